@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"google.golang.org/grpc/reflection"
@@ -30,13 +31,25 @@ func main() {
 	if pass == "" {
 		pass = "test1234"
 	}
+	// stored reports go here; unset keeps them in memory only
+	reportDir := os.Getenv("REPORT_OUTPUT_DIR")
+	// reports generated at the same time by submitted and scheduled jobs
+	reportWorkers := 2
+	if v := os.Getenv("REPORT_WORKERS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatal("REPORT_WORKERS must be a positive number")
+		}
+		reportWorkers = n
+	}
 
 	r, err := repo.New(uri, user, pass)
 	if err != nil {
 		log.Fatal("neo4j connect:", err)
 	}
-	ctx := context.Background()
-	defer r.Close(ctx)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer r.Close(context.Background())
 
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
@@ -44,8 +57,9 @@ func main() {
 	}
 
 	srv := grpc.NewServer()
-	s := server.NewServer(r)
+	s := server.NewServer(r, reportDir)
 	pb.RegisterRouteGraphServer(srv, s)
+	s.StartReportJobs(ctx, reportWorkers)
 
 	reflection.Register(srv)
 
@@ -61,4 +75,5 @@ func main() {
 	<-sigs
 	log.Println("shutting down")
 	srv.GracefulStop()
+	cancel()
 }
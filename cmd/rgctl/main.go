@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "route-graph-service/proto/routegraph"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: rgctl [-addr host:port] <command> [flags]
+
+commands:
+  validate [-line L1]   check line routes and network integrity
+  timetable -line L1 [-date YYYY-MM-DD] [-dir OUTBOUND] [-pattern MAIN] [-dwell secs]
+                        print a line timetable as CSV
+  ingest -file trips.csv [-threshold 0.2] [-estimator MEDIAN] [-profiles] [-timeout 10m]
+                        stream AVL trip logs (trip_id,vehicle_uuid,line_id,stop_id,arrival)
+                        and print edges whose travel time drifted`)
+	os.Exit(2)
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:50052", "RouteGraph gRPC address")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewRouteGraphClient(conn)
+
+	// ingest streams whole log files and sets its own deadline with -timeout
+	args := flag.Args()
+	if args[0] == "ingest" {
+		os.Exit(runIngest(context.Background(), client, args[1:]))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	switch args[0] {
+	case "validate":
+		os.Exit(runValidate(ctx, client, args[1:]))
+	case "timetable":
+		os.Exit(runTimetable(ctx, client, args[1:]))
+	default:
+		usage()
+	}
+}
+
+func runValidate(ctx context.Context, client pb.RouteGraphClient, args []string) int {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	line := fs.String("line", "", "validate only this line")
+	fs.Parse(args)
+
+	resp, err := client.ValidateNetwork(ctx, &pb.ValidateNetworkRequest{LineId: *line})
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SEVERITY\tCODE\tLINE\tSTOP\tMESSAGE")
+	for _, f := range resp.Findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", f.Severity, f.Code, f.LineId, f.StopId, f.Message)
+	}
+	w.Flush()
+	fmt.Printf("\n%d error(s), %d warning(s)\n", resp.Errors, resp.Warnings)
+	if resp.Errors > 0 {
+		return 1
+	}
+	return 0
+}
+
+func runTimetable(ctx context.Context, client pb.RouteGraphClient, args []string) int {
+	fs := flag.NewFlagSet("timetable", flag.ExitOnError)
+	line := fs.String("line", "", "line id")
+	date := fs.String("date", "", "service date, default today")
+	dir := fs.String("dir", "", "direction, default OUTBOUND")
+	pattern := fs.String("pattern", "", "route pattern, default MAIN")
+	dwell := fs.Int("dwell", 0, "dwell at every intermediate stop in seconds")
+	fs.Parse(args)
+	if *line == "" {
+		usage()
+	}
+
+	resp, err := client.GenerateTimetable(ctx, &pb.TimetableRequest{
+		LineId:     *line,
+		Date:       *date,
+		Direction:  *dir,
+		Pattern:    *pattern,
+		DwellSecs:  int32(*dwell),
+		IncludeCsv: true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(resp.Csv)
+	return 0
+}
+
+/*
+runIngest streams one IngestTripLog message per trip. Rows of a trip must be consecutive and in
+stop order; arrival is RFC 3339 or unix seconds. A header row is skipped.
+*/
+func runIngest(ctx context.Context, client pb.RouteGraphClient, args []string) int {
+	fs := flag.NewFlagSet("ingest", flag.ExitOnError)
+	file := fs.String("file", "", "trip log CSV, - for stdin")
+	threshold := fs.Float64("threshold", 0, "relative drift to report, default 0.2")
+	estimator := fs.String("estimator", "", "MEDIAN, P85 or EWMA")
+	minSamples := fs.Int("min-samples", 0, "observations needed before an edge changes")
+	profiles := fs.Bool("profiles", false, "also rebuild time-of-day profiles")
+	timeout := fs.Duration("timeout", 0, "give up after this long, 0 waits until the server is done")
+	fs.Parse(args)
+	if *file == "" {
+		usage()
+	}
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	in := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	stream, err := client.IngestTripLog(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	first := true
+	send := func(trip *pb.TripLog) {
+		if trip == nil {
+			return
+		}
+		req := &pb.IngestTripLogRequest{Trip: trip}
+		if first {
+			req.Estimator, req.DriftThreshold = *estimator, *threshold
+			req.MinSamples, req.BuildProfiles = int32(*minSamples), *profiles
+			first = false
+		}
+		if err := stream.Send(req); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = 5
+	r.TrimLeadingSpace = true
+	var trip *pb.TripLog
+	for line := 1; ; line++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		at, err := parseArrival(rec[4])
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			log.Fatalf("line %d: %v", line, err)
+		}
+		if trip == nil || trip.TripId != rec[0] {
+			send(trip)
+			trip = &pb.TripLog{TripId: rec[0], VehicleUuid: rec[1], LineId: rec[2]}
+		}
+		trip.Arrivals = append(trip.Arrivals, &pb.TripStopArrival{StopId: rec[3], ArrivalTs: at.UnixMilli()})
+	}
+	send(trip)
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%d trip(s), %d arrival(s), %d observation(s), %d on unknown edges\n",
+		resp.Trips, resp.Arrivals, resp.Observations, resp.UnknownEdges)
+	fmt.Printf("%d edge(s) recalibrated, %d changed, %d profile value(s)\n\n",
+		resp.EdgesRecalibrated, resp.EdgesUpdated, resp.ProfileEntries)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "FROM\tTO\tOLD\tNEW\tDRIFT\tSAMPLES\tCONFIDENCE")
+	for _, d := range resp.Drifted {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%+.0f%%\t%d\t%.2f\n",
+			d.FromId, d.ToId, d.OldTravelTime, d.NewTravelTime, 100*d.Drift, d.Samples, d.Confidence)
+	}
+	w.Flush()
+	for _, p := range resp.Problems {
+		fmt.Fprintln(os.Stderr, p)
+	}
+	return 0
+}
+
+func parseArrival(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	secs, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid arrival %q, expected RFC 3339 or unix seconds", s)
+	}
+	return time.Unix(secs, 0), nil
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"container/heap"
+	"math"
+)
+
+/*
+Betweenness computes weighted betweenness centrality with Brandes' algorithm: the share of
+shortest paths between other node pairs passing through each node, normalised by (n-1)(n-2).
+*/
+func (g *Graph) Betweenness() map[string]float64 {
+	n := len(g.nodes)
+	cb := make([]float64, n)
+	for s := 0; s < n; s++ {
+		var stack []int
+		pred := make([][]int, n)
+		sigma := make([]float64, n)
+		dist := make([]float64, n)
+		for i := range dist {
+			dist[i] = math.Inf(1)
+		}
+		sigma[s], dist[s] = 1, 0
+		pq := &queue{{node: s}}
+		for pq.Len() > 0 {
+			it := heap.Pop(pq).(item)
+			if it.dist > dist[it.node] {
+				continue
+			}
+			u := it.node
+			stack = append(stack, u)
+			for _, e := range g.adj[u] {
+				v := g.index[e.To]
+				d := dist[u] + e.Weight
+				switch {
+				case d < dist[v]:
+					dist[v] = d
+					sigma[v] = sigma[u]
+					pred[v] = []int{u}
+					heap.Push(pq, item{node: v, dist: d})
+				case d == dist[v]:
+					sigma[v] += sigma[u]
+					pred[v] = append(pred[v], u)
+				}
+			}
+		}
+		delta := make([]float64, n)
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range pred[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				cb[w] += delta[w]
+			}
+		}
+	}
+	res := make(map[string]float64, n)
+	norm := float64((n - 1) * (n - 2))
+	for i, id := range g.nodes {
+		if norm > 0 {
+			res[id] = cb[i] / norm
+		} else {
+			res[id] = 0
+		}
+	}
+	return res
+}
+
+/*
+Closeness is the Wasserman-Faust closeness over outgoing weighted shortest paths, so nodes
+that reach only part of the network are scaled down by the share they reach.
+*/
+func (g *Graph) Closeness() map[string]float64 {
+	n := len(g.nodes)
+	res := make(map[string]float64, n)
+	for _, id := range g.nodes {
+		total, reached := 0.0, 0
+		for to, d := range g.Distances(id, nil) {
+			if to != id {
+				total += d
+				reached++
+			}
+		}
+		if total == 0 || n < 2 {
+			res[id] = 0
+			continue
+		}
+		r := float64(reached)
+		res[id] = (r / float64(n-1)) * (r / total)
+	}
+	return res
+}
+
+// PageRank runs the power iteration on the unweighted edges; dangling nodes spread their rank evenly.
+func (g *Graph) PageRank(damping float64, iterations int) map[string]float64 {
+	n := len(g.nodes)
+	res := make(map[string]float64, n)
+	if n == 0 {
+		return res
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	for it := 0; it < iterations; it++ {
+		next := make([]float64, n)
+		dangling := 0.0
+		for u, out := range g.adj {
+			if len(out) == 0 {
+				dangling += rank[u]
+				continue
+			}
+			share := rank[u] / float64(len(out))
+			for _, e := range out {
+				next[g.index[e.To]] += share
+			}
+		}
+		diff := 0.0
+		for i := range next {
+			next[i] = (1-damping)/float64(n) + damping*(next[i]+dangling/float64(n))
+			diff += math.Abs(next[i] - rank[i])
+		}
+		rank = next
+		if diff < 1e-9 {
+			break
+		}
+	}
+	for i, id := range g.nodes {
+		res[id] = rank[i]
+	}
+	return res
+}
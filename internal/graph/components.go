@@ -0,0 +1,97 @@
+package graph
+
+import "sort"
+
+/*
+StronglyConnected returns the strongly connected components of the graph (Tarjan), largest
+first; members of a component are sorted and ties are broken by the first member.
+*/
+func (g *Graph) StronglyConnected() [][]string {
+	n := len(g.nodes)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+	var stack []int
+	var comps [][]string
+	next := 0
+	var visit func(u int)
+	visit = func(u int) {
+		index[u], low[u] = next, next
+		next++
+		stack = append(stack, u)
+		onStack[u] = true
+		for _, e := range g.adj[u] {
+			v := g.index[e.To]
+			if index[v] < 0 {
+				visit(v)
+				low[u] = min(low[u], low[v])
+			} else if onStack[v] {
+				low[u] = min(low[u], index[v])
+			}
+		}
+		if low[u] != index[u] {
+			return
+		}
+		var comp []string
+		for {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[v] = false
+			comp = append(comp, g.nodes[v])
+			if v == u {
+				break
+			}
+		}
+		comps = append(comps, comp)
+	}
+	for u := 0; u < n; u++ {
+		if index[u] < 0 {
+			visit(u)
+		}
+	}
+	return sortComponents(comps)
+}
+
+// WeaklyConnected returns the connected components of the undirected view of the graph, largest first.
+func (g *Graph) WeaklyConnected() [][]string {
+	adj := g.undirected()
+	seen := make([]bool, len(g.nodes))
+	var comps [][]string
+	for s := range g.nodes {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		queue := []int{s}
+		var comp []string
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			comp = append(comp, g.nodes[u])
+			for _, v := range adj[u] {
+				if !seen[v] {
+					seen[v] = true
+					queue = append(queue, v)
+				}
+			}
+		}
+		comps = append(comps, comp)
+	}
+	return sortComponents(comps)
+}
+
+func sortComponents(comps [][]string) [][]string {
+	for _, c := range comps {
+		sort.Strings(c)
+	}
+	sort.Slice(comps, func(i, j int) bool {
+		if len(comps[i]) != len(comps[j]) {
+			return len(comps[i]) > len(comps[j])
+		}
+		return comps[i][0] < comps[j][0]
+	})
+	return comps
+}
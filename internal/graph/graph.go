@@ -0,0 +1,108 @@
+// Package graph holds in-memory algorithms over the stop network loaded from Neo4j.
+package graph
+
+import (
+	"container/heap"
+	"math"
+)
+
+type Edge struct {
+	From   string
+	To     string
+	Weight float64
+}
+
+// Graph is a directed weighted graph; nodes keep insertion order so results are deterministic.
+type Graph struct {
+	nodes []string
+	index map[string]int
+	adj   [][]Edge
+}
+
+func New() *Graph {
+	return &Graph{index: map[string]int{}}
+}
+
+func (g *Graph) AddNode(id string) int {
+	if i, ok := g.index[id]; ok {
+		return i
+	}
+	g.index[id] = len(g.nodes)
+	g.nodes = append(g.nodes, id)
+	g.adj = append(g.adj, nil)
+	return len(g.nodes) - 1
+}
+
+func (g *Graph) AddEdge(from, to string, weight float64) {
+	i := g.AddNode(from)
+	g.AddNode(to)
+	g.adj[i] = append(g.adj[i], Edge{From: from, To: to, Weight: weight})
+}
+
+/*
+ShortestPath runs Dijkstra from -> to and returns the node sequence and its total weight.
+Edges for which skip returns true are ignored; ok is false when to is unreachable.
+*/
+func (g *Graph) ShortestPath(from, to string, skip func(Edge) bool) (path []string, cost float64, ok bool) {
+	src, ok1 := g.index[from]
+	dst, ok2 := g.index[to]
+	if !ok1 || !ok2 {
+		return nil, 0, false
+	}
+	dist := make([]float64, len(g.nodes))
+	prev := make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	dist[src] = 0
+	pq := &queue{{node: src}}
+	for pq.Len() > 0 {
+		it := heap.Pop(pq).(item)
+		if it.dist > dist[it.node] {
+			continue
+		}
+		if it.node == dst {
+			break
+		}
+		for _, e := range g.adj[it.node] {
+			if skip != nil && skip(e) {
+				continue
+			}
+			j := g.index[e.To]
+			if d := it.dist + e.Weight; d < dist[j] {
+				dist[j] = d
+				prev[j] = it.node
+				heap.Push(pq, item{node: j, dist: d})
+			}
+		}
+	}
+	if math.IsInf(dist[dst], 1) {
+		return nil, 0, false
+	}
+	for n := dst; n != -1; n = prev[n] {
+		path = append(path, g.nodes[n])
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, dist[dst], true
+}
+
+type item struct {
+	node int
+	dist float64
+}
+
+type queue []item
+
+func (q queue) Len() int           { return len(q) }
+func (q queue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q queue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x any)        { *q = append(*q, x.(item)) }
+func (q *queue) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
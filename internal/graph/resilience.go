@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// Distances runs Dijkstra from one node and returns the cost to every reachable node.
+func (g *Graph) Distances(from string, skip func(Edge) bool) map[string]float64 {
+	src, ok := g.index[from]
+	if !ok {
+		return nil
+	}
+	dist := make([]float64, len(g.nodes))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[src] = 0
+	pq := &queue{{node: src}}
+	for pq.Len() > 0 {
+		it := heap.Pop(pq).(item)
+		if it.dist > dist[it.node] {
+			continue
+		}
+		for _, e := range g.adj[it.node] {
+			if skip != nil && skip(e) {
+				continue
+			}
+			j := g.index[e.To]
+			if d := it.dist + e.Weight; d < dist[j] {
+				dist[j] = d
+				heap.Push(pq, item{node: j, dist: d})
+			}
+		}
+	}
+	res := map[string]float64{}
+	for i, d := range dist {
+		if !math.IsInf(d, 1) {
+			res[g.nodes[i]] = d
+		}
+	}
+	return res
+}
+
+// undirected returns the neighbour lists of the graph with edge direction and duplicates dropped.
+func (g *Graph) undirected() [][]int {
+	seen := map[[2]int]bool{}
+	adj := make([][]int, len(g.nodes))
+	for i, out := range g.adj {
+		for _, e := range out {
+			j := g.index[e.To]
+			if i == j {
+				continue
+			}
+			key := [2]int{min(i, j), max(i, j)}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			adj[i] = append(adj[i], j)
+			adj[j] = append(adj[j], i)
+		}
+	}
+	return adj
+}
+
+/*
+CutElements finds articulation points and bridges of the undirected view of the graph with
+Tarjan's low-link DFS: removing any of them splits a connected part of the network.
+Bridges are returned as node pairs in lexical order.
+*/
+func (g *Graph) CutElements() (points []string, bridges [][2]string) {
+	adj := g.undirected()
+	n := len(g.nodes)
+	disc := make([]int, n)
+	low := make([]int, n)
+	isPoint := make([]bool, n)
+	timer := 0
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		timer++
+		disc[u], low[u] = timer, timer
+		children := 0
+		for _, v := range adj[u] {
+			if v == parent {
+				continue
+			}
+			if disc[v] != 0 {
+				low[u] = min(low[u], disc[v])
+				continue
+			}
+			children++
+			dfs(v, u)
+			low[u] = min(low[u], low[v])
+			if parent != -1 && low[v] >= disc[u] {
+				isPoint[u] = true
+			}
+			if low[v] > disc[u] {
+				a, b := g.nodes[u], g.nodes[v]
+				if b < a {
+					a, b = b, a
+				}
+				bridges = append(bridges, [2]string{a, b})
+			}
+		}
+		if parent == -1 && children > 1 {
+			isPoint[u] = true
+		}
+	}
+	for u := 0; u < n; u++ {
+		if disc[u] == 0 {
+			dfs(u, -1)
+		}
+	}
+	for u, ok := range isPoint {
+		if ok {
+			points = append(points, g.nodes[u])
+		}
+	}
+	sort.Strings(points)
+	sort.Slice(bridges, func(i, j int) bool {
+		if bridges[i][0] != bridges[j][0] {
+			return bridges[i][0] < bridges[j][0]
+		}
+		return bridges[i][1] < bridges[j][1]
+	})
+	return points, bridges
+}
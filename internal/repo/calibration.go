@@ -0,0 +1,308 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Every observed NEXT travel time is kept as a (:TravelTimeObservation {from_id, to_id, ts,
+observed, vehicle_uuid, line_id}) node. Recalibration replaces travel_time with a robust estimate over
+the observations of a rolling window instead of trusting a single reported average.
+*/
+const (
+	EstimatorMedian = "MEDIAN"
+	EstimatorP85    = "P85"
+	EstimatorEWMA   = "EWMA"
+
+	BandAll = "ALL"
+
+	defaultCalibrationWindow = 28 * 24 * time.Hour
+	defaultMinSamples        = 3
+	ewmaAlpha                = 0.2
+)
+
+// TimeBand is a time-of-day interval [From, To) in minutes after midnight.
+type TimeBand struct {
+	Name string
+	From int
+	To   int
+}
+
+// TimeBands follow the headway bands of the seeded weekday service.
+var TimeBands = []TimeBand{
+	{"NIGHT", 0, 5 * 60},
+	{"EARLY", 5 * 60, 7 * 60},
+	{"AM_PEAK", 7 * 60, 9 * 60},
+	{"MIDDAY", 9 * 60, 15 * 60},
+	{"PM_PEAK", 15 * 60, 18 * 60},
+	{"EVENING", 18 * 60, 24 * 60},
+}
+
+func BandAt(t time.Time) string {
+	m := t.Hour()*60 + t.Minute()
+	for _, b := range TimeBands {
+		if m >= b.From && m < b.To {
+			return b.Name
+		}
+	}
+	return BandAll
+}
+
+func ValidEstimator(e string) bool {
+	switch e {
+	case EstimatorMedian, EstimatorP85, EstimatorEWMA:
+		return true
+	}
+	return false
+}
+
+type TravelObservation struct {
+	From        string
+	To          string
+	At          time.Time
+	Observed    int32
+	VehicleUUID string
+	LineID      string
+}
+
+type BandEstimate struct {
+	Band    string
+	Samples int
+	Median  float64
+	P15     float64
+	P85     float64
+	EWMA    float64
+	// Estimate is the value of the chosen estimator, rounded to whole seconds.
+	Estimate   int32
+	Confidence float64
+}
+
+type Calibration struct {
+	From          string
+	To            string
+	Estimator     string
+	OldTravelTime int32
+	NewTravelTime int32
+	Updated       bool
+	Overall       BandEstimate
+	Bands         []BandEstimate
+}
+
+type CalibrationOptions struct {
+	Estimator  string
+	Window     time.Duration
+	MinSamples int
+	Now        time.Time
+}
+
+func (o *CalibrationOptions) defaults() error {
+	o.Estimator = strings.ToUpper(o.Estimator)
+	if o.Estimator == "" {
+		o.Estimator = EstimatorMedian
+	}
+	if !ValidEstimator(o.Estimator) {
+		return fmt.Errorf("invalid estimator %q, expected MEDIAN, P85 or EWMA", o.Estimator)
+	}
+	if o.Window <= 0 {
+		o.Window = defaultCalibrationWindow
+	}
+	if o.MinSamples <= 0 {
+		o.MinSamples = defaultMinSamples
+	}
+	if o.Now.IsZero() {
+		o.Now = time.Now()
+	}
+	return nil
+}
+
+func (r *NeoRepo) RecordTravelTimes(ctx context.Context, obs []TravelObservation) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return nil, recordTravelTimesTx(ctx, tx, obs)
+	})
+	return err
+}
+
+func recordTravelTimesTx(ctx context.Context, tx neo4j.ManagedTransaction, obs []TravelObservation) error {
+	rows := make([]map[string]any, 0, len(obs))
+	for _, o := range obs {
+		if o.Observed <= 0 {
+			return fmt.Errorf("observed travel time must be positive")
+		}
+		rows = append(rows, map[string]any{
+			"from": o.From, "to": o.To, "ts": o.At.UnixMilli(), "observed": o.Observed, "vehicle": o.VehicleUUID, "line": o.LineID,
+		})
+	}
+	_, err := tx.Run(ctx, `
+        UNWIND $rows AS o
+        CREATE (:TravelTimeObservation {from_id: o.from, to_id: o.to, ts: o.ts, observed: o.observed,
+                                        vehicle_uuid: o.vehicle, line_id: o.line})
+    `, map[string]any{"rows": rows})
+	return err
+}
+
+/*
+RecalibrateNext stores obs (when given) and recalibrates the edge from the observations of the
+window. travel_time moves in either direction, but only once MinSamples observations exist.
+*/
+func (r *NeoRepo) RecalibrateNext(ctx context.Context, from, to string, obs *TravelObservation, opts CalibrationOptions) (*Calibration, error) {
+	if err := opts.defaults(); err != nil {
+		return nil, err
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		if obs != nil {
+			if err := recordTravelTimesTx(ctx, tx, []TravelObservation{*obs}); err != nil {
+				return nil, err
+			}
+		}
+		return recalibrateNextTx(ctx, tx, from, to, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Calibration), nil
+}
+
+// recalibrateNextTx expects opts with defaults applied.
+func recalibrateNextTx(ctx context.Context, tx neo4j.ManagedTransaction, from, to string, opts CalibrationOptions) (*Calibration, error) {
+	rs, err := tx.Run(ctx, `MATCH (:Stop {id:$from})-[r:NEXT]->(:Stop {id:$to}) RETURN coalesce(r.travel_time, 0)`,
+		map[string]any{"from": from, "to": to})
+	if err != nil {
+		return nil, err
+	}
+	if !rs.Next(ctx) {
+		return nil, fmt.Errorf("edge not found")
+	}
+	c := &Calibration{From: from, To: to, Estimator: opts.Estimator, OldTravelTime: helper.AnyToInt32(rs.Record().Values[0])}
+	c.NewTravelTime = c.OldTravelTime
+
+	samples, err := travelObservationsTx(ctx, tx, from, to, opts.Now.Add(-opts.Window))
+	if err != nil {
+		return nil, err
+	}
+	c.Overall, c.Bands = EstimateTravelTimes(samples, opts.Estimator)
+	if c.Overall.Samples < opts.MinSamples {
+		return c, nil
+	}
+	c.NewTravelTime = c.Overall.Estimate
+	c.Updated = true
+	_, err = tx.Run(ctx, `
+        MATCH (:Stop {id:$from})-[r:NEXT]->(:Stop {id:$to})
+        SET r.travel_time = $tt, r.last_calibrated = timestamp(),
+            r.calibration_count = coalesce(r.calibration_count, 0) + 1,
+            r.calibration_samples = $samples, r.calibration_confidence = $conf
+    `, map[string]any{"from": from, "to": to, "tt": c.NewTravelTime, "samples": c.Overall.Samples, "conf": c.Overall.Confidence})
+	return c, err
+}
+
+func travelObservationsTx(ctx context.Context, tx neo4j.ManagedTransaction, from, to string, since time.Time) ([]TravelObservation, error) {
+	rs, err := tx.Run(ctx, `
+        MATCH (o:TravelTimeObservation {from_id:$from, to_id:$to})
+        WHERE o.ts >= $since
+        RETURN o.ts, o.observed, coalesce(o.vehicle_uuid, '')
+        ORDER BY o.ts
+    `, map[string]any{"from": from, "to": to, "since": since.UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	var res []TravelObservation
+	for rs.Next(ctx) {
+		rec := rs.Record()
+		res = append(res, TravelObservation{
+			From:        from,
+			To:          to,
+			At:          time.UnixMilli(helper.AnyToInt64(rec.Values[0])),
+			Observed:    helper.AnyToInt32(rec.Values[1]),
+			VehicleUUID: rec.Values[2].(string),
+		})
+	}
+	return res, rs.Err()
+}
+
+// EstimateTravelTimes summarises time-ordered observations overall and per time-of-day band.
+func EstimateTravelTimes(obs []TravelObservation, estimator string) (BandEstimate, []BandEstimate) {
+	byBand := map[string][]float64{}
+	all := make([]float64, 0, len(obs))
+	for _, o := range obs {
+		v := float64(o.Observed)
+		all = append(all, v)
+		b := BandAt(o.At)
+		byBand[b] = append(byBand[b], v)
+	}
+	var bands []BandEstimate
+	for _, b := range TimeBands {
+		if vals := byBand[b.Name]; len(vals) > 0 {
+			bands = append(bands, estimate(b.Name, vals, estimator))
+		}
+	}
+	return estimate(BandAll, all, estimator), bands
+}
+
+func estimate(band string, vals []float64, estimator string) BandEstimate {
+	e := BandEstimate{Band: band, Samples: len(vals)}
+	if len(vals) == 0 {
+		return e
+	}
+	e.EWMA = ewma(vals, ewmaAlpha)
+	sorted := append([]float64(nil), vals...)
+	sort.Float64s(sorted)
+	e.Median = percentile(sorted, 0.5)
+	e.P15 = percentile(sorted, 0.15)
+	e.P85 = percentile(sorted, 0.85)
+	switch estimator {
+	case EstimatorP85:
+		e.Estimate = int32(math.Round(e.P85))
+	case EstimatorEWMA:
+		e.Estimate = int32(math.Round(e.EWMA))
+	default:
+		e.Estimate = int32(math.Round(e.Median))
+	}
+	e.Confidence = confidence(len(vals), e.Median, e.P15, e.P85)
+	return e
+}
+
+// percentile interpolates linearly between the closest ranks of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := min(lo+1, len(sorted)-1)
+	return sorted[lo] + (pos-float64(lo))*(sorted[hi]-sorted[lo])
+}
+
+// ewma weighs later observations more; vals are in time order.
+func ewma(vals []float64, alpha float64) float64 {
+	s := vals[0]
+	for _, v := range vals[1:] {
+		s = alpha*v + (1-alpha)*s
+	}
+	return s
+}
+
+/*
+confidence grows with the sample count and falls with the spread of the middle 70% of the
+observations relative to the median; it is 0 without data and approaches 1 for many
+consistent samples.
+*/
+func confidence(n int, median, p15, p85 float64) float64 {
+	if n == 0 || median <= 0 {
+		return 0
+	}
+	size := float64(n) / float64(n+5)
+	spread := (p85 - p15) / median
+	return size / (1 + spread)
+}
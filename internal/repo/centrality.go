@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+const (
+	MetricDegree      = "degree"
+	MetricBetweenness = "betweenness"
+	MetricCloseness   = "closeness"
+	MetricPageRank    = "pagerank"
+	MetricLines       = "lines"
+
+	pageRankDamping    = 0.85
+	pageRankIterations = 100
+)
+
+type StopCentrality struct {
+	StopID      string  `json:"stop_id"`
+	Name        string  `json:"stop_name"`
+	Degree      int     `json:"degree"`
+	Lines       int     `json:"lines"`
+	Betweenness float64 `json:"betweenness"`
+	Closeness   float64 `json:"closeness"`
+	PageRank    float64 `json:"pagerank"`
+}
+
+func ValidCentralityMetric(m string) bool {
+	switch m {
+	case MetricDegree, MetricBetweenness, MetricCloseness, MetricPageRank, MetricLines:
+		return true
+	}
+	return false
+}
+
+// Value returns the stop's score for one metric.
+func (c StopCentrality) Value(metric string) float64 {
+	switch metric {
+	case MetricDegree:
+		return float64(c.Degree)
+	case MetricCloseness:
+		return c.Closeness
+	case MetricPageRank:
+		return c.PageRank
+	case MetricLines:
+		return float64(c.Lines)
+	}
+	return c.Betweenness
+}
+
+// StopCentrality ranks stops by metric (betweenness when empty) and returns the top limit (all when 0).
+func (r *NeoRepo) StopCentrality(ctx context.Context, metric string, limit int) ([]StopCentrality, error) {
+	if metric == "" {
+		metric = MetricBetweenness
+	}
+	if !ValidCentralityMetric(metric) {
+		return nil, fmt.Errorf("invalid metric %q", metric)
+	}
+	n, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := n.Centrality()
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Value(metric) > res[j].Value(metric) })
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+/*
+Centrality scores every stop: betweenness and closeness follow travel_time weighted shortest
+paths (a zero travel_time counts as one second), PageRank the plain NEXT structure; lines is the
+number of distinct lines with a SERVES edge to the stop.
+*/
+func (n *Network) Centrality() []StopCentrality {
+	g := n.Graph(func(e Edge) float64 { return float64(max(e.TravelTime, 1)) })
+	betweenness := g.Betweenness()
+	closeness := g.Closeness()
+	pagerank := g.PageRank(pageRankDamping, pageRankIterations)
+
+	degree := map[string]int{}
+	for _, st := range n.TopConnectedStops(0) {
+		degree[st["stop_id"].(string)] = int(st["degree"].(int64))
+	}
+	lines := map[string]map[string]bool{}
+	for _, rt := range n.Routes {
+		for _, id := range rt.Stops {
+			if lines[id] == nil {
+				lines[id] = map[string]bool{}
+			}
+			lines[id][rt.LineID] = true
+		}
+	}
+	res := make([]StopCentrality, 0, len(n.Stops))
+	for _, id := range n.StopIDs() {
+		res = append(res, StopCentrality{
+			StopID:      id,
+			Name:        n.Stops[id].Name,
+			Degree:      degree[id],
+			Lines:       len(lines[id]),
+			Betweenness: betweenness[id],
+			Closeness:   closeness[id],
+			PageRank:    pagerank[id],
+		})
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"sort"
+
+	helper "route-graph-service/util"
+)
+
+const (
+	defaultCandidateRadius = 500.0
+	defaultLinkCandidates  = 20
+)
+
+/*
+LinkCandidate is the closest pair of stops between two strongly connected components. Adding
+a NEXT edge From -> To would let passengers cross between them; From -> To is chosen so it
+does not duplicate an existing edge.
+*/
+type LinkCandidate struct {
+	From          string  `json:"from_id"`
+	To            string  `json:"to_id"`
+	FromComponent int     `json:"from_component"`
+	ToComponent   int     `json:"to_component"`
+	Distance      float64 `json:"distance_m"`
+	// JoinsWeak is set when the stops are not connected at all, not even against edge direction.
+	JoinsWeak bool `json:"joins_weak"`
+}
+
+type Connectivity struct {
+	// components are largest first; index 0 is the main network
+	Strong     [][]string      `json:"strong"`
+	Weak       [][]string      `json:"weak"`
+	Isolated   []string        `json:"isolated_stop_ids"`
+	Candidates []LinkCandidate `json:"candidates"`
+}
+
+func (r *NeoRepo) ConnectivityReport(ctx context.Context, radius float64, limit int) (*Connectivity, error) {
+	n, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return n.Connectivity(radius, limit), nil
+}
+
+// Connectivity splits the NEXT graph into components and proposes links between nearby ones.
+func (n *Network) Connectivity(radius float64, limit int) *Connectivity {
+	if radius <= 0 {
+		radius = defaultCandidateRadius
+	}
+	if limit <= 0 {
+		limit = defaultLinkCandidates
+	}
+	g := n.Graph(nil)
+	res := &Connectivity{Strong: g.StronglyConnected(), Weak: g.WeaklyConnected()}
+
+	strongOf := componentIndex(res.Strong)
+	weakOf := componentIndex(res.Weak)
+	degree := map[string]int{}
+	for k := range n.Edges {
+		degree[k[0]]++
+		degree[k[1]]++
+	}
+	for _, id := range n.StopIDs() {
+		if degree[id] == 0 {
+			res.Isolated = append(res.Isolated, id)
+		}
+	}
+
+	best := map[[2]int]LinkCandidate{}
+	ids := n.StopIDs()
+	for i, a := range ids {
+		for _, b := range ids[i+1:] {
+			ca, cb := strongOf[a], strongOf[b]
+			if ca == cb {
+				continue
+			}
+			sa, sb := n.Stops[a], n.Stops[b]
+			d := helper.HaversineMeters(sa.Lat, sa.Lon, sb.Lat, sb.Lon)
+			if d > radius {
+				continue
+			}
+			c := LinkCandidate{From: a, To: b, FromComponent: ca, ToComponent: cb, Distance: d, JoinsWeak: weakOf[a] != weakOf[b]}
+			if _, ok := n.Edges[[2]string{a, b}]; ok {
+				c.From, c.To, c.FromComponent, c.ToComponent = b, a, cb, ca
+			}
+			key := [2]int{min(ca, cb), max(ca, cb)}
+			if prev, ok := best[key]; !ok || d < prev.Distance {
+				best[key] = c
+			}
+		}
+	}
+	for _, c := range best {
+		res.Candidates = append(res.Candidates, c)
+	}
+	sort.Slice(res.Candidates, func(i, j int) bool {
+		a, b := res.Candidates[i], res.Candidates[j]
+		if a.JoinsWeak != b.JoinsWeak {
+			return a.JoinsWeak
+		}
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		return a.From < b.From
+	})
+	if len(res.Candidates) > limit {
+		res.Candidates = res.Candidates[:limit]
+	}
+	return res
+}
+
+func componentIndex(comps [][]string) map[string]int {
+	idx := map[string]int{}
+	for i, c := range comps {
+		for _, id := range c {
+			idx[id] = i
+		}
+	}
+	return idx
+}
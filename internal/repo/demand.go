@@ -0,0 +1,283 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Origin-destination demand is stored as (:Stop)-[:DEMAND {trips_per_hour}]->(:Stop). Assignment
+routes every pair over the path the journey planner would return for a departure at the
+assignment time, the first arrival with NEXT edges priced by their profiles, and splits the
+trips on each edge between the lines serving it in proportion to their hourly capacity.
+*/
+type Demand struct {
+	From         string
+	To           string
+	TripsPerHour float64
+}
+
+type EdgeLoad struct {
+	From     string   `json:"from_id"`
+	To       string   `json:"to_id"`
+	Lines    []string `json:"line_ids"`
+	Load     float64  `json:"load"`
+	Capacity float64  `json:"capacity"`
+}
+
+type LineLoad struct {
+	LineID          string  `json:"line_id"`
+	FrequencyMins   int32   `json:"frequency_mins"`
+	VehicleCapacity float64 `json:"vehicle_capacity"`
+	// Capacity is passengers per hour: 60 / frequency_mins departures of the average assigned vehicle.
+	Capacity float64 `json:"capacity"`
+	PeakLoad float64 `json:"peak_load"`
+	PeakFrom string  `json:"peak_from_id"`
+	PeakTo   string  `json:"peak_to_id"`
+	// PassengerHours sums load times travel time over the line's edges.
+	PassengerHours float64 `json:"passenger_hours"`
+}
+
+type Assignment struct {
+	At              time.Time  `json:"at"`
+	Pairs           int        `json:"pairs"`
+	UnassignedPairs int        `json:"unassigned_pairs"`
+	AssignedTrips   float64    `json:"assigned_trips"`
+	UnassignedTrips float64    `json:"unassigned_trips"`
+	Edges           []EdgeLoad `json:"edges"`
+	Lines           []LineLoad `json:"lines"`
+}
+
+// HourlyCapacity is passengers per hour: departures per hour times the average vehicle capacity.
+func HourlyCapacity(departuresPerHour, vehicleCapacity float64) float64 {
+	return departuresPerHour * vehicleCapacity
+}
+
+func Utilization(load, capacity float64) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	return load / capacity
+}
+
+// Overloaded is true when load exceeds capacity, including load on edges no line serves.
+func Overloaded(load, capacity float64) bool { return load > capacity }
+
+// LoadDemand stores the matrix; rows with unknown stops are returned instead of being stored.
+func (r *NeoRepo) LoadDemand(ctx context.Context, rows []Demand, replace bool) (loaded int, unknown []Demand, err error) {
+	params := make([]map[string]any, 0, len(rows))
+	for _, d := range rows {
+		params = append(params, map[string]any{"from": d.From, "to": d.To, "trips": d.TripsPerHour})
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		loaded, unknown = 0, nil
+		if replace {
+			if _, err := tx.Run(ctx, `MATCH (:Stop)-[d:DEMAND]->(:Stop) DELETE d`, nil); err != nil {
+				return nil, err
+			}
+		}
+		rs, err := tx.Run(ctx, `
+            UNWIND range(0, size($rows) - 1) AS i
+            WITH i, $rows[i] AS row
+            OPTIONAL MATCH (a:Stop {id: row.from})
+            OPTIONAL MATCH (b:Stop {id: row.to})
+            FOREACH (_ IN CASE WHEN a IS NULL OR b IS NULL THEN [] ELSE [1] END |
+                MERGE (a)-[d:DEMAND]->(b)
+                SET d.trips_per_hour = row.trips, d.updated_at = $now)
+            RETURN i, a IS NOT NULL AND b IS NOT NULL
+        `, map[string]any{"rows": params, "now": time.Now().Unix()})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			if rec.Values[1].(bool) {
+				loaded++
+			} else {
+				unknown = append(unknown, rows[helper.AnyToInt64(rec.Values[0])])
+			}
+		}
+		return nil, rs.Err()
+	})
+	return loaded, unknown, err
+}
+
+func (r *NeoRepo) GetDemand(ctx context.Context) ([]Demand, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (a:Stop)-[d:DEMAND]->(b:Stop)
+            RETURN a.id, b.id, coalesce(d.trips_per_hour, 0.0)
+            ORDER BY a.id, b.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res []Demand
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			d := Demand{From: rec.Values[0].(string), To: rec.Values[1].(string)}
+			d.TripsPerHour, _ = toFloat(rec.Values[2])
+			res = append(res, d)
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]Demand), nil
+}
+
+// LineVehicleCapacity returns the average capacity of the vehicles assigned to each line.
+func (r *NeoRepo) LineVehicleCapacity(ctx context.Context) (map[string]float64, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (v:Vehicle)-[:ASSIGNED_TO]->(l:Line)
+            RETURN l.id, avg(toFloat(coalesce(v.capacity, 0)))
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		res := map[string]float64{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res[rec.Values[0].(string)], _ = toFloat(rec.Values[1])
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(map[string]float64), nil
+}
+
+func (r *NeoRepo) AssignDemand(ctx context.Context, at time.Time) (*Assignment, error) {
+	demand, err := r.GetDemand(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	capacity, err := r.LineVehicleCapacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return n.AssignDemand(demand, capacity, holidays, at), nil
+}
+
+func (n *Network) AssignDemand(demand []Demand, vehicleCapacity map[string]float64, holidays map[string]bool, at time.Time) *Assignment {
+	res := &Assignment{At: at}
+	lines := map[string]*LineLoad{}
+	for id, l := range n.Lines {
+		freq, _ := l.Service.FrequencyAt(at, holidays)
+		ll := &LineLoad{LineID: id, FrequencyMins: freq, VehicleCapacity: vehicleCapacity[id]}
+		if l.Active && freq > 0 {
+			ll.Capacity = HourlyCapacity(60/float64(freq), ll.VehicleCapacity)
+		}
+		lines[id] = ll
+	}
+
+	// lines serving each edge, from consecutive stops of every route
+	serving := map[[2]string][]string{}
+	for _, rt := range n.Routes {
+		for i := 1; i < len(rt.Stops); i++ {
+			k := [2]string{rt.Stops[i-1], rt.Stops[i]}
+			if !slices.Contains(serving[k], rt.LineID) {
+				serving[k] = append(serving[k], rt.LineID)
+			}
+		}
+	}
+
+	edgeLoad := map[[2]string]float64{}
+	lineEdgeLoad := map[string]map[[2]string]float64{}
+	for _, d := range demand {
+		if d.TripsPerHour <= 0 || d.From == d.To {
+			continue
+		}
+		res.Pairs++
+		path, _, _, ok := n.ShortestPath(d.From, d.To, at, holidays)
+		if !ok {
+			res.UnassignedPairs++
+			res.UnassignedTrips += d.TripsPerHour
+			continue
+		}
+		res.AssignedTrips += d.TripsPerHour
+		for i := 1; i < len(path); i++ {
+			k := [2]string{path[i-1], path[i]}
+			edgeLoad[k] += d.TripsPerHour
+			var total float64
+			for _, l := range serving[k] {
+				total += lines[l].Capacity
+			}
+			for _, l := range serving[k] {
+				share := 1 / float64(len(serving[k]))
+				if total > 0 {
+					share = lines[l].Capacity / total
+				}
+				if lineEdgeLoad[l] == nil {
+					lineEdgeLoad[l] = map[[2]string]float64{}
+				}
+				lineEdgeLoad[l][k] += d.TripsPerHour * share
+			}
+		}
+	}
+
+	for k, load := range edgeLoad {
+		e := EdgeLoad{From: k[0], To: k[1], Lines: serving[k], Load: load}
+		for _, l := range e.Lines {
+			e.Capacity += lines[l].Capacity
+		}
+		sort.Strings(e.Lines)
+		res.Edges = append(res.Edges, e)
+	}
+	sort.Slice(res.Edges, func(i, j int) bool {
+		a, b := res.Edges[i], res.Edges[j]
+		ua, ub := Utilization(a.Load, a.Capacity), Utilization(b.Load, b.Capacity)
+		if Overloaded(a.Load, a.Capacity) != Overloaded(b.Load, b.Capacity) {
+			return Overloaded(a.Load, a.Capacity)
+		}
+		if ua != ub {
+			return ua > ub
+		}
+		if a.Load != b.Load {
+			return a.Load > b.Load
+		}
+		return a.From+"|"+a.To < b.From+"|"+b.To
+	})
+
+	for id, ll := range lines {
+		for k, load := range lineEdgeLoad[id] {
+			ll.PassengerHours += load * float64(n.Edges[k].TravelTime) / 3600
+			if load > ll.PeakLoad || (load == ll.PeakLoad && k[0]+"|"+k[1] < ll.PeakFrom+"|"+ll.PeakTo) {
+				ll.PeakLoad, ll.PeakFrom, ll.PeakTo = load, k[0], k[1]
+			}
+		}
+		res.Lines = append(res.Lines, *ll)
+	}
+	sort.Slice(res.Lines, func(i, j int) bool {
+		a, b := res.Lines[i], res.Lines[j]
+		ua, ub := Utilization(a.PeakLoad, a.Capacity), Utilization(b.PeakLoad, b.Capacity)
+		if ua != ub {
+			return ua > ub
+		}
+		return a.LineID < b.LineID
+	})
+	return res
+}
@@ -0,0 +1,325 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// Vehicles whose last position is older than this are not used for live predictions.
+	livePositionMaxAge = 5 * time.Minute
+	// a heading further than this from the route segment belongs to another route
+	maxHeadingDiff = 90.0
+)
+
+type Departure struct {
+	LineID      string
+	LineName    string
+	Direction   string
+	Pattern     string
+	Destination string
+	// ScheduledAt is zero for a live vehicle that matches no scheduled trip.
+	ScheduledAt time.Time
+	ExpectedAt  time.Time
+	Realtime    bool
+	VehicleID   string
+}
+
+type DepartureBoard struct {
+	StopID     string
+	StopName   string
+	Departures []Departure
+}
+
+type stopRoute struct {
+	lineID, lineName, direction, pattern string
+}
+
+type livePrediction struct {
+	vehicleID string
+	route     int
+	at        time.Time
+}
+
+/*
+DepartureBoard returns the next perLine departures of every line serving stopId after now.
+Scheduled times come from the line timetable; an ACTIVE vehicle assigned to the line with a
+fresh position is snapped to its nearest route stop upstream of stopId and its predicted
+arrival replaces the closest scheduled trip (or is added when none is close). A vehicle is only
+snapped onto a route of the direction it reports, or whose course matches its heading; without
+either it is used only when the line serves the stop in one direction. Routes whose stop order
+or NEXT edges are broken are left off the board.
+*/
+func (r *NeoRepo) DepartureBoard(ctx context.Context, stopId string, now time.Time, perLine int) (*DepartureBoard, error) {
+	board, routes, err := r.getStopRoutes(ctx, stopId)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lineIds := []string{}
+	services := map[string]*LineService{}
+	for _, rt := range routes {
+		if _, ok := services[rt.lineID]; ok {
+			continue
+		}
+		ls, err := r.GetLineService(ctx, rt.lineID)
+		if err != nil {
+			return nil, err
+		}
+		services[rt.lineID] = ls
+		lineIds = append(lineIds, rt.lineID)
+	}
+	positions, err := r.getLivePositions(ctx, lineIds, now)
+	if err != nil {
+		return nil, err
+	}
+
+	directions := map[string]map[string]bool{}
+	for _, rt := range routes {
+		if directions[rt.lineID] == nil {
+			directions[rt.lineID] = map[string]bool{}
+		}
+		directions[rt.lineID][rt.direction] = true
+	}
+
+	scheduled := make([][]Departure, len(routes))
+	best := map[string]livePrediction{}
+	for i, rt := range routes {
+		stops, window, err := r.getTimedRoute(ctx, rt.lineID, rt.direction, rt.pattern)
+		if err != nil {
+			log.Printf("departure board %s: skipping line %s %s/%s: %v", stopId, rt.lineID, rt.direction, rt.pattern, err)
+			continue
+		}
+		scheduleOffsets(stops, 0, nil)
+		idx := -1
+		for j, st := range stops {
+			if st.StopID == stopId {
+				idx = j
+			}
+		}
+		// nothing departs from the last stop of a route
+		if idx < 0 || idx == len(stops)-1 {
+			continue
+		}
+		dep := Departure{
+			LineID:      rt.lineID,
+			LineName:    rt.lineName,
+			Direction:   rt.direction,
+			Pattern:     rt.pattern,
+			Destination: stops[len(stops)-1].Name,
+		}
+		midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		for day := -1; day <= 1; day++ {
+			date := midnight.AddDate(0, 0, day)
+			for _, m := range services[rt.lineID].Departures(date, holidays) {
+				if !PatternActiveAt(window[0], window[1], m%(24*60)) {
+					continue
+				}
+				start := date.Add(time.Duration(m) * time.Minute)
+				at := start.Add(time.Duration(profiledOffsets(stops[:idx+1], start, holidays)[idx]) * time.Second)
+				if at.Before(now) {
+					continue
+				}
+				d := dep
+				d.ScheduledAt, d.ExpectedAt = at, at
+				scheduled[i] = append(scheduled[i], d)
+			}
+		}
+		sort.Slice(scheduled[i], func(a, b int) bool { return scheduled[i][a].ScheduledAt.Before(scheduled[i][b].ScheduledAt) })
+
+		for _, p := range positions[rt.lineID] {
+			if p.direction != "" && p.direction != rt.direction {
+				continue
+			}
+			if p.direction == "" && p.heading == nil && len(directions[rt.lineID]) > 1 {
+				continue
+			}
+			k := nearestStop(stops, p.lat, p.lon)
+			if k > idx {
+				continue
+			}
+			if p.direction == "" && p.heading != nil && !headingMatches(stops, k, *p.heading) {
+				continue
+			}
+			at := now.Add(time.Duration(profiledOffsets(stops[k:idx+1], now, holidays)[idx-k]) * time.Second)
+			if cur, ok := best[p.vehicleID]; !ok || at.Before(cur.at) {
+				best[p.vehicleID] = livePrediction{vehicleID: p.vehicleID, route: i, at: at}
+			}
+		}
+	}
+
+	for _, lp := range best {
+		deps := scheduled[lp.route]
+		match := -1
+		tolerance := 10 * time.Minute
+		for j, d := range deps {
+			if d.Realtime {
+				continue
+			}
+			diff := d.ScheduledAt.Sub(lp.at)
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff <= tolerance {
+				match, tolerance = j, diff
+			}
+		}
+		if match < 0 {
+			rt := routes[lp.route]
+			extra := Departure{LineID: rt.lineID, LineName: rt.lineName, Direction: rt.direction, Pattern: rt.pattern}
+			if len(deps) > 0 {
+				extra.Destination = deps[0].Destination
+			}
+			deps = append(deps, extra)
+			match = len(deps) - 1
+		}
+		deps[match].ExpectedAt = lp.at
+		deps[match].Realtime = true
+		deps[match].VehicleID = lp.vehicleID
+		scheduled[lp.route] = deps
+	}
+
+	byLine := map[string][]Departure{}
+	for _, deps := range scheduled {
+		for _, d := range deps {
+			byLine[d.LineID] = append(byLine[d.LineID], d)
+		}
+	}
+	for _, deps := range byLine {
+		sort.Slice(deps, func(a, b int) bool { return deps[a].ExpectedAt.Before(deps[b].ExpectedAt) })
+		if perLine > 0 && len(deps) > perLine {
+			deps = deps[:perLine]
+		}
+		board.Departures = append(board.Departures, deps...)
+	}
+	sort.SliceStable(board.Departures, func(a, b int) bool {
+		return board.Departures[a].ExpectedAt.Before(board.Departures[b].ExpectedAt)
+	})
+	return board, nil
+}
+
+func nearestStop(stops []TimedStop, lat, lon float64) int {
+	best, bestDist := 0, math.MaxFloat64
+	for i, st := range stops {
+		if d := helper.HaversineMeters(lat, lon, st.Lat, st.Lon); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best
+}
+
+// headingMatches reports whether heading follows the route course at stop k.
+func headingMatches(stops []TimedStop, k int, heading float64) bool {
+	a, b := k, k+1
+	if b >= len(stops) {
+		a, b = k-1, k
+	}
+	if a < 0 {
+		return true
+	}
+	course := bearing(stops[a].Lat, stops[a].Lon, stops[b].Lat, stops[b].Lon)
+	diff := math.Abs(math.Mod(heading-course+540, 360) - 180)
+	return diff <= maxHeadingDiff
+}
+
+// bearing is the initial course from the first point to the second in degrees clockwise from north.
+func bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	p1, p2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dLon := (lon2 - lon1) * math.Pi / 180
+	y := math.Sin(dLon) * math.Cos(p2)
+	x := math.Cos(p1)*math.Sin(p2) - math.Sin(p1)*math.Cos(p2)*math.Cos(dLon)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// getStopRoutes returns the stop and every line/direction/pattern serving it.
+func (r *NeoRepo) getStopRoutes(ctx context.Context, stopId string) (*DepartureBoard, []stopRoute, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	var routes []stopRoute
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Stop {id:$stop})
+            OPTIONAL MATCH (l:Line)-[r:SERVES]->(s)
+            WHERE coalesce(l.active, false)
+            WITH s, l, r
+            ORDER BY l.id, coalesce(r.direction, 'OUTBOUND') DESC, coalesce(r.pattern, 'MAIN')
+            RETURN s.id, coalesce(s.name, ''),
+                   collect(CASE WHEN l IS NULL THEN NULL ELSE [l.id, coalesce(l.name, ''),
+                       coalesce(r.direction, 'OUTBOUND'), coalesce(r.pattern, 'MAIN')] END)
+        `, map[string]any{"stop": stopId})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("stop %s not found", stopId)
+		}
+		rec := rs.Record()
+		for _, item := range rec.Values[2].([]any) {
+			v := item.([]any)
+			routes = append(routes, stopRoute{
+				lineID:    v[0].(string),
+				lineName:  v[1].(string),
+				direction: v[2].(string),
+				pattern:   v[3].(string),
+			})
+		}
+		return &DepartureBoard{StopID: rec.Values[0].(string), StopName: rec.Values[1].(string)}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return out.(*DepartureBoard), routes, nil
+}
+
+type vehiclePosition struct {
+	vehicleID string
+	lat, lon  float64
+	direction string
+	heading   *float64
+}
+
+// getLivePositions returns the fresh positions of ACTIVE vehicles keyed by assigned line.
+func (r *NeoRepo) getLivePositions(ctx context.Context, lineIds []string, now time.Time) (map[string][]vehiclePosition, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (v:Vehicle)-[:ASSIGNED_TO]->(l:Line)
+            WHERE l.id IN $lines AND v.status = 'ACTIVE'
+              AND v.last_known_lat IS NOT NULL AND v.last_known_lon IS NOT NULL
+              AND v.last_seen_ts >= $since
+            RETURN l.id, v.vehicle_uuid, v.last_known_lat, v.last_known_lon, coalesce(v.direction, ''), v.heading
+        `, map[string]any{"lines": lineIds, "since": now.Add(-livePositionMaxAge).UnixMilli()})
+		if err != nil {
+			return nil, err
+		}
+		res := map[string][]vehiclePosition{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			p := vehiclePosition{vehicleID: helper.AnyToString(rec.Values[1])}
+			p.lat, _ = toFloat(rec.Values[2])
+			p.lon, _ = toFloat(rec.Values[3])
+			p.direction = rec.Values[4].(string)
+			if h, ok := toFloat(rec.Values[5]); ok {
+				p.heading = &h
+			}
+			res[rec.Values[0].(string)] = append(res[rec.Values[0].(string)], p)
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(map[string][]vehiclePosition), nil
+}
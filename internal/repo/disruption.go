@@ -0,0 +1,304 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"route-graph-service/internal/graph"
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Disruptions close a Stop or a NEXT edge for [start_ts, end_ts) (unix ms, open ended while
+end_ts is null). They are stored as (:Disruption) nodes referencing the element by id so that
+route edits do not lose them.
+*/
+const (
+	DisruptionStop = "STOP"
+	DisruptionEdge = "EDGE"
+)
+
+type Disruption struct {
+	ID      string
+	Kind    string
+	StopID  string
+	FromID  string
+	ToID    string
+	Reason  string
+	StartTs int64
+	EndTs   int64
+}
+
+func (d Disruption) ActiveAt(ts int64) bool {
+	return d.StartTs <= ts && (d.EndTs == 0 || d.EndTs > ts)
+}
+
+type Detour struct {
+	DisruptionIDs []string
+	LineID        string
+	Direction     string
+	Pattern       string
+	// From and To are the last open stop before and the first open stop after the closure.
+	From         string
+	To           string
+	SkippedStops []string
+	Path         []string
+	OriginalTime int32
+	DetourTime   int32
+	Found        bool
+}
+
+// activeClosureWhere filters links rel from a to b that touch a Stop or NEXT edge closed at $at.
+const activeClosureWhere = `
+              none(n IN [a, b] WHERE EXISTS {
+                  MATCH (d:Disruption {kind:'STOP', stop_id:n.id})
+                  WHERE d.start_ts <= $at AND (d.end_ts IS NULL OR d.end_ts > $at)
+              })
+              AND NOT EXISTS {
+                  MATCH (d:Disruption {kind:'EDGE', from_id:a.id, to_id:b.id})
+                  WHERE d.start_ts <= $at AND (d.end_ts IS NULL OR d.end_ts > $at)
+              }`
+
+func (r *NeoRepo) CreateDisruption(ctx context.Context, d Disruption) (*Disruption, error) {
+	var check string
+	switch d.Kind {
+	case DisruptionStop:
+		if d.StopID == "" {
+			return nil, fmt.Errorf("stop_id required for a %s disruption", DisruptionStop)
+		}
+		d.FromID, d.ToID = "", ""
+		check = `MATCH (s:Stop {id:$stop}) RETURN s.id`
+	case DisruptionEdge:
+		if d.FromID == "" || d.ToID == "" {
+			return nil, fmt.Errorf("from_id and to_id required for an %s disruption", DisruptionEdge)
+		}
+		d.StopID = ""
+		check = `MATCH (:Stop {id:$from})-[n:NEXT]->(:Stop {id:$to}) RETURN n.travel_time`
+	default:
+		return nil, fmt.Errorf("invalid disruption kind %q", d.Kind)
+	}
+	if d.EndTs != 0 && d.EndTs <= d.StartTs {
+		return nil, fmt.Errorf("end_ts must be after start_ts")
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		params := map[string]any{"stop": d.StopID, "from": d.FromID, "to": d.ToID}
+		rs, err := tx.Run(ctx, check, params)
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			if d.Kind == DisruptionStop {
+				return nil, fmt.Errorf("stop %s not found", d.StopID)
+			}
+			return nil, fmt.Errorf("NEXT edge %s -> %s not found", d.FromID, d.ToID)
+		}
+		if d.ID != "" {
+			rs, err = tx.Run(ctx, `MATCH (d:Disruption {id:$id}) RETURN d.id`, map[string]any{"id": d.ID})
+			if err != nil {
+				return nil, err
+			}
+			if rs.Next(ctx) {
+				return nil, fmt.Errorf("disruption %s already exists", d.ID)
+			}
+		}
+		props := map[string]any{
+			"kind": d.Kind, "stop_id": d.StopID, "from_id": d.FromID, "to_id": d.ToID,
+			"reason": d.Reason, "start_ts": d.StartTs,
+		}
+		if d.EndTs != 0 {
+			props["end_ts"] = d.EndTs
+		}
+		rs, err = tx.Run(ctx, `
+            CREATE (d:Disruption {id: CASE WHEN $id = '' THEN randomUUID() ELSE $id END})
+            SET d += $props
+            RETURN d.id
+        `, map[string]any{"id": d.ID, "props": props})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, rs.Err()
+		}
+		return rs.Record().Values[0].(string), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.ID = out.(string)
+	return &d, nil
+}
+
+func (r *NeoRepo) GetDisruption(ctx context.Context, id string) (*Disruption, error) {
+	res, err := r.listDisruptions(ctx, id, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("disruption %s not found", id)
+	}
+	return &res[0], nil
+}
+
+// ListDisruptions returns all disruptions, or only the ones active at ts when activeOnly is set.
+func (r *NeoRepo) ListDisruptions(ctx context.Context, ts int64, activeOnly bool) ([]Disruption, error) {
+	return r.listDisruptions(ctx, "", ts, activeOnly)
+}
+
+func (r *NeoRepo) listDisruptions(ctx context.Context, id string, ts int64, activeOnly bool) ([]Disruption, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (d:Disruption)
+            WHERE ($id = '' OR d.id = $id)
+              AND (NOT $active OR (d.start_ts <= $at AND (d.end_ts IS NULL OR d.end_ts > $at)))
+            RETURN d.id, d.kind, coalesce(d.stop_id, ''), coalesce(d.from_id, ''), coalesce(d.to_id, ''),
+                   coalesce(d.reason, ''), d.start_ts, coalesce(d.end_ts, 0)
+            ORDER BY d.start_ts, d.id
+        `, map[string]any{"id": id, "active": activeOnly, "at": ts})
+		if err != nil {
+			return nil, err
+		}
+		var res []Disruption
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res = append(res, Disruption{
+				ID:      rec.Values[0].(string),
+				Kind:    rec.Values[1].(string),
+				StopID:  rec.Values[2].(string),
+				FromID:  rec.Values[3].(string),
+				ToID:    rec.Values[4].(string),
+				Reason:  rec.Values[5].(string),
+				StartTs: helper.AnyToInt64(rec.Values[6]),
+				EndTs:   helper.AnyToInt64(rec.Values[7]),
+			})
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]Disruption), nil
+}
+
+// EndDisruption closes the disruption window at ts; an already finished disruption is left as is.
+func (r *NeoRepo) EndDisruption(ctx context.Context, id string, ts int64) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (d:Disruption {id:$id})
+            SET d.end_ts = CASE WHEN d.end_ts IS NULL OR d.end_ts > $at
+                                THEN CASE WHEN d.start_ts > $at THEN d.start_ts ELSE $at END
+                                ELSE d.end_ts END
+            RETURN d.id
+        `, map[string]any{"id": id, "at": ts})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("disruption %s not found", id)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+/*
+Detours computes, for every line route crossing an element closed at ts, the fastest NEXT
+path (by travel_time) from the last open stop before the closure to the first open stop
+after it, avoiding every closure active at ts.
+*/
+func (r *NeoRepo) Detours(ctx context.Context, ts int64) ([]Detour, error) {
+	active, err := r.ListDisruptions(ctx, ts, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(active) == 0 {
+		return nil, nil
+	}
+	net, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	closedStops := map[string][]string{}
+	closedEdges := map[[2]string][]string{}
+	for _, d := range active {
+		if d.Kind == DisruptionStop {
+			closedStops[d.StopID] = append(closedStops[d.StopID], d.ID)
+		} else {
+			key := [2]string{d.FromID, d.ToID}
+			closedEdges[key] = append(closedEdges[key], d.ID)
+		}
+	}
+	g := net.Graph(nil)
+	skip := func(e graph.Edge) bool {
+		_, edgeClosed := closedEdges[[2]string{e.From, e.To}]
+		_, stopClosed := closedStops[e.To]
+		return edgeClosed || stopClosed
+	}
+
+	var res []Detour
+	for _, rt := range net.Routes {
+		stops := rt.Stops
+		n := len(stops)
+		stopClosed := func(k int) bool { _, ok := closedStops[stops[k]]; return ok }
+		edgeClosed := func(k int) bool { _, ok := closedEdges[[2]string{stops[k], stops[k+1]}]; return ok }
+		for i := 0; i < n; i++ {
+			if !stopClosed(i) && !(i+1 < n && edgeClosed(i)) {
+				continue
+			}
+			// the segment runs from the last open stop to the next open stop past the closure
+			from, to := i, i+1
+			if stopClosed(i) {
+				from, to = i-1, i
+			}
+			for to < n && stopClosed(to) {
+				to++
+			}
+			det := Detour{LineID: rt.LineID, Direction: rt.Direction, Pattern: rt.Pattern}
+			var ids []string
+			for k := max(from, 0); k < to && k < n; k++ {
+				if k > from {
+					det.SkippedStops = append(det.SkippedStops, stops[k])
+					ids = append(ids, closedStops[stops[k]]...)
+				}
+				if k+1 < n {
+					ids = append(ids, closedEdges[[2]string{stops[k], stops[k+1]}]...)
+				}
+			}
+			det.DisruptionIDs = uniqueSorted(ids)
+			if from >= 0 && to < n {
+				det.From, det.To = stops[from], stops[to]
+				for k := from; k < to; k++ {
+					det.OriginalTime += net.Edges[[2]string{stops[k], stops[k+1]}].TravelTime
+				}
+				if path, cost, ok := g.ShortestPath(det.From, det.To, skip); ok {
+					det.Path, det.DetourTime, det.Found = path, int32(cost), true
+				}
+			}
+			res = append(res, det)
+			i = to - 1
+		}
+	}
+	return res, nil
+}
+
+func uniqueSorted(ids []string) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,168 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// LineSheet is what a printed route sheet shows for one line; Service holds its headways.
+type LineSheet struct {
+	LineID   string
+	Name     string
+	Mode     string
+	Active   bool
+	Service  LineService
+	Routes   []SheetRoute
+	Vehicles []SheetVehicle
+}
+
+type SheetRoute struct {
+	Direction string
+	Pattern   string
+	Stops     []SheetStop
+}
+
+/*
+SheetStop carries running totals from the first stop of the route. Gap marks a stop reached
+without a NEXT edge from the previous one; the totals then do not include that leg.
+*/
+type SheetStop struct {
+	Order      int
+	StopID     string
+	Name       string
+	Zone       string
+	Shelter    bool
+	TravelTime int32
+	Distance   int32
+	Gap        bool
+}
+
+type SheetVehicle struct {
+	VehicleUUID string
+	ID          string
+	Capacity    int32
+	Status      string
+}
+
+func (r *NeoRepo) LineSheet(ctx context.Context, lineId string) (*LineSheet, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})
+            RETURN coalesce(l.name, ''), coalesce(l.mode, ''), coalesce(l.active, false)
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("line %s not found", lineId)
+		}
+		rec := rs.Record()
+		sheet := &LineSheet{
+			LineID: lineId,
+			Name:   rec.Values[0].(string),
+			Mode:   rec.Values[1].(string),
+			Active: rec.Values[2].(bool),
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (l:Line {id:$line})-[r:SERVES]->(s:Stop)
+            WITH l, r, s, coalesce(r.direction, 'OUTBOUND') AS direction, coalesce(r.pattern, 'MAIN') AS pattern
+            OPTIONAL MATCH (l)-[r0:SERVES]->(prev:Stop)
+            WHERE coalesce(r0.direction, 'OUTBOUND') = direction AND coalesce(r0.pattern, 'MAIN') = pattern
+              AND r0.order = r.order - 1
+            OPTIONAL MATCH (prev)-[n:NEXT]->(s)
+            RETURN direction, pattern, r.order, s.id, coalesce(s.name, ''), coalesce(s.zone, ''),
+                   coalesce(s.shelter, false), prev IS NOT NULL, n IS NOT NULL,
+                   coalesce(n.travel_time, 0), coalesce(n.distance, 0)
+            ORDER BY direction DESC, pattern = 'MAIN' DESC, pattern, r.order
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			dir, pat := rec.Values[0].(string), rec.Values[1].(string)
+			if n := len(sheet.Routes); n == 0 || sheet.Routes[n-1].Direction != dir || sheet.Routes[n-1].Pattern != pat {
+				sheet.Routes = append(sheet.Routes, SheetRoute{Direction: dir, Pattern: pat})
+			}
+			rt := &sheet.Routes[len(sheet.Routes)-1]
+			st := SheetStop{
+				Order:   int(helper.AnyToInt64(rec.Values[2])),
+				StopID:  rec.Values[3].(string),
+				Name:    rec.Values[4].(string),
+				Zone:    helper.AnyToString(rec.Values[5]),
+				Shelter: rec.Values[6].(bool),
+			}
+			if k := len(rt.Stops); k > 0 {
+				prev := rt.Stops[k-1]
+				st.TravelTime, st.Distance = prev.TravelTime, prev.Distance
+				if rec.Values[7].(bool) && rec.Values[8].(bool) {
+					st.TravelTime += helper.AnyToInt32(rec.Values[9])
+					st.Distance += helper.AnyToInt32(rec.Values[10])
+				} else {
+					st.Gap = true
+				}
+			}
+			rt.Stops = append(rt.Stops, st)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (v:Vehicle)-[:ASSIGNED_TO]->(:Line {id:$line})
+            RETURN v.vehicle_uuid, coalesce(v.id, ''), v.capacity, coalesce(v.status, '')
+            ORDER BY v.id
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			sheet.Vehicles = append(sheet.Vehicles, SheetVehicle{
+				VehicleUUID: rec.Values[0].(string),
+				ID:          rec.Values[1].(string),
+				Capacity:    helper.AnyToInt32(rec.Values[2]),
+				Status:      rec.Values[3].(string),
+			})
+		}
+		return sheet, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	sheet := out.(*LineSheet)
+	ls, err := r.GetLineService(ctx, lineId)
+	if err != nil {
+		return nil, err
+	}
+	sheet.Service = *ls
+	return sheet, nil
+}
+
+// ActiveLineIDs lists the lines marked active, in id order.
+func (r *NeoRepo) ActiveLineIDs(ctx context.Context) ([]string, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `MATCH (l:Line) WHERE coalesce(l.active, false) RETURN l.id ORDER BY l.id`, nil)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for rs.Next(ctx) {
+			ids = append(ids, rs.Record().Values[0].(string))
+		}
+		return ids, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]string), nil
+}
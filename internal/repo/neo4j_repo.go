@@ -1,10 +1,14 @@
 package repo
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	helper "route-graph-service/util"
+
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
@@ -297,14 +301,37 @@ func (r *NeoRepo) DeleteNext(ctx context.Context, from, to string) error {
 }
 
 /* SERVES */
-func (r *NeoRepo) GetServes(ctx context.Context, lineId, stopId string) (map[string]any, error) {
+
+// SERVES edges without a direction belong to the outbound route, without a pattern to the main one.
+const (
+	DirectionOutbound = "OUTBOUND"
+	DirectionInbound  = "INBOUND"
+	PatternMain       = "MAIN"
+)
+
+func direction(dir string) string {
+	if dir == "" {
+		return DirectionOutbound
+	}
+	return dir
+}
+
+func pattern(name string) string {
+	if name == "" {
+		return PatternMain
+	}
+	return name
+}
+
+func (r *NeoRepo) GetServes(ctx context.Context, lineId, stopId, dir, pat string) (map[string]any, error) {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close(ctx)
 	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		rs, err := tx.Run(ctx, `
 			MATCH (l:Line {id:$line})-[r:SERVES]->(s:Stop {id:$stop})
+			WHERE coalesce(r.direction, 'OUTBOUND') = $dir AND coalesce(r.pattern, 'MAIN') = $pattern
 			RETURN r, l.id AS lineId, s.id AS stopId LIMIT 1
-		`, map[string]any{"line": lineId, "stop": stopId})
+		`, map[string]any{"line": lineId, "stop": stopId, "dir": direction(dir), "pattern": pattern(pat)})
 		if err != nil {
 			return nil, err
 		}
@@ -328,16 +355,19 @@ func (r *NeoRepo) GetServes(ctx context.Context, lineId, stopId string) (map[str
 	return out.(map[string]any), nil
 }
 
-func (r *NeoRepo) GetServesList(ctx context.Context, lineId string) ([]map[string]any, error) {
+// GetServesList returns the ordered stops of a line; an empty dir or pattern lists all of them.
+func (r *NeoRepo) GetServesList(ctx context.Context, lineId, dir, pat string) ([]map[string]any, error) {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close(ctx)
 
 	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		rs, err := tx.Run(ctx, `
 			MATCH (l:Line {id:$line})-[r:SERVES]->(s:Stop)
-			RETURN s.id AS stopId, r.order AS order
-			ORDER BY r.order
-		`, map[string]any{"line": lineId})
+			WITH s, r, coalesce(r.direction, 'OUTBOUND') AS direction, coalesce(r.pattern, 'MAIN') AS pattern
+			WHERE ($dir = '' OR direction = $dir) AND ($pattern = '' OR pattern = $pattern)
+			RETURN s.id AS stopId, r.order AS order, direction, pattern, r.average_boardings AS boardings
+			ORDER BY direction DESC, pattern = 'MAIN' DESC, pattern, r.order
+		`, map[string]any{"line": lineId, "dir": dir, "pattern": pat})
 		if err != nil {
 			return nil, err
 		}
@@ -346,9 +376,15 @@ func (r *NeoRepo) GetServesList(ctx context.Context, lineId string) ([]map[strin
 			rec := rs.Record()
 			stopId, _ := rec.Get("stopId")
 			ord, _ := rec.Get("order")
+			dir, _ := rec.Get("direction")
+			pat, _ := rec.Get("pattern")
+			boardings, _ := rec.Get("boardings")
 			res = append(res, map[string]any{
-				"stopId": stopId.(string),
-				"order":  ord.(int64),
+				"stopId":    stopId.(string),
+				"order":     ord.(int64),
+				"direction": dir.(string),
+				"pattern":   pat.(string),
+				"boardings": boardings,
 			})
 		}
 		if err := rs.Err(); err != nil {
@@ -365,23 +401,29 @@ func (r *NeoRepo) GetServesList(ctx context.Context, lineId string) ([]map[strin
 	return out.([]map[string]any), nil
 }
 
-func (r *NeoRepo) CreateServes(ctx context.Context, lineId, stopId string, order int32) error {
+// CreateServes links a line to a stop; boardings may be nil when unknown.
+func (r *NeoRepo) CreateServes(ctx context.Context, lineId, stopId, dir, pat string, order int32, boardings *int32) error {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		_, err := tx.Run(ctx, `MATCH (l:Line {id:$l}), (s:Stop {id:$s}) CREATE (l)-[:SERVES {order:$o}]->(s)`, map[string]any{"l": lineId, "s": stopId, "o": order})
+		var b any
+		if boardings != nil {
+			b = *boardings
+		}
+		_, err := tx.Run(ctx, `MATCH (l:Line {id:$l}), (s:Stop {id:$s}) CREATE (l)-[r:SERVES {order:$o, direction:$d, pattern:$p}]->(s) SET r.average_boardings = $b`, map[string]any{"l": lineId, "s": stopId, "o": order, "d": direction(dir), "p": pattern(pat), "b": b})
 		return nil, err
 	})
 	return err
 }
 
-func (r *NeoRepo) UpdateServes(ctx context.Context, lineId, stopId string, props map[string]any) error {
-	params := map[string]any{"lineId": lineId, "stopId": stopId, "props": props}
+func (r *NeoRepo) UpdateServes(ctx context.Context, lineId, stopId, dir, pat string, props map[string]any) error {
+	params := map[string]any{"lineId": lineId, "stopId": stopId, "dir": direction(dir), "pattern": pattern(pat), "props": props}
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		_, err := tx.Run(ctx,
 			`MATCH (l:Line {id:$lineId})-[r:SERVES]->(s:Stop {id:$stopId})
+             WHERE coalesce(r.direction, 'OUTBOUND') = $dir AND coalesce(r.pattern, 'MAIN') = $pattern
              SET r += $props
              RETURN r`,
 			params)
@@ -390,11 +432,11 @@ func (r *NeoRepo) UpdateServes(ctx context.Context, lineId, stopId string, props
 	return err
 }
 
-func (r *NeoRepo) DeleteServes(ctx context.Context, lineId, stopId string) error {
+func (r *NeoRepo) DeleteServes(ctx context.Context, lineId, stopId, dir, pat string) error {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
 	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		_, err := tx.Run(ctx, `MATCH (l:Line {id:$l})-[r:SERVES]->(s:Stop {id:$s}) DELETE r`, map[string]any{"l": lineId, "s": stopId})
+		_, err := tx.Run(ctx, `MATCH (l:Line {id:$l})-[r:SERVES]->(s:Stop {id:$s}) WHERE coalesce(r.direction, 'OUTBOUND') = $d AND coalesce(r.pattern, 'MAIN') = $p DELETE r`, map[string]any{"l": lineId, "s": stopId, "d": direction(dir), "p": pattern(pat)})
 		return nil, err
 	})
 	return err
@@ -544,8 +586,10 @@ func (r *NeoRepo) AssignNearestIdleVehicle(ctx context.Context, lineId string) (
 	defer session.Close(ctx)
 	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		rs, err := tx.Run(ctx, `
-            MATCH (l:Line {id:$line})-[:SERVES {order:1}]->(s:Stop)
-            RETURN s.lat AS lat, s.lon AS lon LIMIT 1
+            MATCH (l:Line {id:$line})-[r:SERVES {order:1}]->(s:Stop)
+            RETURN s.lat AS lat, s.lon AS lon
+            ORDER BY coalesce(r.direction, 'OUTBOUND') = 'OUTBOUND' DESC, coalesce(r.pattern, 'MAIN') = 'MAIN' DESC
+            LIMIT 1
         `, map[string]any{"line": lineId})
 		if err != nil {
 			return nil, err
@@ -594,48 +638,6 @@ func (r *NeoRepo) AssignNearestIdleVehicle(ctx context.Context, lineId string) (
 	return out.(map[string]any), nil
 }
 
-/*
-2. Recalibrate NEXT
-*/
-func (r *NeoRepo) RecalibrateNext(ctx context.Context, from, to string, observed int32) (map[string]any, error) {
-	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		rs, err := tx.Run(ctx, `
-            MATCH (a:Stop {id:$from})-[r:NEXT]->(b:Stop {id:$to})
-            WHERE r.travel_time IS NOT NULL
-            WITH r, r.travel_time AS cur, $obs AS observed
-            WHERE observed > cur * 1.2
-            SET r.travel_time = observed, r.last_calibrated = timestamp(), r.calibration_count = coalesce(r.calibration_count,0) + 1
-            RETURN r.travel_time AS new_travel_time, r.calibration_count AS calibration_count, r.last_calibrated AS last_calibrated
-        `, map[string]any{"from": from, "to": to, "obs": observed})
-		if err != nil {
-			return nil, err
-		}
-		if rs.Next(ctx) {
-			rec := rs.Record()
-			return map[string]any{
-				"new":  rec.Values[0].(int64),
-				"cnt":  rec.Values[1].(int64),
-				"when": rec.Values[2].(int64),
-			}, nil
-		}
-
-		rs2, err := tx.Run(ctx, `MATCH (a:Stop {id:$from})-[r:NEXT]->(b:Stop {id:$to}) RETURN r.travel_time AS cur`, map[string]any{"from": from, "to": to})
-		if err != nil {
-			return nil, err
-		}
-		if rs2.Next(ctx) {
-			return map[string]any{"new": rs2.Record().Values[0].(int64)}, nil
-		}
-		return nil, fmt.Errorf("edge not found")
-	})
-	if err != nil {
-		return nil, err
-	}
-	return out.(map[string]any), nil
-}
-
 /* 3) TopPairs analytic */
 func (r *NeoRepo) TopPairs(ctx context.Context, limit int) ([]map[string]any, error) {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
@@ -706,51 +708,210 @@ func (r *NeoRepo) DepotsIdleStats(ctx context.Context, limit int) ([]map[string]
 }
 
 /* 5) ShortestPath utility */
-func (r *NeoRepo) ShortestPath(ctx context.Context, start, end string, maxHops int) ([]string, int, error) {
+
+/*
+PathFilter restricts ShortestPath to the NEXT edges travelled by one line (and direction/pattern).
+Stops and edges closed by a disruption active at At (unix ms, 0 = now) are always avoided.
+Walk also lets the path use WALK links as transfers between nearby stops, one walk between rides.
+*/
+type PathFilter struct {
+	LineID    string
+	Direction string
+	Pattern   string
+	At        int64
+	Walk      bool
+}
+
+type PathResult struct {
+	Stops []string `json:"node_ids"`
+	Hops  int      `json:"hops"`
+	// Links holds the relationship type (NEXT or WALK) of every hop.
+	Links []string `json:"link_types"`
+	// TravelTime uses the edge profiles along the way for a departure at Departure.
+	TravelTime int32     `json:"travel_time"`
+	Departure  time.Time `json:"departure"`
+}
+
+/*
+ShortestPath returns the path arriving first when leaving start at f.At. It loads the links the
+filter allows and runs Dijkstra over arrival times, pricing each NEXT edge with its profile at the
+time it is reached, so a slow peak edge can lose to a longer but faster detour. maxHops <= 0 means
+no limit.
+*/
+func (r *NeoRepo) ShortestPath(ctx context.Context, start, end string, maxHops int, f PathFilter) (*PathResult, error) {
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	at := f.At
+	if at == 0 {
+		at = time.Now().UnixMilli()
+	}
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close(ctx)
 	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		query := fmt.Sprintf(`
-            MATCH (a:Stop {id:$start}), (b:Stop {id:$end})
-            MATCH p = shortestPath((a)-[:NEXT*..%d]->(b))
-            RETURN [n IN nodes(p) | n.id] AS ids, length(p) AS hops
-        `, maxHops)
-		rs, err := tx.Run(ctx, query, map[string]any{"start": start, "end": end})
+		where := `
+            WHERE (type(rel) = 'NEXT' OR $walk) AND` + activeClosureWhere
+		if f.LineID != "" {
+			where += `
+              AND (type(rel) = 'WALK' OR EXISTS {
+                MATCH (l:Line {id:$line})-[r1:SERVES]->(a), (l)-[r2:SERVES]->(b)
+                WHERE r2.order = r1.order + 1
+                  AND coalesce(r1.direction, 'OUTBOUND') = coalesce(r2.direction, 'OUTBOUND')
+                  AND coalesce(r1.pattern, 'MAIN') = coalesce(r2.pattern, 'MAIN')
+                  AND ($dir = '' OR coalesce(r1.direction, 'OUTBOUND') = $dir)
+                  AND ($pattern = '' OR coalesce(r1.pattern, 'MAIN') = $pattern)
+            })`
+		}
+		rs, err := tx.Run(ctx, `
+            MATCH (a:Stop)-[rel:NEXT|WALK]->(b:Stop)`+where+`
+            RETURN a.id, b.id, type(rel), properties(rel)
+            ORDER BY a.id, b.id, type(rel)
+        `, map[string]any{"line": f.LineID, "dir": f.Direction, "pattern": f.Pattern, "at": at, "walk": f.Walk})
 		if err != nil {
 			return nil, err
 		}
-		if rs.Next(ctx) {
+		links := map[string][]pathLink{}
+		for rs.Next(ctx) {
 			rec := rs.Record()
-			idsAny := rec.Values[0].([]any)
-			ids := make([]string, 0, len(idsAny))
-			for _, v := range idsAny {
-				ids = append(ids, v.(string))
+			props := rec.Values[3].(map[string]any)
+			l := pathLink{To: rec.Values[1].(string), Type: rec.Values[2].(string)}
+			if l.Type == "WALK" {
+				l.Base = helper.AnyToInt32(props["walk_time"])
+			} else {
+				l.Base, l.Profile = helper.AnyToInt32(props["travel_time"]), profileFromProps(props)
 			}
-			hops := int(rec.Values[1].(int64))
-			return map[string]any{"ids": ids, "hops": hops}, nil
+			from := rec.Values[0].(string)
+			links[from] = append(links[from], l)
 		}
-		return nil, fmt.Errorf("no path found")
+		return links, rs.Err()
 	})
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	res, ok := timedShortestPath(out.(map[string][]pathLink), start, end, maxHops, time.UnixMilli(at), holidays)
+	if !ok {
+		return nil, fmt.Errorf("no path found")
+	}
+	return res, nil
+}
+
+// pathLink is a NEXT or WALK link out of a stop; Base is travel_time or walk_time in seconds.
+type pathLink struct {
+	To      string
+	Type    string
+	Base    int32
+	Profile EdgeProfile
+}
+
+func (l pathLink) cost(t time.Time, holidays map[string]bool) int32 {
+	if l.Type == "WALK" {
+		return l.Base
+	}
+	return l.Profile.TravelTime(l.Base, t, holidays)
+}
+
+// walked marks a label reached over a WALK link; the next link must then be a ride.
+type pathState struct {
+	stop   string
+	hops   int
+	walked bool
+}
+
+type pathLabel struct {
+	pathState
+	arrival int64
+	prev    *pathLabel
+	link    string
+}
+
+/*
+timedShortestPath is Dijkstra over arrival seconds after departure. Labels carry the hop count and
+whether they end in a walk, so maxHops is exact and two WALK links never follow each other: a
+label is only dropped when the stop was already reached as early with no more hops and, for a
+label that may still walk, not by walking.
+*/
+func timedShortestPath(links map[string][]pathLink, start, end string, maxHops int, departure time.Time, holidays map[string]bool) (*PathResult, bool) {
+	settled := map[bool]map[string]int{false: {}, true: {}}
+	dominated := func(l *pathLabel) bool {
+		if h, ok := settled[false][l.stop]; ok && h <= l.hops {
+			return true
+		}
+		h, ok := settled[true][l.stop]
+		return l.walked && ok && h <= l.hops
+	}
+	best := map[pathState]int64{{start, 0, false}: 0}
+	pq := &labelQueue{&pathLabel{pathState: pathState{start, 0, false}}}
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(*pathLabel)
+		if dominated(cur) {
+			continue
+		}
+		settled[cur.walked][cur.stop] = cur.hops
+		if cur.stop == end {
+			res := &PathResult{Hops: cur.hops, TravelTime: int32(cur.arrival), Departure: departure}
+			for l := cur; l != nil; l = l.prev {
+				res.Stops = append(res.Stops, l.stop)
+				if l.prev != nil {
+					res.Links = append(res.Links, l.link)
+				}
+			}
+			slices.Reverse(res.Stops)
+			slices.Reverse(res.Links)
+			return res, true
+		}
+		if maxHops > 0 && cur.hops >= maxHops {
+			continue
+		}
+		now := departure.Add(time.Duration(cur.arrival) * time.Second)
+		for _, l := range links[cur.stop] {
+			if cur.walked && l.Type == "WALK" {
+				continue
+			}
+			next := pathState{l.To, cur.hops + 1, l.Type == "WALK"}
+			arrival := cur.arrival + int64(l.cost(now, holidays))
+			if d, ok := best[next]; ok && d <= arrival {
+				continue
+			}
+			best[next] = arrival
+			heap.Push(pq, &pathLabel{pathState: next, arrival: arrival, prev: cur, link: l.Type})
+		}
 	}
-	m := out.(map[string]any)
-	return m["ids"].([]string), m["hops"].(int), nil
+	return nil, false
+}
+
+// labelQueue orders labels by arrival, then by fewer hops.
+type labelQueue []*pathLabel
+
+func (q labelQueue) Len() int { return len(q) }
+func (q labelQueue) Less(i, j int) bool {
+	if q[i].arrival != q[j].arrival {
+		return q[i].arrival < q[j].arrival
+	}
+	return q[i].hops < q[j].hops
+}
+func (q labelQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *labelQueue) Push(x any)   { *q = append(*q, x.(*pathLabel)) }
+func (q *labelQueue) Pop() any {
+	old := *q
+	l := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return l
 }
 
 /* Methods for generating report*/
 type Vehicle struct {
-	UUID     string
-	Status   string
-	Capacity int
-	Depot    string
+	UUID     string `json:"vehicle_uuid"`
+	Status   string `json:"status"`
+	Capacity int    `json:"capacity"`
+	Depot    string `json:"depot"`
 }
 
 type Stop struct {
-	ID      string
-	Name    string
-	Zone    string
-	Shelter bool
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Zone    string `json:"zone"`
+	Shelter bool   `json:"shelter"`
 }
 
 func (r *NeoRepo) GetVehiclesByDepot() (map[string][]Vehicle, error) {
@@ -808,6 +969,50 @@ func (r *NeoRepo) GetStopsByZone() (map[string][]Stop, error) {
 	return result, err
 }
 
+type LinePattern struct {
+	LineID    string `json:"line_id"`
+	Name      string `json:"name"`
+	Direction string `json:"direction"`
+	Stops     int    `json:"stops"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+func (r *NeoRepo) GetLinePatterns() ([]LinePattern, error) {
+	ctx := context.Background()
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	var result []LinePattern
+	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `
+            MATCH (l:Line)-[r:SERVES]->(:Stop)
+            WITH l, coalesce(r.pattern, 'MAIN') AS name, coalesce(r.direction, 'OUTBOUND') AS direction, count(r) AS stops
+            OPTIONAL MATCH (l)-[:HAS_PATTERN]->(p:Pattern {name:name})
+            WHERE coalesce(p.direction, 'OUTBOUND') = direction
+            RETURN l.id, name, direction, stops, coalesce(p.start_time, ''), coalesce(p.end_time, '')
+            ORDER BY l.id, direction DESC, name = 'MAIN' DESC, name
+        `
+		rs, err := tx.Run(ctx, query, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			result = append(result, LinePattern{
+				LineID:    rec.Values[0].(string),
+				Name:      rec.Values[1].(string),
+				Direction: rec.Values[2].(string),
+				Stops:     int(rec.Values[3].(int64)),
+				StartTime: rec.Values[4].(string),
+				EndTime:   rec.Values[5].(string),
+			})
+		}
+		return nil, rs.Err()
+	})
+	return result, err
+}
+
 func (r *NeoRepo) GetTopConnectedStops(ctx context.Context, limit int) ([]map[string]any, error) {
 	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close(ctx)
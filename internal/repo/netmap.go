@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"context"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type DepotLocation struct {
+	ID   string
+	Name string
+	Lat  float64
+	Lon  float64
+}
+
+// DepotLocations lists depots that have coordinates, for drawing them on the network map.
+func (r *NeoRepo) DepotLocations(ctx context.Context) ([]DepotLocation, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (d:Depot) WHERE d.lat IS NOT NULL AND d.lon IS NOT NULL
+            RETURN d.id, coalesce(d.name, ''), d.lat, d.lon
+            ORDER BY d.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res []DepotLocation
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			d := DepotLocation{ID: helper.AnyToString(rec.Values[0]), Name: rec.Values[1].(string)}
+			d.Lat, _ = toFloat(rec.Values[2])
+			d.Lon, _ = toFloat(rec.Values[3])
+			res = append(res, d)
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]DepotLocation), nil
+}
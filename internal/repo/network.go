@@ -0,0 +1,353 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"route-graph-service/internal/graph"
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Network is an in-memory snapshot of stops, NEXT edges, line routes and line service used by
+the analyses that need the whole graph at once (detours, scenarios, resilience).
+*/
+type Network struct {
+	Stops  map[string]NetStop
+	Edges  map[[2]string]Edge
+	Routes []LineRoute
+	Lines  map[string]*NetLine
+	// Profiles holds the time-of-day profiles of the edges that have one; scenarios keep them as loaded.
+	Profiles map[[2]string]EdgeProfile
+}
+
+type NetStop struct {
+	ID   string  `json:"id"`
+	Name string  `json:"name"`
+	Zone string  `json:"zone"`
+	Lat  float64 `json:"lat"`
+	Lon  float64 `json:"lon"`
+}
+
+type NetLine struct {
+	ID       string
+	Name     string
+	Active   bool
+	Assigned int
+	Service  LineService
+}
+
+type LineRoute struct {
+	LineID    string
+	Direction string
+	Pattern   string
+	Stops     []string
+}
+
+// LoadNetwork reads the whole network in one read transaction so all parts are consistent.
+func (r *NeoRepo) LoadNetwork(ctx context.Context) (*Network, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		n := &Network{Stops: map[string]NetStop{}, Edges: map[[2]string]Edge{}, Lines: map[string]*NetLine{}, Profiles: map[[2]string]EdgeProfile{}}
+		rs, err := tx.Run(ctx, `MATCH (s:Stop) RETURN s.id, coalesce(s.name, ''), coalesce(s.zone, ''), s.lat, s.lon`, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			st := NetStop{ID: helper.AnyToString(rec.Values[0]), Name: rec.Values[1].(string), Zone: rec.Values[2].(string)}
+			st.Lat, _ = toFloat(rec.Values[3])
+			st.Lon, _ = toFloat(rec.Values[4])
+			n.Stops[st.ID] = st
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (a:Stop)-[e:NEXT]->(b:Stop)
+            RETURN a.id, b.id, coalesce(e.travel_time, 0), coalesce(e.distance, 0), properties(e)
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			e := Edge{
+				From:       rec.Values[0].(string),
+				To:         rec.Values[1].(string),
+				TravelTime: helper.AnyToInt32(rec.Values[2]),
+				Distance:   helper.AnyToInt32(rec.Values[3]),
+			}
+			n.Edges[[2]string{e.From, e.To}] = e
+			if p := profileFromProps(rec.Values[4].(map[string]any)); p != nil {
+				n.Profiles[[2]string{e.From, e.To}] = p
+			}
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (l:Line)-[r:SERVES]->(s:Stop)
+            WITH l, coalesce(r.direction, 'OUTBOUND') AS dir, coalesce(r.pattern, 'MAIN') AS pattern, r, s
+            ORDER BY r.order
+            RETURN l.id, dir, pattern, collect(s.id)
+            ORDER BY l.id, dir DESC, pattern
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			rt := LineRoute{LineID: rec.Values[0].(string), Direction: rec.Values[1].(string), Pattern: rec.Values[2].(string)}
+			for _, v := range rec.Values[3].([]any) {
+				rt.Stops = append(rt.Stops, v.(string))
+			}
+			n.Routes = append(n.Routes, rt)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (l:Line)
+            OPTIONAL MATCH (v:Vehicle)-[:ASSIGNED_TO]->(l)
+            WITH l, count(DISTINCT v) AS assigned
+            OPTIONAL MATCH (l)-[:HAS_PERIOD]->(p:ServicePeriod)
+            WITH l, assigned, p
+            ORDER BY p.day_type, p.start_time
+            RETURN l.id, coalesce(l.name, ''), coalesce(l.active, false), l.frequency_mins, assigned,
+                   collect(CASE WHEN p IS NULL THEN NULL ELSE properties(p) END)
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			l := &NetLine{
+				ID:       rec.Values[0].(string),
+				Name:     rec.Values[1].(string),
+				Active:   rec.Values[2].(bool),
+				Assigned: int(helper.AnyToInt64(rec.Values[4])),
+			}
+			l.Service = LineService{LineID: l.ID, FrequencyMins: helper.AnyToInt32(rec.Values[3])}
+			for _, item := range rec.Values[5].([]any) {
+				m := item.(map[string]any)
+				l.Service.Periods = append(l.Service.Periods, ServicePeriod{
+					DayType:       helper.AnyToString(m["day_type"]),
+					StartTime:     helper.AnyToString(m["start_time"]),
+					EndTime:       helper.AnyToString(m["end_time"]),
+					FrequencyMins: helper.AnyToInt32(m["frequency_mins"]),
+				})
+			}
+			n.Lines[l.ID] = l
+		}
+		return n, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Network), nil
+}
+
+func (n *Network) Clone() *Network {
+	c := &Network{
+		Stops:  make(map[string]NetStop, len(n.Stops)),
+		Edges:  make(map[[2]string]Edge, len(n.Edges)),
+		Routes: make([]LineRoute, len(n.Routes)),
+		Lines:  make(map[string]*NetLine, len(n.Lines)),
+		// profiles are never modified, so the clone shares them
+		Profiles: n.Profiles,
+	}
+	for k, v := range n.Stops {
+		c.Stops[k] = v
+	}
+	for k, v := range n.Edges {
+		c.Edges[k] = v
+	}
+	for i, rt := range n.Routes {
+		rt.Stops = append([]string(nil), rt.Stops...)
+		c.Routes[i] = rt
+	}
+	for k, v := range n.Lines {
+		l := *v
+		l.Service.Periods = append([]ServicePeriod(nil), v.Service.Periods...)
+		c.Lines[k] = &l
+	}
+	return c
+}
+
+func (n *Network) StopIDs() []string {
+	ids := make([]string, 0, len(n.Stops))
+	for id := range n.Stops {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (n *Network) SortedEdges() []Edge {
+	res := make([]Edge, 0, len(n.Edges))
+	for _, e := range n.Edges {
+		res = append(res, e)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].From != res[j].From {
+			return res[i].From < res[j].From
+		}
+		return res[i].To < res[j].To
+	})
+	return res
+}
+
+// Graph builds the directed NEXT graph; weight maps an edge to its cost (nil = travel_time).
+func (n *Network) Graph(weight func(Edge) float64) *graph.Graph {
+	if weight == nil {
+		weight = func(e Edge) float64 { return float64(e.TravelTime) }
+	}
+	g := graph.New()
+	for _, id := range n.StopIDs() {
+		g.AddNode(id)
+	}
+	for _, e := range n.SortedEdges() {
+		g.AddEdge(e.From, e.To, weight(e))
+	}
+	return g
+}
+
+/*
+ShortestPath mirrors the timed planner behind the ShortestPath query over NEXT edges: the path
+arriving first when leaving at departure, each edge priced by its profile when it is reached.
+*/
+func (n *Network) ShortestPath(start, end string, departure time.Time, holidays map[string]bool) ([]string, int, int32, bool) {
+	links := map[string][]pathLink{}
+	for _, e := range n.SortedEdges() {
+		links[e.From] = append(links[e.From], pathLink{To: e.To, Type: "NEXT", Base: e.TravelTime, Profile: n.Profiles[[2]string{e.From, e.To}]})
+	}
+	res, ok := timedShortestPath(links, start, end, 0, departure, holidays)
+	if !ok {
+		return nil, 0, 0, false
+	}
+	return res.Stops, res.Hops, res.TravelTime, true
+}
+
+// TopPairs mirrors the TopPairs query: NEXT pairs ranked by the number of lines serving both stops.
+func (n *Network) TopPairs(limit int) []map[string]any {
+	served := map[string]map[string]bool{}
+	for _, rt := range n.Routes {
+		for _, id := range rt.Stops {
+			if served[id] == nil {
+				served[id] = map[string]bool{}
+			}
+			served[id][rt.LineID] = true
+		}
+	}
+	var res []map[string]any
+	for _, e := range n.SortedEdges() {
+		if e.From == e.To {
+			continue
+		}
+		lines := int64(0)
+		for l := range served[e.From] {
+			if served[e.To][l] {
+				lines++
+			}
+		}
+		if lines > 0 {
+			res = append(res, map[string]any{"from": e.From, "to": e.To, "lines": lines})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i]["lines"].(int64) > res[j]["lines"].(int64) })
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+// TopConnectedStops mirrors GetTopConnectedStops: stops ranked by distinct NEXT neighbours.
+func (n *Network) TopConnectedStops(limit int) []map[string]any {
+	in := map[string]map[string]bool{}
+	out := map[string]map[string]bool{}
+	for _, e := range n.Edges {
+		if out[e.From] == nil {
+			out[e.From] = map[string]bool{}
+		}
+		if in[e.To] == nil {
+			in[e.To] = map[string]bool{}
+		}
+		out[e.From][e.To] = true
+		in[e.To][e.From] = true
+	}
+	var res []map[string]any
+	for _, id := range n.StopIDs() {
+		degree := int64(len(out[id]) + len(in[id]))
+		if degree > 0 {
+			res = append(res, map[string]any{"stop_id": id, "stop_name": n.Stops[id].Name, "degree": degree})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i]["degree"].(int64) > res[j]["degree"].(int64) })
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+// Fleet mirrors GetLineFleet: MAIN-pattern round trip time and assigned vehicles per line.
+func (n *Network) Fleet() []LineFleet {
+	run := map[string]int{}
+	dirs := map[string]map[string]bool{}
+	for _, rt := range n.Routes {
+		if rt.Pattern != PatternMain {
+			continue
+		}
+		if dirs[rt.LineID] == nil {
+			dirs[rt.LineID] = map[string]bool{}
+		}
+		dirs[rt.LineID][rt.Direction] = true
+		for i := 1; i < len(rt.Stops); i++ {
+			run[rt.LineID] += int(n.Edges[[2]string{rt.Stops[i-1], rt.Stops[i]}].TravelTime)
+		}
+	}
+	var res []LineFleet
+	for _, l := range n.Lines {
+		cycle := run[l.ID]
+		if len(dirs[l.ID]) < 2 {
+			cycle *= 2
+		}
+		res = append(res, LineFleet{LineID: l.ID, Name: l.Name, Active: l.Active, CycleSeconds: cycle, AssignedCount: l.Assigned})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].LineID < res[j].LineID })
+	return res
+}
+
+func (n *Network) Services() map[string]LineService {
+	res := make(map[string]LineService, len(n.Lines))
+	for id, l := range n.Lines {
+		res[id] = l.Service
+	}
+	return res
+}
+
+// RouteGaps describes consecutive route stops that are missing or not joined by a NEXT edge.
+func (n *Network) RouteGaps() []string {
+	var res []string
+	for _, rt := range n.Routes {
+		for i, id := range rt.Stops {
+			if _, ok := n.Stops[id]; !ok {
+				res = append(res, fmt.Sprintf("%s %s/%s: stop %s does not exist", rt.LineID, rt.Direction, rt.Pattern, id))
+			}
+			if i > 0 {
+				if _, ok := n.Edges[[2]string{rt.Stops[i-1], id}]; !ok {
+					res = append(res, fmt.Sprintf("%s %s/%s: missing NEXT %s -> %s", rt.LineID, rt.Direction, rt.Pattern, rt.Stops[i-1], id))
+				}
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Route patterns are named stop sequences of a line (short-turn, depot run...). The stops of a
+pattern are SERVES edges carrying its name in SERVES.pattern; (:Line)-[:HAS_PATTERN]->(:Pattern)
+holds the optional active window. MAIN is the implicit full route of every direction.
+*/
+
+// UpsertPattern keeps one Pattern node per line, name and direction.
+func (r *NeoRepo) UpsertPattern(ctx context.Context, lineId, name, dir string, props map[string]any) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})
+            MERGE (l)-[:HAS_PATTERN]->(p:Pattern {line_id:$line, name:$name, direction:$dir})
+            SET p += $props
+            RETURN p.name
+        `, map[string]any{"line": lineId, "name": pattern(name), "dir": direction(dir), "props": props})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("line %s not found", lineId)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// ListPatterns returns the declared patterns of a line together with the ones only present on SERVES edges.
+func (r *NeoRepo) ListPatterns(ctx context.Context, lineId string) ([]map[string]any, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})-[r:SERVES]->(:Stop)
+            RETURN coalesce(r.pattern, 'MAIN') AS name, coalesce(r.direction, 'OUTBOUND') AS direction, count(r) AS stops
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		byKey := map[string]map[string]any{}
+		var res []map[string]any
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			m := map[string]any{
+				"name":        rec.Values[0].(string),
+				"direction":   rec.Values[1].(string),
+				"stops":       rec.Values[2].(int64),
+				"start_time":  "",
+				"end_time":    "",
+				"description": "",
+			}
+			byKey[m["name"].(string)+"|"+m["direction"].(string)] = m
+			res = append(res, m)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (:Line {id:$line})-[:HAS_PATTERN]->(p:Pattern)
+            RETURN p.name AS name, coalesce(p.direction, 'OUTBOUND') AS direction,
+                   coalesce(p.start_time, '') AS start_time, coalesce(p.end_time, '') AS end_time,
+                   coalesce(p.description, '') AS description
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			key := rec.Values[0].(string) + "|" + rec.Values[1].(string)
+			m, ok := byKey[key]
+			if !ok {
+				m = map[string]any{"name": rec.Values[0].(string), "direction": rec.Values[1].(string), "stops": int64(0)}
+				byKey[key] = m
+				res = append(res, m)
+			}
+			m["start_time"] = rec.Values[2].(string)
+			m["end_time"] = rec.Values[3].(string)
+			m["description"] = rec.Values[4].(string)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+		sort.Slice(res, func(i, j int) bool {
+			a, b := res[i], res[j]
+			if a["direction"] != b["direction"] {
+				return a["direction"].(string) > b["direction"].(string)
+			}
+			if (a["name"] == PatternMain) != (b["name"] == PatternMain) {
+				return a["name"] == PatternMain
+			}
+			return a["name"].(string) < b["name"].(string)
+		})
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]map[string]any), nil
+}
+
+// DeletePattern removes a pattern of one direction and its SERVES edges; NEXT edges are left untouched.
+func (r *NeoRepo) DeletePattern(ctx context.Context, lineId, name, dir string) error {
+	if pattern(name) == PatternMain {
+		return fmt.Errorf("the %s pattern cannot be deleted", PatternMain)
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		params := map[string]any{"line": lineId, "name": name, "dir": direction(dir)}
+		if _, err := tx.Run(ctx, `
+            MATCH (:Line {id:$line})-[r:SERVES {pattern:$name}]->(:Stop)
+            WHERE coalesce(r.direction, 'OUTBOUND') = $dir
+            DELETE r
+        `, params); err != nil {
+			return nil, err
+		}
+		_, err := tx.Run(ctx, `
+            MATCH (:Line {id:$line})-[:HAS_PATTERN]->(p:Pattern {name:$name})
+            WHERE coalesce(p.direction, 'OUTBOUND') = $dir
+            DETACH DELETE p
+        `, params)
+		return nil, err
+	})
+	return err
+}
+
+// PatternActiveAt reports whether a pattern window ("HH:MM" bounds, empty = all day) covers minute-of-day at.
+func PatternActiveAt(start, end string, at int) bool {
+	if start == "" || end == "" {
+		return true
+	}
+	from, err := helper.ClockMinutes(start)
+	if err != nil {
+		return false
+	}
+	to, err := helper.ClockMinutes(end)
+	if err != nil {
+		return false
+	}
+	return helper.InClockWindow(from, to, at)
+}
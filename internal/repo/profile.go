@@ -0,0 +1,248 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Time-of-day travel times are kept on the NEXT edge itself as travel_time_<DAY>_<BAND>
+properties, DAY being WEEKDAY or WEEKEND (weekends and holidays) and BAND one of TimeBands.
+travel_time stays the fallback for bands without a profile value.
+*/
+const (
+	ProfileWeekday = "WEEKDAY"
+	ProfileWeekend = "WEEKEND"
+
+	profilePrefix = "travel_time_"
+)
+
+// EdgeProfile maps DAY_BAND keys to travel times in seconds.
+type EdgeProfile map[string]int32
+
+type ProfiledEdge struct {
+	From       string
+	To         string
+	TravelTime int32
+	Profile    EdgeProfile
+	// Samples per profile key, only set when built from observations.
+	Samples map[string]int
+}
+
+func ProfileDay(t time.Time, holidays map[string]bool) string {
+	if DayType(t, holidays) == DayWeekday {
+		return ProfileWeekday
+	}
+	return ProfileWeekend
+}
+
+func ProfileKey(day, band string) string { return day + "_" + band }
+
+func ValidProfileKey(day, band string) bool {
+	if day != ProfileWeekday && day != ProfileWeekend {
+		return false
+	}
+	for _, b := range TimeBands {
+		if b.Name == band {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitProfileKey returns the day and band of a DAY_BAND key.
+func SplitProfileKey(key string) (string, string) {
+	day, band, _ := strings.Cut(key, "_")
+	return day, band
+}
+
+// TravelTime returns the profile value for a departure at t, or base when the band has none.
+func (p EdgeProfile) TravelTime(base int32, t time.Time, holidays map[string]bool) int32 {
+	if v, ok := p[ProfileKey(ProfileDay(t, holidays), BandAt(t))]; ok && v > 0 {
+		return v
+	}
+	return base
+}
+
+func profileFromProps(props map[string]any) EdgeProfile {
+	var p EdgeProfile
+	for k, v := range props {
+		key, ok := strings.CutPrefix(k, profilePrefix)
+		if !ok {
+			continue
+		}
+		if day, band := SplitProfileKey(key); !ValidProfileKey(day, band) {
+			continue
+		}
+		if p == nil {
+			p = EdgeProfile{}
+		}
+		p[key] = helper.AnyToInt32(v)
+	}
+	return p
+}
+
+func (r *NeoRepo) GetEdgeProfile(ctx context.Context, from, to string) (*ProfiledEdge, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `MATCH (:Stop {id:$from})-[r:NEXT]->(:Stop {id:$to}) RETURN properties(r)`,
+			map[string]any{"from": from, "to": to})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("edge not found")
+		}
+		props := rs.Record().Values[0].(map[string]any)
+		return &ProfiledEdge{From: from, To: to, TravelTime: helper.AnyToInt32(props["travel_time"]), Profile: profileFromProps(props)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*ProfiledEdge), nil
+}
+
+// SetEdgeProfile imports profile values; replace drops the keys not given.
+func (r *NeoRepo) SetEdgeProfile(ctx context.Context, from, to string, profile EdgeProfile, replace bool) error {
+	for key, v := range profile {
+		if day, band := SplitProfileKey(key); !ValidProfileKey(day, band) {
+			return fmt.Errorf("invalid profile key %q, expected WEEKDAY|WEEKEND_<band>", key)
+		}
+		if v <= 0 {
+			return fmt.Errorf("profile %s: travel time must be positive", key)
+		}
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return nil, setEdgeProfileTx(ctx, tx, from, to, profile, replace)
+	})
+	return err
+}
+
+func setEdgeProfileTx(ctx context.Context, tx neo4j.ManagedTransaction, from, to string, profile EdgeProfile, replace bool) error {
+	props := map[string]any{}
+	if replace {
+		for _, day := range []string{ProfileWeekday, ProfileWeekend} {
+			for _, b := range TimeBands {
+				props[profilePrefix+ProfileKey(day, b.Name)] = nil
+			}
+		}
+	}
+	for key, v := range profile {
+		props[profilePrefix+key] = v
+	}
+	rs, err := tx.Run(ctx, `
+        MATCH (:Stop {id:$from})-[r:NEXT]->(:Stop {id:$to})
+        SET r += $props, r.profile_updated = timestamp()
+        RETURN count(r)
+    `, map[string]any{"from": from, "to": to, "props": props})
+	return expectRow(ctx, rs, err, "edge not found")
+}
+
+/*
+BuildEdgeProfiles estimates every DAY_BAND of the edges with observations in the window and
+stores the groups that reach MinSamples. An empty from/to builds all observed edges.
+*/
+func (r *NeoRepo) BuildEdgeProfiles(ctx context.Context, from, to string, opts CalibrationOptions) ([]ProfiledEdge, error) {
+	if err := opts.defaults(); err != nil {
+		return nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return buildEdgeProfilesTx(ctx, tx, from, to, opts, holidays)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]ProfiledEdge), nil
+}
+
+// buildEdgeProfilesTx expects opts with defaults applied.
+func buildEdgeProfilesTx(ctx context.Context, tx neo4j.ManagedTransaction, from, to string, opts CalibrationOptions, holidays map[string]bool) ([]ProfiledEdge, error) {
+	rs, err := tx.Run(ctx, `
+        MATCH (a:Stop)-[r:NEXT]->(b:Stop)
+        WHERE ($from = '' OR a.id = $from) AND ($to = '' OR b.id = $to)
+        MATCH (o:TravelTimeObservation {from_id: a.id, to_id: b.id})
+        WHERE o.ts >= $since
+        WITH a, b, r, o ORDER BY o.ts
+        RETURN a.id, b.id, coalesce(r.travel_time, 0), collect(o.ts), collect(o.observed)
+        ORDER BY a.id, b.id
+    `, map[string]any{"from": from, "to": to, "since": opts.Now.Add(-opts.Window).UnixMilli()})
+	if err != nil {
+		return nil, err
+	}
+	var res []ProfiledEdge
+	for rs.Next(ctx) {
+		rec := rs.Record()
+		e := ProfiledEdge{
+			From:       rec.Values[0].(string),
+			To:         rec.Values[1].(string),
+			TravelTime: helper.AnyToInt32(rec.Values[2]),
+			Profile:    EdgeProfile{},
+			Samples:    map[string]int{},
+		}
+		groups := map[string][]float64{}
+		observed := rec.Values[4].([]any)
+		for i, ts := range rec.Values[3].([]any) {
+			t := time.UnixMilli(helper.AnyToInt64(ts))
+			key := ProfileKey(ProfileDay(t, holidays), BandAt(t))
+			groups[key] = append(groups[key], float64(helper.AnyToInt32(observed[i])))
+		}
+		for key, vals := range groups {
+			est := estimate(key, vals, opts.Estimator)
+			if est.Samples < opts.MinSamples {
+				continue
+			}
+			e.Profile[key] = est.Estimate
+			e.Samples[key] = est.Samples
+		}
+		res = append(res, e)
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	for _, e := range res {
+		if len(e.Profile) == 0 {
+			continue
+		}
+		if err := setEdgeProfileTx(ctx, tx, e.From, e.To, e.Profile, false); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// SortedKeys lists profile keys by day, then band in time order.
+func (p EdgeProfile) SortedKeys() []string {
+	order := map[string]int{}
+	for i, b := range TimeBands {
+		order[b.Name] = i
+	}
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		di, bi := SplitProfileKey(keys[i])
+		dj, bj := SplitProfileKey(keys[j])
+		if di != dj {
+			return di == ProfileWeekday
+		}
+		return order[bi] < order[bj]
+	})
+	return keys
+}
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Report schedules are kept as (:ReportSchedule {cron, request}) so they survive restarts. request is
+the report request encoded as JSON by the server; the repo does not look into it. last_run_ts is
+when the scheduler last queued the report.
+*/
+type ReportSchedule struct {
+	ID        string
+	Name      string
+	Cron      string
+	Request   string
+	CreatedTs int64
+	LastRunTs int64
+	LastJobID string
+}
+
+func (r *NeoRepo) CreateReportSchedule(ctx context.Context, sc ReportSchedule) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// with the report_schedule_id constraint MERGE locks the id, so only one of two concurrent
+		// creates sees ON CREATE run
+		rs, err := tx.Run(ctx, `
+            MERGE (s:ReportSchedule {id:$id})
+            ON CREATE SET s.name = $name, s.cron = $cron, s.request = $request,
+                          s.created_ts = $now, s.last_run_ts = 0, s.last_job_id = '', s.new = true
+            WITH s, s.new IS NOT NULL AS created
+            REMOVE s.new
+            RETURN created
+        `, map[string]any{
+			"id": sc.ID, "name": sc.Name, "cron": sc.Cron, "request": sc.Request,
+			"now": time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, rs.Err()
+		}
+		if !rs.Record().Values[0].(bool) {
+			return nil, fmt.Errorf("report schedule %s already exists", sc.ID)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (r *NeoRepo) GetReportSchedule(ctx context.Context, id string) (*ReportSchedule, error) {
+	res, err := r.listReportSchedules(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("report schedule %s not found", id)
+	}
+	return &res[0], nil
+}
+
+func (r *NeoRepo) ListReportSchedules(ctx context.Context) ([]ReportSchedule, error) {
+	return r.listReportSchedules(ctx, "")
+}
+
+func (r *NeoRepo) DeleteReportSchedule(ctx context.Context, id string) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MATCH (s:ReportSchedule {id:$id}) DETACH DELETE s`, map[string]any{"id": id})
+		return nil, err
+	})
+	return err
+}
+
+// MarkReportScheduleRun records that the schedule was queued at ts as job jobID.
+func (r *NeoRepo) MarkReportScheduleRun(ctx context.Context, id string, ts int64, jobID string) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `
+            MATCH (s:ReportSchedule {id:$id}) SET s.last_run_ts = $ts, s.last_job_id = $job
+        `, map[string]any{"id": id, "ts": ts, "job": jobID})
+		return nil, err
+	})
+	return err
+}
+
+func (r *NeoRepo) listReportSchedules(ctx context.Context, id string) ([]ReportSchedule, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (s:ReportSchedule)
+            WHERE $id = '' OR s.id = $id
+            RETURN s.id, coalesce(s.name, ''), s.cron, coalesce(s.request, '{}'),
+                   coalesce(s.created_ts, 0), coalesce(s.last_run_ts, 0), coalesce(s.last_job_id, '')
+            ORDER BY s.id
+        `, map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		var res []ReportSchedule
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res = append(res, ReportSchedule{
+				ID:        rec.Values[0].(string),
+				Name:      rec.Values[1].(string),
+				Cron:      helper.AnyToString(rec.Values[2]),
+				Request:   rec.Values[3].(string),
+				CreatedTs: helper.AnyToInt64(rec.Values[4]),
+				LastRunTs: helper.AnyToInt64(rec.Values[5]),
+				LastJobID: rec.Values[6].(string),
+			})
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]ReportSchedule), nil
+}
@@ -0,0 +1,203 @@
+package repo
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"route-graph-service/internal/graph"
+)
+
+const (
+	defaultSamplePairs      = 200
+	defaultCriticalElements = 10
+	// highest-degree stops measured besides the cut elements, at least this many or 5 × limit
+	criticalDegreePool = 50
+	// fixed seed so repeated runs sample the same origin-destination pairs
+	samplePairsSeed = 1
+)
+
+type CriticalElement struct {
+	// Kind is DisruptionStop or DisruptionEdge; an edge stands for both directions between From and To.
+	Kind   string `json:"kind"`
+	StopID string `json:"stop_id"`
+	From   string `json:"from_id"`
+	To     string `json:"to_id"`
+	// Cut marks articulation points and bridges.
+	Cut               bool `json:"cut"`
+	Degree            int  `json:"degree"`
+	Pairs             int  `json:"pairs"`
+	DisconnectedPairs int  `json:"disconnected_pairs"`
+	// average shortest travel time in seconds over the sampled pairs that stay connected
+	AvgBefore float64 `json:"avg_travel_time_before"`
+	AvgAfter  float64 `json:"avg_travel_time_after"`
+}
+
+func (c CriticalElement) Increase() float64 { return c.AvgAfter - c.AvgBefore }
+
+type Resilience struct {
+	ArticulationPoints []string          `json:"articulation_points"`
+	Bridges            [][2]string       `json:"bridges"`
+	SampledPairs       int               `json:"sampled_pairs"`
+	AvgTravelTime      float64           `json:"avg_travel_time"`
+	Ranked             []CriticalElement `json:"ranked"`
+}
+
+func (r *NeoRepo) CriticalElements(ctx context.Context, samplePairs, limit int) (*Resilience, error) {
+	n, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return n.CriticalElements(samplePairs, limit), nil
+}
+
+/*
+CriticalElements lists articulation stops and bridge edges of the NEXT network and ranks them,
+together with the highest-degree stops, by how many sampled origin-destination pairs their
+closure disconnects and by the increase in average shortest travel time.
+*/
+func (n *Network) CriticalElements(samplePairs, limit int) *Resilience {
+	if samplePairs <= 0 {
+		samplePairs = defaultSamplePairs
+	}
+	if limit <= 0 {
+		limit = defaultCriticalElements
+	}
+	g := n.Graph(nil)
+	res := &Resilience{}
+	res.ArticulationPoints, res.Bridges = g.CutElements()
+
+	// only pairs connected in the live network take part
+	sampled := samplePairsFrom(n.StopIDs(), samplePairs)
+	dist := distancesByOrigin(g, sampled, nil)
+	var pairs [][2]string
+	var base []float64
+	for _, p := range sampled {
+		if d, ok := dist[p[0]][p[1]]; ok {
+			pairs = append(pairs, p)
+			base = append(base, d)
+		}
+	}
+	res.SampledPairs = len(pairs)
+	for _, d := range base {
+		res.AvgTravelTime += d
+	}
+	if len(base) > 0 {
+		res.AvgTravelTime /= float64(len(base))
+	}
+
+	byDegree := n.TopConnectedStops(0)
+	degree := map[string]int{}
+	for _, st := range byDegree {
+		degree[st["stop_id"].(string)] = int(st["degree"].(int64))
+	}
+	var candidates []CriticalElement
+	seen := map[string]bool{}
+	for _, id := range res.ArticulationPoints {
+		candidates = append(candidates, CriticalElement{Kind: DisruptionStop, StopID: id, Cut: true, Degree: degree[id]})
+		seen[id] = true
+	}
+	for _, b := range res.Bridges {
+		candidates = append(candidates, CriticalElement{Kind: DisruptionEdge, From: b[0], To: b[1], Cut: true})
+	}
+	// every cut element is measured; the rest of the pool is bounded so large networks stay fast
+	pool := max(criticalDegreePool, 5*limit)
+	for _, st := range byDegree {
+		if pool == 0 {
+			break
+		}
+		id := st["stop_id"].(string)
+		if !seen[id] {
+			candidates = append(candidates, CriticalElement{Kind: DisruptionStop, StopID: id, Degree: degree[id]})
+			pool--
+		}
+	}
+
+	for _, c := range candidates {
+		var skip func(graph.Edge) bool
+		if c.Kind == DisruptionStop {
+			skip = func(e graph.Edge) bool { return e.From == c.StopID || e.To == c.StopID }
+		} else {
+			skip = func(e graph.Edge) bool {
+				return (e.From == c.From && e.To == c.To) || (e.From == c.To && e.To == c.From)
+			}
+		}
+		var affected [][2]string
+		var before []float64
+		for i, p := range pairs {
+			if c.Kind == DisruptionStop && (p[0] == c.StopID || p[1] == c.StopID) {
+				continue
+			}
+			affected = append(affected, p)
+			before = append(before, base[i])
+		}
+		after := distancesByOrigin(g, affected, skip)
+		var sumBefore, sumAfter float64
+		for i, p := range affected {
+			d, ok := after[p[0]][p[1]]
+			if !ok {
+				c.DisconnectedPairs++
+				continue
+			}
+			sumBefore += before[i]
+			sumAfter += d
+		}
+		c.Pairs = len(affected)
+		if connected := len(affected) - c.DisconnectedPairs; connected > 0 {
+			c.AvgBefore = sumBefore / float64(connected)
+			c.AvgAfter = sumAfter / float64(connected)
+		}
+		res.Ranked = append(res.Ranked, c)
+	}
+	sort.SliceStable(res.Ranked, func(i, j int) bool {
+		a, b := res.Ranked[i], res.Ranked[j]
+		if a.DisconnectedPairs != b.DisconnectedPairs {
+			return a.DisconnectedPairs > b.DisconnectedPairs
+		}
+		return a.Increase() > b.Increase()
+	})
+	if len(res.Ranked) > limit {
+		res.Ranked = res.Ranked[:limit]
+	}
+	return res
+}
+
+// samplePairsFrom returns every ordered pair when there are few enough, otherwise a seeded random sample.
+func samplePairsFrom(ids []string, count int) [][2]string {
+	var pairs [][2]string
+	if len(ids) < 2 {
+		return nil
+	}
+	if len(ids)*(len(ids)-1) <= count {
+		for _, a := range ids {
+			for _, b := range ids {
+				if a != b {
+					pairs = append(pairs, [2]string{a, b})
+				}
+			}
+		}
+		return pairs
+	}
+	rng := rand.New(rand.NewSource(samplePairsSeed))
+	seen := map[[2]string]bool{}
+	for len(pairs) < count {
+		p := [2]string{ids[rng.Intn(len(ids))], ids[rng.Intn(len(ids))]}
+		if p[0] == p[1] || seen[p] {
+			continue
+		}
+		seen[p] = true
+		pairs = append(pairs, p)
+	}
+	return pairs
+}
+
+// distancesByOrigin runs one Dijkstra per distinct origin of pairs.
+func distancesByOrigin(g *graph.Graph, pairs [][2]string, skip func(graph.Edge) bool) map[string]map[string]float64 {
+	res := map[string]map[string]float64{}
+	for _, p := range pairs {
+		if _, ok := res[p[0]]; !ok {
+			res[p[0]] = g.Distances(p[0], skip)
+		}
+	}
+	return res
+}
@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Ridership is derived from SERVES.average_boardings, the average number of passengers boarding
+a trip at the stop. Hourly figures multiply MAIN pattern boardings by the departures per hour
+the line's service period gives at the requested time; other patterns only count per trip.
+*/
+type StopRidership struct {
+	StopID           string   `json:"stop_id"`
+	Name             string   `json:"name"`
+	Zone             string   `json:"zone"`
+	Lines            []string `json:"line_ids"`
+	Boardings        float64  `json:"boardings"`
+	BoardingsPerHour float64  `json:"boardings_per_hour"`
+}
+
+type LineRidership struct {
+	LineID           string  `json:"line_id"`
+	Name             string  `json:"name"`
+	FrequencyMins    int32   `json:"frequency_mins"`
+	Boardings        float64 `json:"boardings"`
+	BoardingsPerHour float64 `json:"boardings_per_hour"`
+	// VehicleCapacity averages the assigned vehicles; Capacity is passengers per hour as in LineLoad,
+	// summed over the directions the MAIN pattern runs since boardings count both directions.
+	VehicleCapacity float64 `json:"vehicle_capacity"`
+	Capacity        float64 `json:"capacity"`
+}
+
+// PerCapacityHour is boardings per hour divided by the passengers per hour the line can carry.
+func (l LineRidership) PerCapacityHour() float64 {
+	return Utilization(l.BoardingsPerHour, l.Capacity)
+}
+
+type ZoneRidership struct {
+	Zone             string  `json:"zone"`
+	Stops            int     `json:"stops"`
+	Boardings        float64 `json:"boardings"`
+	BoardingsPerHour float64 `json:"boardings_per_hour"`
+}
+
+type Ridership struct {
+	At               time.Time       `json:"at"`
+	Stops            []StopRidership `json:"busiest_stops"`
+	Lines            []LineRidership `json:"busiest_lines"`
+	Zones            []ZoneRidership `json:"zones"`
+	BoardingsPerHour float64         `json:"boardings_per_hour"`
+	Capacity         float64         `json:"capacity"`
+}
+
+func (r *Ridership) PerCapacityHour() float64 {
+	return Utilization(r.BoardingsPerHour, r.Capacity)
+}
+
+func (r *NeoRepo) RidershipStats(ctx context.Context, at time.Time, limit int) (*Ridership, error) {
+	services, err := r.GetLineServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vehicleCapacity, err := r.LineVehicleCapacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	perHour := map[string]float64{}
+	freqs := map[string]int32{}
+	for _, ls := range services {
+		freq, _ := ls.FrequencyAt(at, holidays)
+		freqs[ls.LineID] = freq
+		if freq > 0 {
+			perHour[ls.LineID] = 60 / float64(freq)
+		}
+	}
+
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res := &Ridership{At: at}
+		lines := map[string]*LineRidership{}
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line)
+            RETURN l.id, coalesce(l.name, ''), coalesce(l.active, false)
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			id := rec.Values[0].(string)
+			l := &LineRidership{LineID: id, Name: rec.Values[1].(string), FrequencyMins: freqs[id], VehicleCapacity: vehicleCapacity[id]}
+			if !rec.Values[2].(bool) {
+				perHour[id] = 0
+			}
+			lines[id] = l
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (l:Line)-[r:SERVES]->(s:Stop)
+            RETURN s.id, coalesce(s.name, ''), coalesce(s.zone, ''), l.id,
+                   coalesce(r.pattern, 'MAIN'), toFloat(coalesce(r.average_boardings, 0)),
+                   coalesce(r.direction, 'OUTBOUND')
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		stops := map[string]*StopRidership{}
+		directions := map[string]map[string]bool{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			stopId, lineId := rec.Values[0].(string), rec.Values[3].(string)
+			b, _ := toFloat(rec.Values[5])
+			hourly := 0.0
+			if rec.Values[4].(string) == PatternMain {
+				hourly = b * perHour[lineId]
+				if directions[lineId] == nil {
+					directions[lineId] = map[string]bool{}
+				}
+				directions[lineId][rec.Values[6].(string)] = true
+			}
+			st := stops[stopId]
+			if st == nil {
+				st = &StopRidership{StopID: stopId, Name: rec.Values[1].(string), Zone: rec.Values[2].(string)}
+				stops[stopId] = st
+			}
+			st.Boardings += b
+			st.BoardingsPerHour += hourly
+			if !slices.Contains(st.Lines, lineId) {
+				st.Lines = append(st.Lines, lineId)
+			}
+			if l := lines[lineId]; l != nil {
+				l.Boardings += b
+				l.BoardingsPerHour += hourly
+			}
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		zones := map[string]*ZoneRidership{}
+		for _, st := range stops {
+			sort.Strings(st.Lines)
+			res.Stops = append(res.Stops, *st)
+			z := zones[st.Zone]
+			if z == nil {
+				z = &ZoneRidership{Zone: st.Zone}
+				zones[st.Zone] = z
+			}
+			z.Stops++
+			z.Boardings += st.Boardings
+			z.BoardingsPerHour += st.BoardingsPerHour
+		}
+		for _, z := range zones {
+			res.Zones = append(res.Zones, *z)
+		}
+		for id, l := range lines {
+			l.Capacity = HourlyCapacity(perHour[id], l.VehicleCapacity) * float64(len(directions[id]))
+			res.Lines = append(res.Lines, *l)
+			res.BoardingsPerHour += l.BoardingsPerHour
+			res.Capacity += l.Capacity
+		}
+
+		sort.Slice(res.Stops, func(i, j int) bool {
+			a, b := res.Stops[i], res.Stops[j]
+			if a.BoardingsPerHour != b.BoardingsPerHour {
+				return a.BoardingsPerHour > b.BoardingsPerHour
+			}
+			if a.Boardings != b.Boardings {
+				return a.Boardings > b.Boardings
+			}
+			return a.StopID < b.StopID
+		})
+		sort.Slice(res.Lines, func(i, j int) bool {
+			a, b := res.Lines[i], res.Lines[j]
+			if a.BoardingsPerHour != b.BoardingsPerHour {
+				return a.BoardingsPerHour > b.BoardingsPerHour
+			}
+			if a.Boardings != b.Boardings {
+				return a.Boardings > b.Boardings
+			}
+			return a.LineID < b.LineID
+		})
+		sort.Slice(res.Zones, func(i, j int) bool { return res.Zones[i].Zone < res.Zones[j].Zone })
+		if limit > 0 {
+			res.Stops = res.Stops[:min(limit, len(res.Stops))]
+			res.Lines = res.Lines[:min(limit, len(res.Lines))]
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*Ridership), nil
+}
@@ -0,0 +1,322 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Average in-service speed used to estimate NEXT travel_time when none is supplied.
+const estimatedSpeedMps = 5.0
+
+type RouteStop struct {
+	StopID string `json:"stop_id"`
+	// TravelTime and Distance describe the NEXT edge from the previous stop; 0 keeps the
+	// existing edge value or estimates it from coordinates for a new edge.
+	TravelTime int32 `json:"travel_time"`
+	Distance   int32 `json:"distance"`
+}
+
+type Edge struct {
+	From       string `json:"from_id"`
+	To         string `json:"to_id"`
+	TravelTime int32  `json:"travel_time"`
+	Distance   int32  `json:"distance"`
+}
+
+type RouteChange struct {
+	Stops   []string
+	Created []Edge
+	Removed []Edge
+}
+
+func (r *NeoRepo) SetLineRoute(ctx context.Context, lineId, dir, pat string, route []RouteStop) (*RouteChange, error) {
+	return r.editLineRoute(ctx, lineId, dir, pat, func(current []string) ([]RouteStop, error) {
+		return route, nil
+	})
+}
+
+/*
+InsertStopIntoLine puts stopId at the given 1-based position of the line route (0 or past the end appends).
+in describes the edge from the previous stop, out the edge to the following stop.
+*/
+func (r *NeoRepo) InsertStopIntoLine(ctx context.Context, lineId, dir, pat, stopId string, position int, in, out RouteStop) (*RouteChange, error) {
+	return r.editLineRoute(ctx, lineId, dir, pat, func(current []string) ([]RouteStop, error) {
+		for _, id := range current {
+			if id == stopId {
+				return nil, fmt.Errorf("stop %s is already served by line %s", stopId, lineId)
+			}
+		}
+		if position <= 0 || position > len(current) {
+			position = len(current) + 1
+		}
+		res := make([]RouteStop, 0, len(current)+1)
+		for i, id := range current {
+			if i == position-1 {
+				res = append(res, RouteStop{StopID: stopId, TravelTime: in.TravelTime, Distance: in.Distance})
+				res = append(res, RouteStop{StopID: id, TravelTime: out.TravelTime, Distance: out.Distance})
+				continue
+			}
+			res = append(res, RouteStop{StopID: id})
+		}
+		if position == len(current)+1 {
+			res = append(res, RouteStop{StopID: stopId, TravelTime: in.TravelTime, Distance: in.Distance})
+		}
+		return res, nil
+	})
+}
+
+/*
+RemoveStopFromLine drops stopId from the line route; bridge describes the edge that
+joins the neighbouring stops.
+*/
+func (r *NeoRepo) RemoveStopFromLine(ctx context.Context, lineId, dir, pat, stopId string, bridge RouteStop) (*RouteChange, error) {
+	return r.editLineRoute(ctx, lineId, dir, pat, func(current []string) ([]RouteStop, error) {
+		idx := -1
+		for i, id := range current {
+			if id == stopId {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("stop %s is not served by line %s", stopId, lineId)
+		}
+		res := make([]RouteStop, 0, len(current)-1)
+		for i, id := range current {
+			if i == idx {
+				continue
+			}
+			rs := RouteStop{StopID: id}
+			if i == idx+1 && idx > 0 {
+				rs.TravelTime, rs.Distance = bridge.TravelTime, bridge.Distance
+			}
+			res = append(res, rs)
+		}
+		return res, nil
+	})
+}
+
+/*
+editLineRoute rewrites SERVES.order of one line direction/pattern and keeps NEXT edges in sync
+in a single transaction. NEXT edges that are no longer consecutive on this route are removed
+unless another line, direction or pattern still uses them.
+*/
+func (r *NeoRepo) editLineRoute(ctx context.Context, lineId, dir, pat string, build func(current []string) ([]RouteStop, error)) (*RouteChange, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return editLineRouteTx(ctx, tx, lineId, dir, pat, build)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*RouteChange), nil
+}
+
+func editLineRouteTx(ctx context.Context, tx neo4j.ManagedTransaction, lineId, dir, pat string, build func(current []string) ([]RouteStop, error)) (*RouteChange, error) {
+	dir, pat = direction(dir), pattern(pat)
+	rs, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})
+            OPTIONAL MATCH (l)-[r:SERVES]->(s:Stop)
+            WHERE coalesce(r.direction, 'OUTBOUND') = $dir AND coalesce(r.pattern, 'MAIN') = $pattern
+            WITH l, r, s
+            ORDER BY r.order
+            RETURN l.id AS line_id, collect(CASE WHEN s IS NULL THEN NULL ELSE {stop_id: s.id, props: properties(r)} END) AS route
+        `, map[string]any{"line": lineId, "dir": dir, "pattern": pat})
+	if err != nil {
+		return nil, err
+	}
+	if !rs.Next(ctx) {
+		return nil, fmt.Errorf("line %s not found", lineId)
+	}
+	var current []string
+	props := map[string]map[string]any{}
+	for _, item := range rs.Record().Values[1].([]any) {
+		m := item.(map[string]any)
+		id := m["stop_id"].(string)
+		current = append(current, id)
+		if _, ok := props[id]; !ok {
+			props[id] = m["props"].(map[string]any)
+		}
+	}
+
+	route, err := build(current)
+	if err != nil {
+		return nil, err
+	}
+	if len(route) == 0 {
+		return nil, fmt.Errorf("route for line %s %s/%s is empty", lineId, dir, pat)
+	}
+	ids := make([]string, 0, len(route))
+	for i, st := range route {
+		if i > 0 && route[i-1].StopID == st.StopID {
+			return nil, fmt.Errorf("stop %s appears twice in a row", st.StopID)
+		}
+		ids = append(ids, st.StopID)
+	}
+	coords, err := stopCoords(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	serves := make([]map[string]any, 0, len(route))
+	for i, st := range route {
+		p := map[string]any{}
+		for k, v := range props[st.StopID] {
+			p[k] = v
+		}
+		p["order"] = int64(i + 1)
+		p["direction"] = dir
+		p["pattern"] = pat
+		serves = append(serves, map[string]any{"stop": st.StopID, "props": p})
+	}
+	if _, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})-[r:SERVES]->(:Stop)
+            WHERE coalesce(r.direction, 'OUTBOUND') = $dir AND coalesce(r.pattern, 'MAIN') = $pattern
+            DELETE r
+        `, map[string]any{"line": lineId, "dir": dir, "pattern": pat}); err != nil {
+		return nil, err
+	}
+	if pat != PatternMain {
+		if _, err := tx.Run(ctx, `
+                MATCH (l:Line {id:$line})
+                MERGE (l)-[:HAS_PATTERN]->(p:Pattern {line_id:$line, name:$pattern, direction:$dir})
+            `, map[string]any{"line": lineId, "dir": dir, "pattern": pat}); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})
+            UNWIND $serves AS sv
+            MATCH (s:Stop {id:sv.stop})
+            CREATE (l)-[r:SERVES]->(s)
+            SET r = sv.props
+        `, map[string]any{"line": lineId, "serves": serves}); err != nil {
+		return nil, err
+	}
+
+	change := &RouteChange{Stops: ids}
+	newPairs := map[string]bool{}
+	var edges []map[string]any
+	for i := 1; i < len(route); i++ {
+		from, to := route[i-1].StopID, route[i].StopID
+		newPairs[from+">"+to] = true
+		travel, dist := route[i].TravelTime, route[i].Distance
+		estTravel, estDist := estimateEdge(coords[from], coords[to])
+		edges = append(edges, map[string]any{
+			"from": from, "to": to,
+			"travel": travel, "dist": dist,
+			"est_travel": estTravel, "est_dist": estDist,
+		})
+	}
+	if len(edges) > 0 {
+		rs, err = tx.Run(ctx, `
+                UNWIND $edges AS e
+                MATCH (a:Stop {id:e.from}), (b:Stop {id:e.to})
+                OPTIONAL MATCH (a)-[old:NEXT]->(b)
+                WITH a, b, e, count(old) = 0 AS missing
+                WITH a, b, e, missing WHERE missing OR e.travel > 0 OR e.dist > 0
+                MERGE (a)-[n:NEXT]->(b)
+                ON CREATE SET n.created_at = $now,
+                              n.travel_time = CASE WHEN e.travel > 0 THEN e.travel ELSE e.est_travel END,
+                              n.distance = CASE WHEN e.dist > 0 THEN e.dist ELSE e.est_dist END
+                ON MATCH SET n.travel_time = CASE WHEN e.travel > 0 THEN e.travel ELSE n.travel_time END,
+                             n.distance = CASE WHEN e.dist > 0 THEN e.dist ELSE n.distance END
+                RETURN a.id AS from, b.id AS to, n.travel_time AS travel, n.distance AS dist, missing
+            `, map[string]any{"edges": edges, "now": time.Now().Unix()})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			if missing, _ := rec.Values[4].(bool); !missing {
+				continue
+			}
+			change.Created = append(change.Created, Edge{
+				From:       rec.Values[0].(string),
+				To:         rec.Values[1].(string),
+				TravelTime: helper.AnyToInt32(rec.Values[2]),
+				Distance:   helper.AnyToInt32(rec.Values[3]),
+			})
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var stale []map[string]any
+	for i := 1; i < len(current); i++ {
+		from, to := current[i-1], current[i]
+		if !newPairs[from+">"+to] {
+			stale = append(stale, map[string]any{"from": from, "to": to})
+		}
+	}
+	if len(stale) > 0 {
+		rs, err = tx.Run(ctx, `
+                UNWIND $pairs AS p
+                MATCH (a:Stop {id:p.from})-[n:NEXT]->(b:Stop {id:p.to})
+                WHERE NOT EXISTS {
+                    MATCH (l:Line)-[r1:SERVES]->(a), (l)-[r2:SERVES]->(b)
+                    WHERE r2.order = r1.order + 1
+                      AND coalesce(r1.direction, 'OUTBOUND') = coalesce(r2.direction, 'OUTBOUND')
+                      AND coalesce(r1.pattern, 'MAIN') = coalesce(r2.pattern, 'MAIN')
+                }
+                WITH a, b, n, n.travel_time AS travel, n.distance AS dist
+                DELETE n
+                RETURN a.id AS from, b.id AS to, travel, dist
+            `, map[string]any{"pairs": stale})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			change.Removed = append(change.Removed, Edge{
+				From:       rec.Values[0].(string),
+				To:         rec.Values[1].(string),
+				TravelTime: helper.AnyToInt32(rec.Values[2]),
+				Distance:   helper.AnyToInt32(rec.Values[3]),
+			})
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
+
+func stopCoords(ctx context.Context, tx neo4j.ManagedTransaction, ids []string) (map[string][2]float64, error) {
+	rs, err := tx.Run(ctx, `
+        MATCH (s:Stop) WHERE s.id IN $ids
+        RETURN s.id AS id, s.lat AS lat, s.lon AS lon
+    `, map[string]any{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+	res := map[string][2]float64{}
+	for rs.Next(ctx) {
+		rec := rs.Record()
+		lat, _ := toFloat(rec.Values[1])
+		lon, _ := toFloat(rec.Values[2])
+		res[rec.Values[0].(string)] = [2]float64{lat, lon}
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if _, ok := res[id]; !ok {
+			return nil, fmt.Errorf("stop %s not found", id)
+		}
+	}
+	return res, nil
+}
+
+func estimateEdge(a, b [2]float64) (int32, int32) {
+	dist := helper.HaversineMeters(a[0], a[1], b[0], b[1])
+	return int32(math.Round(dist / estimatedSpeedMps)), int32(math.Round(dist))
+}
@@ -0,0 +1,680 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Scenarios are named overlays of network changes kept in (:Scenario {changes}) as JSON. They are
+evaluated on an in-memory copy of the live network and only touch the graph when promoted, which
+applies every change in order inside one write transaction.
+*/
+const (
+	ScenarioDraft    = "DRAFT"
+	ScenarioPromoted = "PROMOTED"
+
+	ChangeAddStop      = "ADD_STOP"
+	ChangeRemoveStop   = "REMOVE_STOP"
+	ChangeAddEdge      = "ADD_EDGE"
+	ChangeRemoveEdge   = "REMOVE_EDGE"
+	ChangeSetRoute     = "SET_ROUTE"
+	ChangeSetFrequency = "SET_FREQUENCY"
+)
+
+type ScenarioChange struct {
+	Kind string `json:"kind"`
+	// ADD_STOP
+	Stop    NetStop `json:"stop"`
+	Shelter bool    `json:"shelter,omitempty"`
+	// REMOVE_STOP
+	StopID string `json:"stop_id,omitempty"`
+	// ADD_EDGE, REMOVE_EDGE
+	Edge Edge `json:"edge"`
+	// SET_ROUTE, SET_FREQUENCY
+	LineID    string      `json:"line_id,omitempty"`
+	Direction string      `json:"direction,omitempty"`
+	Pattern   string      `json:"pattern,omitempty"`
+	Route     []RouteStop `json:"route,omitempty"`
+	// SET_FREQUENCY: FrequencyMins > 0 replaces the base headway, non-nil Periods the service periods
+	FrequencyMins int32           `json:"frequency_mins,omitempty"`
+	Periods       []ServicePeriod `json:"periods,omitempty"`
+}
+
+type Scenario struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Status      string           `json:"status"`
+	CreatedTs   int64            `json:"created_ts"`
+	PromotedTs  int64            `json:"promoted_ts"`
+	Changes     []ScenarioChange `json:"changes"`
+}
+
+type ScenarioQuery struct {
+	StartID string
+	EndID   string
+	Limit   int
+	At      time.Time
+}
+
+type NetworkMetrics struct {
+	Path             []string         `json:"path"`
+	Hops             int              `json:"hops"`
+	TravelTime       int32            `json:"travel_time"`
+	PathFound        bool             `json:"path_found"`
+	TopPairs         []map[string]any `json:"top_pairs"`
+	TopStops         []map[string]any `json:"top_stops"`
+	Fleet            []LineFleetSize  `json:"fleet"`
+	RequiredVehicles int              `json:"required_vehicles"`
+	Warnings         []string         `json:"warnings"`
+}
+
+type FleetDelta struct {
+	LineID          string `json:"line_id"`
+	FrequencyBefore int32  `json:"frequency_before"`
+	FrequencyAfter  int32  `json:"frequency_after"`
+	CycleBefore     int    `json:"cycle_secs_before"`
+	CycleAfter      int    `json:"cycle_secs_after"`
+	RequiredBefore  int    `json:"required_before"`
+	RequiredAfter   int    `json:"required_after"`
+}
+
+type MetricsDiff struct {
+	StopsAdded            []string     `json:"stops_added"`
+	StopsRemoved          []string     `json:"stops_removed"`
+	EdgesAdded            []Edge       `json:"edges_added"`
+	EdgesRemoved          []Edge       `json:"edges_removed"`
+	RoutesChanged         []string     `json:"routes_changed"`
+	Fleet                 []FleetDelta `json:"fleet"`
+	RequiredVehiclesDelta int          `json:"required_vehicles_delta"`
+	HopsDelta             int          `json:"hops_delta"`
+	TravelTimeDelta       int32        `json:"travel_time_delta"`
+}
+
+type ScenarioEvaluation struct {
+	Baseline NetworkMetrics `json:"baseline"`
+	Scenario NetworkMetrics `json:"scenario"`
+	Diff     MetricsDiff    `json:"diff"`
+}
+
+func ValidChangeKind(k string) bool {
+	switch k {
+	case ChangeAddStop, ChangeRemoveStop, ChangeAddEdge, ChangeRemoveEdge, ChangeSetRoute, ChangeSetFrequency:
+		return true
+	}
+	return false
+}
+
+/* Scenario CRUD */
+func (r *NeoRepo) CreateScenario(ctx context.Context, sc Scenario) error {
+	changes, err := encodeChanges(sc.Changes)
+	if err != nil {
+		return err
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `MATCH (s:Scenario {id:$id}) RETURN s.id`, map[string]any{"id": sc.ID})
+		if err != nil {
+			return nil, err
+		}
+		if rs.Next(ctx) {
+			return nil, fmt.Errorf("scenario %s already exists", sc.ID)
+		}
+		_, err = tx.Run(ctx, `
+            CREATE (:Scenario {id:$id, name:$name, description:$description, status:$status,
+                               created_ts:$now, changes:$changes})
+        `, map[string]any{
+			"id": sc.ID, "name": sc.Name, "description": sc.Description, "status": ScenarioDraft,
+			"now": time.Now().UnixMilli(), "changes": changes,
+		})
+		return nil, err
+	})
+	return err
+}
+
+func (r *NeoRepo) GetScenario(ctx context.Context, id string) (*Scenario, error) {
+	res, err := r.listScenarios(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("scenario %s not found", id)
+	}
+	return &res[0], nil
+}
+
+func (r *NeoRepo) ListScenarios(ctx context.Context) ([]Scenario, error) {
+	return r.listScenarios(ctx, "")
+}
+
+// UpdateScenario replaces name, description and changes of a scenario that is not promoted yet.
+func (r *NeoRepo) UpdateScenario(ctx context.Context, sc Scenario) error {
+	changes, err := encodeChanges(sc.Changes)
+	if err != nil {
+		return err
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Scenario {id:$id})
+            WITH s, s.status AS status
+            FOREACH (_ IN CASE WHEN status = $draft THEN [1] ELSE [] END |
+                SET s.name = $name, s.description = $description, s.changes = $changes)
+            RETURN status
+        `, map[string]any{"id": sc.ID, "name": sc.Name, "description": sc.Description, "changes": changes, "draft": ScenarioDraft})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("scenario %s not found", sc.ID)
+		}
+		if status := helper.AnyToString(rs.Record().Values[0]); status != ScenarioDraft {
+			return nil, fmt.Errorf("scenario %s is %s and cannot be changed", sc.ID, status)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (r *NeoRepo) DeleteScenario(ctx context.Context, id string) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MATCH (s:Scenario {id:$id}) DETACH DELETE s`, map[string]any{"id": id})
+		return nil, err
+	})
+	return err
+}
+
+func (r *NeoRepo) listScenarios(ctx context.Context, id string) ([]Scenario, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Scenario)
+            WHERE $id = '' OR s.id = $id
+            RETURN s.id, coalesce(s.name, ''), coalesce(s.description, ''), s.status,
+                   coalesce(s.created_ts, 0), coalesce(s.promoted_ts, 0), coalesce(s.changes, [])
+            ORDER BY s.created_ts, s.id
+        `, map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		var res []Scenario
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			sc := Scenario{
+				ID:          rec.Values[0].(string),
+				Name:        rec.Values[1].(string),
+				Description: rec.Values[2].(string),
+				Status:      helper.AnyToString(rec.Values[3]),
+				CreatedTs:   helper.AnyToInt64(rec.Values[4]),
+				PromotedTs:  helper.AnyToInt64(rec.Values[5]),
+			}
+			for _, raw := range rec.Values[6].([]any) {
+				var c ScenarioChange
+				if err := json.Unmarshal([]byte(raw.(string)), &c); err != nil {
+					return nil, fmt.Errorf("scenario %s: %w", sc.ID, err)
+				}
+				sc.Changes = append(sc.Changes, c)
+			}
+			res = append(res, sc)
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]Scenario), nil
+}
+
+func encodeChanges(changes []ScenarioChange) ([]string, error) {
+	res := make([]string, 0, len(changes))
+	for i, c := range changes {
+		if !ValidChangeKind(c.Kind) {
+			return nil, fmt.Errorf("change %d: invalid kind %q", i+1, c.Kind)
+		}
+		b, err := json.Marshal(c)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, string(b))
+	}
+	return res, nil
+}
+
+/* Evaluation */
+
+// ApplyScenario returns a copy of the network with every change of sc applied in order.
+func (n *Network) ApplyScenario(sc *Scenario) (*Network, error) {
+	c := n.Clone()
+	for i, ch := range sc.Changes {
+		if err := c.apply(ch); err != nil {
+			return nil, fmt.Errorf("change %d (%s): %w", i+1, ch.Kind, err)
+		}
+	}
+	return c, nil
+}
+
+// apply mirrors promoteChange on the in-memory network.
+func (n *Network) apply(c ScenarioChange) error {
+	switch c.Kind {
+	case ChangeAddStop:
+		if c.Stop.ID == "" {
+			return fmt.Errorf("stop id required")
+		}
+		if _, ok := n.Stops[c.Stop.ID]; ok {
+			return fmt.Errorf("stop %s already exists", c.Stop.ID)
+		}
+		n.Stops[c.Stop.ID] = c.Stop
+	case ChangeRemoveStop:
+		if _, ok := n.Stops[c.StopID]; !ok {
+			return fmt.Errorf("stop %s not found", c.StopID)
+		}
+		// reroute every route that served the stop first so the neighbours get a bridge NEXT edge
+		var served []LineRoute
+		for _, rt := range n.Routes {
+			if slices.Contains(rt.Stops, c.StopID) {
+				served = append(served, rt)
+			}
+		}
+		for _, rt := range served {
+			route := routeWithoutStop(rt.Stops, c.StopID)
+			if len(route) == 0 {
+				n.Routes = slices.DeleteFunc(n.Routes, func(x LineRoute) bool {
+					return x.LineID == rt.LineID && x.Direction == rt.Direction && x.Pattern == rt.Pattern
+				})
+				continue
+			}
+			if err := n.setRoute(rt.LineID, rt.Direction, rt.Pattern, route); err != nil {
+				return err
+			}
+		}
+		delete(n.Stops, c.StopID)
+		for k := range n.Edges {
+			if k[0] == c.StopID || k[1] == c.StopID {
+				delete(n.Edges, k)
+			}
+		}
+	case ChangeAddEdge:
+		a, okA := n.Stops[c.Edge.From]
+		b, okB := n.Stops[c.Edge.To]
+		if !okA || !okB {
+			return fmt.Errorf("stops %s and %s must exist", c.Edge.From, c.Edge.To)
+		}
+		e := c.Edge
+		travel, dist := estimateEdge([2]float64{a.Lat, a.Lon}, [2]float64{b.Lat, b.Lon})
+		if e.TravelTime <= 0 {
+			e.TravelTime = travel
+		}
+		if e.Distance <= 0 {
+			e.Distance = dist
+		}
+		n.Edges[[2]string{e.From, e.To}] = e
+	case ChangeRemoveEdge:
+		key := [2]string{c.Edge.From, c.Edge.To}
+		if _, ok := n.Edges[key]; !ok {
+			return fmt.Errorf("NEXT edge %s -> %s not found", c.Edge.From, c.Edge.To)
+		}
+		delete(n.Edges, key)
+	case ChangeSetRoute:
+		return n.setRoute(c.LineID, direction(c.Direction), pattern(c.Pattern), c.Route)
+	case ChangeSetFrequency:
+		l, ok := n.Lines[c.LineID]
+		if !ok {
+			return fmt.Errorf("line %s not found", c.LineID)
+		}
+		if c.FrequencyMins > 0 {
+			l.Service.FrequencyMins = c.FrequencyMins
+		}
+		if c.Periods != nil {
+			l.Service.Periods = append([]ServicePeriod(nil), c.Periods...)
+		}
+	default:
+		return fmt.Errorf("invalid kind %q", c.Kind)
+	}
+	return nil
+}
+
+// setRoute follows editLineRoute: missing NEXT edges are created, unused old ones dropped.
+func (n *Network) setRoute(lineId, dir, pat string, route []RouteStop) error {
+	if _, ok := n.Lines[lineId]; !ok {
+		return fmt.Errorf("line %s not found", lineId)
+	}
+	if len(route) == 0 {
+		return fmt.Errorf("route for line %s %s/%s is empty", lineId, dir, pat)
+	}
+	ids := make([]string, 0, len(route))
+	for i, st := range route {
+		if _, ok := n.Stops[st.StopID]; !ok {
+			return fmt.Errorf("stop %s not found", st.StopID)
+		}
+		if i > 0 && route[i-1].StopID == st.StopID {
+			return fmt.Errorf("stop %s appears twice in a row", st.StopID)
+		}
+		ids = append(ids, st.StopID)
+	}
+	idx := slices.IndexFunc(n.Routes, func(rt LineRoute) bool {
+		return rt.LineID == lineId && rt.Direction == dir && rt.Pattern == pat
+	})
+	var current []string
+	if idx < 0 {
+		n.Routes = append(n.Routes, LineRoute{LineID: lineId, Direction: dir, Pattern: pat})
+		idx = len(n.Routes) - 1
+	} else {
+		current = n.Routes[idx].Stops
+	}
+	n.Routes[idx].Stops = ids
+
+	newPairs := map[[2]string]bool{}
+	for i := 1; i < len(route); i++ {
+		key := [2]string{route[i-1].StopID, route[i].StopID}
+		newPairs[key] = true
+		e, ok := n.Edges[key]
+		if !ok {
+			a, b := n.Stops[key[0]], n.Stops[key[1]]
+			e = Edge{From: key[0], To: key[1]}
+			e.TravelTime, e.Distance = estimateEdge([2]float64{a.Lat, a.Lon}, [2]float64{b.Lat, b.Lon})
+		}
+		if route[i].TravelTime > 0 {
+			e.TravelTime = route[i].TravelTime
+		}
+		if route[i].Distance > 0 {
+			e.Distance = route[i].Distance
+		}
+		n.Edges[key] = e
+	}
+	used := map[[2]string]bool{}
+	for _, rt := range n.Routes {
+		for i := 1; i < len(rt.Stops); i++ {
+			used[[2]string{rt.Stops[i-1], rt.Stops[i]}] = true
+		}
+	}
+	for i := 1; i < len(current); i++ {
+		key := [2]string{current[i-1], current[i]}
+		if !newPairs[key] && !used[key] {
+			delete(n.Edges, key)
+		}
+	}
+	return nil
+}
+
+func (n *Network) Metrics(q ScenarioQuery, holidays map[string]bool) NetworkMetrics {
+	m := NetworkMetrics{
+		TopPairs: n.TopPairs(q.Limit),
+		TopStops: n.TopConnectedStops(q.Limit),
+		Fleet:    SizeFleet(n.Fleet(), n.Services(), holidays, q.At),
+		Warnings: n.RouteGaps(),
+	}
+	if q.StartID != "" && q.EndID != "" {
+		m.Path, m.Hops, m.TravelTime, m.PathFound = n.ShortestPath(q.StartID, q.EndID, q.At, holidays)
+	}
+	for _, f := range m.Fleet {
+		m.RequiredVehicles += f.Required
+	}
+	return m
+}
+
+func DiffNetworks(base, scen *Network, bm, sm NetworkMetrics) MetricsDiff {
+	d := MetricsDiff{RequiredVehiclesDelta: sm.RequiredVehicles - bm.RequiredVehicles}
+	if bm.PathFound && sm.PathFound {
+		d.HopsDelta = sm.Hops - bm.Hops
+		d.TravelTimeDelta = sm.TravelTime - bm.TravelTime
+	}
+	for _, id := range scen.StopIDs() {
+		if _, ok := base.Stops[id]; !ok {
+			d.StopsAdded = append(d.StopsAdded, id)
+		}
+	}
+	for _, id := range base.StopIDs() {
+		if _, ok := scen.Stops[id]; !ok {
+			d.StopsRemoved = append(d.StopsRemoved, id)
+		}
+	}
+	for _, e := range scen.SortedEdges() {
+		if old, ok := base.Edges[[2]string{e.From, e.To}]; !ok || old != e {
+			d.EdgesAdded = append(d.EdgesAdded, e)
+		}
+	}
+	for _, e := range base.SortedEdges() {
+		if _, ok := scen.Edges[[2]string{e.From, e.To}]; !ok {
+			d.EdgesRemoved = append(d.EdgesRemoved, e)
+		}
+	}
+	routeKey := func(rt LineRoute) string { return fmt.Sprintf("%s %s/%s", rt.LineID, rt.Direction, rt.Pattern) }
+	before := map[string][]string{}
+	for _, rt := range base.Routes {
+		before[routeKey(rt)] = rt.Stops
+	}
+	seen := map[string]bool{}
+	for _, rt := range scen.Routes {
+		k := routeKey(rt)
+		seen[k] = true
+		if !slices.Equal(before[k], rt.Stops) {
+			d.RoutesChanged = append(d.RoutesChanged, k)
+		}
+	}
+	for k := range before {
+		if !seen[k] {
+			d.RoutesChanged = append(d.RoutesChanged, k)
+		}
+	}
+	sort.Strings(d.RoutesChanged)
+
+	old := map[string]LineFleetSize{}
+	for _, f := range bm.Fleet {
+		old[f.LineID] = f
+	}
+	for _, f := range sm.Fleet {
+		o := old[f.LineID]
+		if o.FrequencyMins == f.FrequencyMins && o.CycleSeconds == f.CycleSeconds && o.Required == f.Required {
+			continue
+		}
+		d.Fleet = append(d.Fleet, FleetDelta{
+			LineID:          f.LineID,
+			FrequencyBefore: o.FrequencyMins,
+			FrequencyAfter:  f.FrequencyMins,
+			CycleBefore:     o.CycleSeconds,
+			CycleAfter:      f.CycleSeconds,
+			RequiredBefore:  o.Required,
+			RequiredAfter:   f.Required,
+		})
+	}
+	return d
+}
+
+// EvaluateScenario computes the same metrics on the live network and on the scenario overlay.
+func (r *NeoRepo) EvaluateScenario(ctx context.Context, id string, q ScenarioQuery) (*Scenario, *ScenarioEvaluation, error) {
+	sc, err := r.GetScenario(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	base, err := r.LoadNetwork(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	scen, err := base.ApplyScenario(sc)
+	if err != nil {
+		return nil, nil, err
+	}
+	ev := &ScenarioEvaluation{Baseline: base.Metrics(q, holidays), Scenario: scen.Metrics(q, holidays)}
+	ev.Diff = DiffNetworks(base, scen, ev.Baseline, ev.Scenario)
+	return sc, ev, nil
+}
+
+/* Promotion */
+
+// PromoteScenario applies all changes to the live graph in one transaction and marks the scenario PROMOTED.
+func (r *NeoRepo) PromoteScenario(ctx context.Context, id string) error {
+	sc, err := r.GetScenario(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sc.Status != ScenarioDraft {
+		return fmt.Errorf("scenario %s is already %s", id, sc.Status)
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// claim the scenario first so two concurrent promotions cannot both apply it
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Scenario {id:$id, status:$draft})
+            SET s.status = $promoted, s.promoted_ts = $now
+            RETURN s.id
+        `, map[string]any{"id": id, "draft": ScenarioDraft, "promoted": ScenarioPromoted, "now": time.Now().UnixMilli()})
+		if err != nil {
+			return nil, err
+		}
+		if !rs.Next(ctx) {
+			return nil, fmt.Errorf("scenario %s is no longer a draft", id)
+		}
+		for i, c := range sc.Changes {
+			if err := promoteChange(ctx, tx, c); err != nil {
+				return nil, fmt.Errorf("change %d (%s): %w", i+1, c.Kind, err)
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func promoteChange(ctx context.Context, tx neo4j.ManagedTransaction, c ScenarioChange) error {
+	switch c.Kind {
+	case ChangeAddStop:
+		rs, err := tx.Run(ctx, `
+            OPTIONAL MATCH (old:Stop {id:$id})
+            WITH old WHERE old IS NULL
+            CREATE (s:Stop {id:$id, name:$name, lat:$lat, lon:$lon, zone:$zone, shelter:$shelter, created_at:$now})
+            RETURN s.id
+        `, map[string]any{
+			"id": c.Stop.ID, "name": c.Stop.Name, "lat": c.Stop.Lat, "lon": c.Stop.Lon,
+			"zone": c.Stop.Zone, "shelter": c.Shelter, "now": time.Now().Unix(),
+		})
+		return expectRow(ctx, rs, err, fmt.Sprintf("stop %s already exists", c.Stop.ID))
+	case ChangeRemoveStop:
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Stop {id:$id})
+            OPTIONAL MATCH (l:Line)-[r:SERVES]->(s)
+            WITH s, l, coalesce(r.direction, 'OUTBOUND') AS dir, coalesce(r.pattern, 'MAIN') AS pattern
+            OPTIONAL MATCH (l)-[o:SERVES]->(other:Stop)
+            WHERE other <> s AND coalesce(o.direction, 'OUTBOUND') = dir AND coalesce(o.pattern, 'MAIN') = pattern
+            RETURN l.id, dir, pattern, count(o)
+        `, map[string]any{"id": c.StopID})
+		if err != nil {
+			return err
+		}
+		recs, err := rs.Collect(ctx)
+		if err != nil {
+			return err
+		}
+		if len(recs) == 0 {
+			return fmt.Errorf("stop %s not found", c.StopID)
+		}
+		// rebuild only the routes that served the stop so its neighbours get a bridge NEXT edge;
+		// a route left without stops simply loses its SERVES edge with the stop
+		for _, rec := range recs {
+			if rec.Values[0] == nil || rec.Values[3].(int64) == 0 {
+				continue
+			}
+			lineId, dir, pat := rec.Values[0].(string), rec.Values[1].(string), rec.Values[2].(string)
+			_, err := editLineRouteTx(ctx, tx, lineId, dir, pat, func(current []string) ([]RouteStop, error) {
+				return routeWithoutStop(current, c.StopID), nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Run(ctx, `MATCH (s:Stop {id:$id}) DETACH DELETE s`, map[string]any{"id": c.StopID})
+		return err
+	case ChangeAddEdge:
+		coords, err := stopCoords(ctx, tx, []string{c.Edge.From, c.Edge.To})
+		if err != nil {
+			return err
+		}
+		travel, dist := estimateEdge(coords[c.Edge.From], coords[c.Edge.To])
+		if c.Edge.TravelTime > 0 {
+			travel = c.Edge.TravelTime
+		}
+		if c.Edge.Distance > 0 {
+			dist = c.Edge.Distance
+		}
+		_, err = tx.Run(ctx, `
+            MATCH (a:Stop {id:$from}), (b:Stop {id:$to})
+            MERGE (a)-[n:NEXT]->(b)
+            ON CREATE SET n.created_at = $now
+            SET n.travel_time = $travel, n.distance = $dist
+        `, map[string]any{"from": c.Edge.From, "to": c.Edge.To, "travel": travel, "dist": dist, "now": time.Now().Unix()})
+		return err
+	case ChangeRemoveEdge:
+		rs, err := tx.Run(ctx, `
+            MATCH (:Stop {id:$from})-[n:NEXT]->(:Stop {id:$to})
+            DELETE n
+            RETURN count(*) AS n
+        `, map[string]any{"from": c.Edge.From, "to": c.Edge.To})
+		if err != nil {
+			return err
+		}
+		if !rs.Next(ctx) || rs.Record().Values[0].(int64) == 0 {
+			return fmt.Errorf("NEXT edge %s -> %s not found", c.Edge.From, c.Edge.To)
+		}
+		return nil
+	case ChangeSetRoute:
+		_, err := editLineRouteTx(ctx, tx, c.LineID, c.Direction, c.Pattern, func([]string) ([]RouteStop, error) {
+			return c.Route, nil
+		})
+		return err
+	case ChangeSetFrequency:
+		if c.FrequencyMins > 0 {
+			rs, err := tx.Run(ctx, `MATCH (l:Line {id:$id}) SET l.frequency_mins = $freq RETURN l.id`,
+				map[string]any{"id": c.LineID, "freq": c.FrequencyMins})
+			if err := expectRow(ctx, rs, err, fmt.Sprintf("line %s not found", c.LineID)); err != nil {
+				return err
+			}
+		}
+		if c.Periods != nil {
+			return setServicePeriodsTx(ctx, tx, c.LineID, c.Periods)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid kind %q", c.Kind)
+}
+
+// routeWithoutStop drops stopId from a route, merging the neighbours when they are the same stop.
+func routeWithoutStop(stops []string, stopId string) []RouteStop {
+	var res []RouteStop
+	for _, id := range stops {
+		if id == stopId || (len(res) > 0 && res[len(res)-1].StopID == id) {
+			continue
+		}
+		res = append(res, RouteStop{StopID: id})
+	}
+	return res
+}
+
+func expectRow(ctx context.Context, rs neo4j.ResultWithContext, err error, missing string) error {
+	if err != nil {
+		return err
+	}
+	if !rs.Next(ctx) {
+		if err := rs.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("%s", missing)
+	}
+	return nil
+}
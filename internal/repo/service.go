@@ -0,0 +1,355 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Service calendar: (:Line)-[:HAS_PERIOD]->(:ServicePeriod) holds a headway for a day type and
+time band, (:Holiday {date}) marks dates that run the HOLIDAY (or, if none, SUNDAY) service.
+Lines without periods run Line.frequency_mins all day, every day.
+*/
+const (
+	DayWeekday  = "WEEKDAY"
+	DaySaturday = "SATURDAY"
+	DaySunday   = "SUNDAY"
+	DayHoliday  = "HOLIDAY"
+)
+
+const dateLayout = "2006-01-02"
+
+type ServicePeriod struct {
+	DayType       string `json:"day_type"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+	FrequencyMins int32  `json:"frequency_mins"`
+}
+
+type LineService struct {
+	LineID        string
+	FrequencyMins int32
+	Periods       []ServicePeriod
+}
+
+func ValidDayType(d string) bool {
+	switch d {
+	case DayWeekday, DaySaturday, DaySunday, DayHoliday:
+		return true
+	}
+	return false
+}
+
+// DayType returns the service day type of t's date.
+func DayType(t time.Time, holidays map[string]bool) string {
+	if holidays[t.Format(dateLayout)] {
+		return DayHoliday
+	}
+	switch t.Weekday() {
+	case time.Saturday:
+		return DaySaturday
+	case time.Sunday:
+		return DaySunday
+	}
+	return DayWeekday
+}
+
+/*
+FrequencyAt returns the headway in minutes at t and the period it comes from (nil when the
+line has no periods). 0 means the line does not run at t.
+*/
+func (ls LineService) FrequencyAt(t time.Time, holidays map[string]bool) (int32, *ServicePeriod) {
+	if len(ls.Periods) == 0 {
+		return ls.FrequencyMins, nil
+	}
+	day := ls.DayType(t, holidays)
+	minute := t.Hour()*60 + t.Minute()
+	for i := range ls.Periods {
+		p := &ls.Periods[i]
+		if p.DayType != day {
+			continue
+		}
+		from, err := helper.ClockMinutes(p.StartTime)
+		if err != nil {
+			continue
+		}
+		to, err := helper.ClockMinutes(p.EndTime)
+		if err != nil {
+			continue
+		}
+		if helper.InClockWindow(from, to, minute) {
+			return p.FrequencyMins, p
+		}
+	}
+	return 0, nil
+}
+
+// DeparturesPerHour averages the number of departures over the hour starting at t.
+func (ls LineService) DeparturesPerHour(t time.Time, holidays map[string]bool) float64 {
+	total := 0.0
+	for m := 0; m < 60; m += 5 {
+		if f, _ := ls.FrequencyAt(t.Add(time.Duration(m)*time.Minute), holidays); f > 0 {
+			total += 60.0 / float64(f)
+		}
+	}
+	return total / 12
+}
+
+/*
+DayType returns the day type whose periods the line runs on t's date: holidays fall back to the
+SUNDAY service when the line has no HOLIDAY periods.
+*/
+func (ls LineService) DayType(t time.Time, holidays map[string]bool) string {
+	day := DayType(t, holidays)
+	if day != DayHoliday {
+		return day
+	}
+	for _, p := range ls.Periods {
+		if p.DayType == DayHoliday {
+			return day
+		}
+	}
+	return DaySunday
+}
+
+func (r *NeoRepo) SetServicePeriods(ctx context.Context, lineId string, periods []ServicePeriod) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return nil, setServicePeriodsTx(ctx, tx, lineId, periods)
+	})
+	return err
+}
+
+func setServicePeriodsTx(ctx context.Context, tx neo4j.ManagedTransaction, lineId string, periods []ServicePeriod) error {
+	rows := make([]map[string]any, 0, len(periods))
+	for _, p := range periods {
+		rows = append(rows, map[string]any{
+			"day_type": p.DayType, "start_time": p.StartTime, "end_time": p.EndTime, "frequency_mins": p.FrequencyMins,
+		})
+	}
+	rs, err := tx.Run(ctx, `MATCH (l:Line {id:$line}) RETURN l.id`, map[string]any{"line": lineId})
+	if err != nil {
+		return err
+	}
+	if !rs.Next(ctx) {
+		return fmt.Errorf("line %s not found", lineId)
+	}
+	if _, err := tx.Run(ctx, `MATCH (:Line {id:$line})-[:HAS_PERIOD]->(p:ServicePeriod) DETACH DELETE p`,
+		map[string]any{"line": lineId}); err != nil {
+		return err
+	}
+	_, err = tx.Run(ctx, `
+        MATCH (l:Line {id:$line})
+        UNWIND $periods AS p
+        CREATE (l)-[:HAS_PERIOD]->(sp:ServicePeriod {line_id:$line})
+        SET sp += p
+    `, map[string]any{"line": lineId, "periods": rows})
+	return err
+}
+
+func (r *NeoRepo) GetLineService(ctx context.Context, lineId string) (*LineService, error) {
+	res, err := r.getLineServices(ctx, lineId)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("line %s not found", lineId)
+	}
+	return &res[0], nil
+}
+
+func (r *NeoRepo) GetLineServices(ctx context.Context) ([]LineService, error) {
+	return r.getLineServices(ctx, "")
+}
+
+func (r *NeoRepo) getLineServices(ctx context.Context, lineId string) ([]LineService, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line)
+            WHERE $line = '' OR l.id = $line
+            OPTIONAL MATCH (l)-[:HAS_PERIOD]->(p:ServicePeriod)
+            WITH l, p
+            ORDER BY p.day_type, p.start_time
+            RETURN l.id AS line_id, l.frequency_mins AS freq,
+                   collect(CASE WHEN p IS NULL THEN NULL ELSE properties(p) END) AS periods
+            ORDER BY line_id
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		var res []LineService
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			ls := LineService{
+				LineID:        rec.Values[0].(string),
+				FrequencyMins: helper.AnyToInt32(rec.Values[1]),
+			}
+			for _, item := range rec.Values[2].([]any) {
+				m := item.(map[string]any)
+				ls.Periods = append(ls.Periods, ServicePeriod{
+					DayType:       helper.AnyToString(m["day_type"]),
+					StartTime:     helper.AnyToString(m["start_time"]),
+					EndTime:       helper.AnyToString(m["end_time"]),
+					FrequencyMins: helper.AnyToInt32(m["frequency_mins"]),
+				})
+			}
+			res = append(res, ls)
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]LineService), nil
+}
+
+/* Holidays */
+func (r *NeoRepo) CreateHoliday(ctx context.Context, date, name string) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MERGE (h:Holiday {date:$date}) SET h.name = $name`, map[string]any{"date": date, "name": name})
+		return nil, err
+	})
+	return err
+}
+
+func (r *NeoRepo) DeleteHoliday(ctx context.Context, date string) error {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `MATCH (h:Holiday {date:$date}) DELETE h`, map[string]any{"date": date})
+		return nil, err
+	})
+	return err
+}
+
+// ListHolidays returns holiday names keyed by "YYYY-MM-DD" date.
+func (r *NeoRepo) ListHolidays(ctx context.Context) (map[string]string, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `MATCH (h:Holiday) RETURN h.date, coalesce(h.name, '')`, nil)
+		if err != nil {
+			return nil, err
+		}
+		res := map[string]string{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res[helper.AnyToString(rec.Values[0])] = helper.AnyToString(rec.Values[1])
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(map[string]string), nil
+}
+
+func (r *NeoRepo) HolidaySet(ctx context.Context) (map[string]bool, error) {
+	hs, err := r.ListHolidays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]bool, len(hs))
+	for d := range hs {
+		res[d] = true
+	}
+	return res, nil
+}
+
+func ValidDate(date string) bool {
+	_, err := time.Parse(dateLayout, date)
+	return err == nil
+}
+
+/* Fleet sizing */
+type LineFleet struct {
+	LineID        string `json:"line_id"`
+	Name          string `json:"name"`
+	Active        bool   `json:"active"`
+	CycleSeconds  int    `json:"cycle_seconds"`
+	AssignedCount int    `json:"assigned_count"`
+}
+
+/*
+GetLineFleet returns the MAIN-pattern round trip time of every line (summed NEXT travel_time
+of both directions, or twice the outbound run when the line has a single direction) and
+the number of vehicles assigned to it.
+*/
+func (r *NeoRepo) GetLineFleet(ctx context.Context) ([]LineFleet, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line)
+            OPTIONAL MATCH (l)-[r1:SERVES]->(:Stop)-[n:NEXT]->(:Stop)<-[r2:SERVES]-(l)
+            WHERE coalesce(r1.pattern, 'MAIN') = 'MAIN' AND coalesce(r2.pattern, 'MAIN') = 'MAIN'
+              AND coalesce(r1.direction, 'OUTBOUND') = coalesce(r2.direction, 'OUTBOUND')
+              AND r2.order = r1.order + 1
+            WITH l, sum(coalesce(n.travel_time, 0)) AS run, count(DISTINCT coalesce(r1.direction, 'OUTBOUND')) AS dirs
+            OPTIONAL MATCH (v:Vehicle)-[:ASSIGNED_TO]->(l)
+            RETURN l.id, coalesce(l.name, ''), coalesce(l.active, false), run, dirs, count(DISTINCT v)
+            ORDER BY l.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		var res []LineFleet
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			cycle := int(helper.AnyToInt64(rec.Values[3]))
+			if helper.AnyToInt64(rec.Values[4]) < 2 {
+				cycle *= 2
+			}
+			res = append(res, LineFleet{
+				LineID:        rec.Values[0].(string),
+				Name:          rec.Values[1].(string),
+				Active:        rec.Values[2].(bool),
+				CycleSeconds:  cycle,
+				AssignedCount: int(helper.AnyToInt64(rec.Values[5])),
+			})
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]LineFleet), nil
+}
+
+type LineFleetSize struct {
+	LineFleet
+	FrequencyMins int32 `json:"frequency_mins"`
+	Required      int   `json:"required"`
+}
+
+// SizeFleet sizes every line for the headway its service gives at t; inactive lines need no vehicles.
+func SizeFleet(fleet []LineFleet, services map[string]LineService, holidays map[string]bool, at time.Time) []LineFleetSize {
+	res := make([]LineFleetSize, 0, len(fleet))
+	for _, f := range fleet {
+		freq, _ := services[f.LineID].FrequencyAt(at, holidays)
+		if !f.Active {
+			freq = 0
+		}
+		res = append(res, LineFleetSize{LineFleet: f, FrequencyMins: freq, Required: RequiredVehicles(f.CycleSeconds, freq)})
+	}
+	return res
+}
+
+// RequiredVehicles is the number of vehicles needed to run a cycle at the given headway.
+func RequiredVehicles(cycleSeconds int, frequencyMins int32) int {
+	if frequencyMins <= 0 || cycleSeconds <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(cycleSeconds) / float64(frequencyMins*60)))
+}
@@ -0,0 +1,237 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type TimedStop struct {
+	StopID string `json:"stop_id"`
+	Name   string `json:"name"`
+	Order  int    `json:"order"`
+	// TravelTime is the NEXT travel_time from the previous stop in seconds.
+	TravelTime int32 `json:"travel_time"`
+	// Profile holds the time-of-day travel times of the same edge.
+	Profile   EdgeProfile `json:"profile"`
+	DwellTime int32       `json:"dwell_secs"`
+	Lat       float64     `json:"lat"`
+	Lon       float64     `json:"lon"`
+	// Offset is the arrival in seconds after departure from the first stop using base travel times.
+	Offset int32 `json:"offset_secs"`
+}
+
+type Timetable struct {
+	LineID    string      `json:"line_id"`
+	Direction string      `json:"direction"`
+	Pattern   string      `json:"pattern"`
+	Date      string      `json:"date"`
+	DayType   string      `json:"day_type"`
+	Stops     []TimedStop `json:"stops"`
+	// Departures from the first stop in minutes after midnight of Date.
+	Departures []int `json:"departures"`
+	// TripOffsets are the per-trip arrival offsets using the travel time profile at departure.
+	TripOffsets [][]int32 `json:"trip_offsets"`
+}
+
+// ArrivalAt returns the scheduled arrival of trip i at stop j in minutes after midnight.
+func (t *Timetable) ArrivalAt(i, j int) int {
+	offset := t.Stops[j].Offset
+	if i < len(t.TripOffsets) {
+		offset = t.TripOffsets[i][j]
+	}
+	return t.Departures[i] + int(offset+30)/60
+}
+
+/*
+Departures lists the first-stop departures of date in minutes after midnight: every
+period of the day type is run from its start at its headway, lines without periods run
+the base frequency from midnight. Bands past midnight continue beyond 24:00.
+*/
+func (ls LineService) Departures(date time.Time, holidays map[string]bool) []int {
+	if len(ls.Periods) == 0 {
+		if ls.FrequencyMins <= 0 {
+			return nil
+		}
+		var res []int
+		for m := 0; m < 24*60; m += int(ls.FrequencyMins) {
+			res = append(res, m)
+		}
+		return res
+	}
+	day := ls.DayType(date, holidays)
+	var res []int
+	for _, p := range ls.Periods {
+		if p.DayType != day || p.FrequencyMins <= 0 {
+			continue
+		}
+		from, err := helper.ClockMinutes(p.StartTime)
+		if err != nil {
+			continue
+		}
+		to, err := helper.ClockMinutes(p.EndTime)
+		if err != nil {
+			continue
+		}
+		if to <= from {
+			to += 24 * 60
+		}
+		for m := from; m < to; m += int(p.FrequencyMins) {
+			res = append(res, m)
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+/*
+GenerateTimetable schedules a line route for one date. Arrivals accumulate NEXT.travel_time
+along the SERVES order plus the dwell of every intermediate stop; dwell comes from stopDwell,
+then Stop.dwell_time, then defaultDwell. Every trip uses the edge profiles of its own time of
+day. Non-MAIN patterns only run inside their active window.
+*/
+func (r *NeoRepo) GenerateTimetable(ctx context.Context, lineId, dir, pat string, date time.Time, defaultDwell int32, stopDwell map[string]int32) (*Timetable, error) {
+	ls, err := r.GetLineService(ctx, lineId)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := r.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stops, window, err := r.getTimedRoute(ctx, lineId, direction(dir), pattern(pat))
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleOffsets(stops, defaultDwell, stopDwell)
+
+	tt := &Timetable{
+		LineID:    lineId,
+		Direction: direction(dir),
+		Pattern:   pattern(pat),
+		Date:      date.Format(dateLayout),
+		DayType:   ls.DayType(date, holidays),
+		Stops:     stops,
+	}
+	for _, m := range ls.Departures(date, holidays) {
+		if !PatternActiveAt(window[0], window[1], m%(24*60)) {
+			continue
+		}
+		tt.Departures = append(tt.Departures, m)
+		tt.TripOffsets = append(tt.TripOffsets, profiledOffsets(stops, date.Add(time.Duration(m)*time.Minute), holidays))
+	}
+	return tt, nil
+}
+
+// scheduleOffsets fills the dwell and arrival offset of every stop; terminals have no dwell.
+func scheduleOffsets(stops []TimedStop, defaultDwell int32, stopDwell map[string]int32) {
+	var offset int32
+	for i := range stops {
+		st := &stops[i]
+		if d, ok := stopDwell[st.StopID]; ok {
+			st.DwellTime = d
+		} else if st.DwellTime == 0 {
+			st.DwellTime = defaultDwell
+		}
+		if i == 0 || i == len(stops)-1 {
+			st.DwellTime = 0
+		}
+		if i > 0 {
+			offset += stops[i-1].DwellTime + st.TravelTime
+		}
+		st.Offset = offset
+	}
+}
+
+/*
+profiledOffsets is scheduleOffsets for a trip leaving the first stop at start: every edge takes
+the profile travel time of the moment the vehicle leaves the previous stop. Dwell times must
+already be filled.
+*/
+func profiledOffsets(stops []TimedStop, start time.Time, holidays map[string]bool) []int32 {
+	res := make([]int32, len(stops))
+	var offset int32
+	for i := 1; i < len(stops); i++ {
+		offset += stops[i-1].DwellTime
+		leave := start.Add(time.Duration(offset) * time.Second)
+		offset += stops[i].Profile.TravelTime(stops[i].TravelTime, leave, holidays)
+		res[i] = offset
+	}
+	return res
+}
+
+// getTimedRoute returns the ordered stops of a route with travel times and the pattern's active window.
+func (r *NeoRepo) getTimedRoute(ctx context.Context, lineId, dir, pat string) ([]TimedStop, [2]string, error) {
+	var window [2]string
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (l:Line {id:$line})-[r:SERVES]->(s:Stop)
+            WHERE coalesce(r.direction, 'OUTBOUND') = $dir AND coalesce(r.pattern, 'MAIN') = $pattern
+            OPTIONAL MATCH (l)-[r0:SERVES]->(prev:Stop)
+            WHERE coalesce(r0.direction, 'OUTBOUND') = $dir AND coalesce(r0.pattern, 'MAIN') = $pattern
+              AND r0.order = r.order - 1
+            OPTIONAL MATCH (prev)-[n:NEXT]->(s)
+            RETURN s.id, coalesce(s.name, ''), r.order, prev.id, n.travel_time, coalesce(s.dwell_time, 0), s.lat, s.lon,
+                   CASE WHEN n IS NULL THEN {} ELSE properties(n) END
+            ORDER BY r.order
+        `, map[string]any{"line": lineId, "dir": dir, "pattern": pat})
+		if err != nil {
+			return nil, err
+		}
+		var res []TimedStop
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			st := TimedStop{
+				StopID:     rec.Values[0].(string),
+				Name:       rec.Values[1].(string),
+				Order:      int(helper.AnyToInt64(rec.Values[2])),
+				TravelTime: helper.AnyToInt32(rec.Values[4]),
+				DwellTime:  helper.AnyToInt32(rec.Values[5]),
+			}
+			st.Lat, _ = toFloat(rec.Values[6])
+			st.Lon, _ = toFloat(rec.Values[7])
+			st.Profile = profileFromProps(rec.Values[8].(map[string]any))
+			if len(res) > 0 {
+				if rec.Values[3] == nil {
+					return nil, fmt.Errorf("line %s %s/%s: stop order broken before %s", lineId, dir, pat, st.StopID)
+				}
+				if rec.Values[4] == nil {
+					return nil, fmt.Errorf("line %s %s/%s: missing NEXT edge %s -> %s", lineId, dir, pat, rec.Values[3], st.StopID)
+				}
+			}
+			res = append(res, st)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			return nil, fmt.Errorf("line %s has no stops for %s/%s", lineId, dir, pat)
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (:Line {id:$line})-[:HAS_PATTERN]->(p:Pattern {name:$pattern})
+            WHERE coalesce(p.direction, 'OUTBOUND') = $dir
+            RETURN coalesce(p.start_time, ''), coalesce(p.end_time, '')
+        `, map[string]any{"line": lineId, "dir": dir, "pattern": pat})
+		if err != nil {
+			return nil, err
+		}
+		if rs.Next(ctx) {
+			rec := rs.Record()
+			window = [2]string{rec.Values[0].(string), rec.Values[1].(string)}
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, window, err
+	}
+	return out.([]TimedStop), window, nil
+}
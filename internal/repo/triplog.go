@@ -0,0 +1,188 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// maxObservedTravel rejects gaps between arrivals that cannot be a single NEXT hop (layovers, lost data).
+const maxObservedTravel = time.Hour
+
+// TripLog is one completed trip reported by the AVL system.
+type TripLog struct {
+	TripID      string
+	VehicleUUID string
+	LineID      string
+	Arrivals    []StopArrival
+}
+
+type StopArrival struct {
+	StopID string
+	At     time.Time
+}
+
+/*
+Observations turns consecutive stop arrivals into edge travel times. Pairs that go back in
+time or exceed maxObservedTravel are returned as problems; repeated arrivals at the same stop
+are ignored.
+*/
+func (t TripLog) Observations() ([]TravelObservation, []string) {
+	var obs []TravelObservation
+	var problems []string
+	for i := 1; i < len(t.Arrivals); i++ {
+		a, b := t.Arrivals[i-1], t.Arrivals[i]
+		if a.StopID == b.StopID {
+			continue
+		}
+		dt := b.At.Sub(a.At)
+		if dt <= 0 || dt > maxObservedTravel {
+			problems = append(problems, fmt.Sprintf("trip %s: %s -> %s took %s", t.TripID, a.StopID, b.StopID, dt))
+			continue
+		}
+		obs = append(obs, TravelObservation{
+			From:        a.StopID,
+			To:          b.StopID,
+			At:          a.At,
+			Observed:    int32(math.Round(dt.Seconds())),
+			VehicleUUID: t.VehicleUUID,
+			LineID:      t.LineID,
+		})
+	}
+	return obs, problems
+}
+
+type IngestResult struct {
+	Recorded int
+	// Unknown are observations between stops without a NEXT edge; they are not stored.
+	Unknown      []TravelObservation
+	Calibrations []Calibration
+	Profiles     int
+}
+
+// Drift is the relative change of the edge travel time made by the calibration.
+func (c Calibration) Drift() float64 {
+	if c.OldTravelTime <= 0 {
+		return 0
+	}
+	return float64(c.NewTravelTime-c.OldTravelTime) / float64(c.OldTravelTime)
+}
+
+/*
+IngestObservations stores the observations of existing edges and recalibrates every edge they
+touch with opts, optionally rebuilding its time-of-day profile as well. All of it is written in one
+transaction, so a failed ingest leaves the graph as it was.
+*/
+func (r *NeoRepo) IngestObservations(ctx context.Context, obs []TravelObservation, opts CalibrationOptions, profiles bool) (*IngestResult, error) {
+	if err := opts.defaults(); err != nil {
+		return nil, err
+	}
+	known, err := r.existingEdges(ctx, obs)
+	if err != nil {
+		return nil, err
+	}
+	res := &IngestResult{}
+	var valid []TravelObservation
+	for _, o := range obs {
+		if known[[2]string{o.From, o.To}] {
+			valid = append(valid, o)
+		} else {
+			res.Unknown = append(res.Unknown, o)
+		}
+	}
+	res.Recorded = len(valid)
+	if len(valid) == 0 {
+		return res, nil
+	}
+
+	touched := map[[2]string]bool{}
+	var edges [][2]string
+	for _, o := range valid {
+		k := [2]string{o.From, o.To}
+		if !touched[k] {
+			touched[k] = true
+			edges = append(edges, k)
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i][0] != edges[j][0] {
+			return edges[i][0] < edges[j][0]
+		}
+		return edges[i][1] < edges[j][1]
+	})
+	var holidays map[string]bool
+	if profiles {
+		if holidays, err = r.HolidaySet(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// the transaction may be retried, so start from a clean result each time
+		res.Calibrations, res.Profiles = nil, 0
+		if err := recordTravelTimesTx(ctx, tx, valid); err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			c, err := recalibrateNextTx(ctx, tx, e[0], e[1], opts)
+			if err != nil {
+				return nil, err
+			}
+			res.Calibrations = append(res.Calibrations, *c)
+			if profiles {
+				built, err := buildEdgeProfilesTx(ctx, tx, e[0], e[1], opts, holidays)
+				if err != nil {
+					return nil, err
+				}
+				for _, b := range built {
+					res.Profiles += len(b.Profile)
+				}
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *NeoRepo) existingEdges(ctx context.Context, obs []TravelObservation) (map[[2]string]bool, error) {
+	pairs := make([]map[string]any, 0, len(obs))
+	seen := map[[2]string]bool{}
+	for _, o := range obs {
+		k := [2]string{o.From, o.To}
+		if !seen[k] {
+			seen[k] = true
+			pairs = append(pairs, map[string]any{"from": o.From, "to": o.To})
+		}
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            UNWIND $pairs AS p
+            MATCH (a:Stop {id:p.from})-[:NEXT]->(b:Stop {id:p.to})
+            RETURN a.id, b.id
+        `, map[string]any{"pairs": pairs})
+		if err != nil {
+			return nil, err
+		}
+		res := map[[2]string]bool{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res[[2]string{rec.Values[0].(string), rec.Values[1].(string)}] = true
+		}
+		return res, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(map[[2]string]bool), nil
+}
@@ -0,0 +1,252 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	SeverityError   = "ERROR"
+	SeverityWarning = "WARNING"
+)
+
+func finding(severity, code, lineId, stopId, msg string) map[string]any {
+	return map[string]any{
+		"severity":  severity,
+		"code":      code,
+		"line_id":   lineId,
+		"direction": "",
+		"pattern":   "",
+		"stop_id":   stopId,
+		"message":   msg,
+	}
+}
+
+func routeFinding(severity, code, lineId, dir, pat, stopId, msg string) map[string]any {
+	f := finding(severity, code, lineId, stopId, msg)
+	f["direction"] = dir
+	f["pattern"] = pat
+	return f
+}
+
+/*
+ValidateNetwork checks line routes (SERVES order, NEXT links between consecutive stops),
+orphan stops, inactive lines with vehicles and stop/depot coordinates.
+When lineId is set only that line and its stops are checked.
+*/
+func (r *NeoRepo) ValidateNetwork(ctx context.Context, lineId string) ([]map[string]any, error) {
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res := []map[string]any{}
+
+		routes, err := validateRoutes(ctx, tx, lineId)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, routes...)
+
+		rs, err := tx.Run(ctx, `
+            MATCH (v:Vehicle)-[:ASSIGNED_TO]->(l:Line)
+            WHERE coalesce(l.active, false) = false AND ($line = '' OR l.id = $line)
+            RETURN l.id AS line_id, collect(v.vehicle_uuid) AS vehicles
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			res = append(res, finding(SeverityWarning, "INACTIVE_LINE_ASSIGNED", rec.Values[0].(string), "",
+				fmt.Sprintf("inactive line has assigned vehicles %v", rec.Values[1])))
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (s:Stop)
+            WHERE $line = '' OR (:Line {id:$line})-[:SERVES]->(s)
+            RETURN s.id AS id, s.lat AS lat, s.lon AS lon, 'Stop' AS kind
+            UNION ALL
+            MATCH (d:Depot)
+            WHERE $line = ''
+            RETURN d.id AS id, d.lat AS lat, d.lon AS lon, 'Depot' AS kind
+        `, map[string]any{"line": lineId})
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			id, _ := rec.Values[0].(string)
+			if msg := checkCoordinates(rec.Values[1], rec.Values[2]); msg != "" {
+				res = append(res, finding(SeverityError, "INVALID_COORDINATES", "", id,
+					fmt.Sprintf("%s %s: %s", rec.Values[3], id, msg)))
+			}
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		if lineId != "" {
+			return res, nil
+		}
+		rs, err = tx.Run(ctx, `
+            MATCH (s:Stop)
+            WHERE NOT (:Line)-[:SERVES]->(s)
+            RETURN s.id AS stop_id, EXISTS { (s)-[:NEXT]-(:Stop) } AS linked
+            ORDER BY stop_id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			msg := "stop is not served by any line"
+			if linked, _ := rec.Values[1].(bool); !linked {
+				msg += " and has no NEXT edges"
+			}
+			res = append(res, finding(SeverityWarning, "ORPHAN_STOP", "", rec.Values[0].(string), msg))
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]map[string]any), nil
+}
+
+func validateRoutes(ctx context.Context, tx neo4j.ManagedTransaction, lineId string) ([]map[string]any, error) {
+	rs, err := tx.Run(ctx, `
+        MATCH (l:Line)
+        WHERE $line = '' OR l.id = $line
+        OPTIONAL MATCH (l)-[r:SERVES]->(s:Stop)
+        WITH l, r, s, coalesce(r.direction, 'OUTBOUND') AS direction, coalesce(r.pattern, 'MAIN') AS pattern
+        ORDER BY r.order
+        RETURN l.id AS line_id, direction, pattern,
+               collect(CASE WHEN s IS NULL THEN NULL ELSE {stop_id: s.id, order: r.order} END) AS route
+        ORDER BY line_id, direction DESC, pattern
+    `, map[string]any{"line": lineId})
+	if err != nil {
+		return nil, err
+	}
+	type routeStop struct {
+		stopId string
+		order  int64
+	}
+	res := []map[string]any{}
+	var pairs []map[string]any
+	for rs.Next(ctx) {
+		rec := rs.Record()
+		line := rec.Values[0].(string)
+		dir := rec.Values[1].(string)
+		pat := rec.Values[2].(string)
+		raw := rec.Values[3].([]any)
+		if len(raw) == 0 {
+			res = append(res, finding(SeverityWarning, "LINE_WITHOUT_STOPS", line, "", "line does not serve any stop"))
+			continue
+		}
+
+		var route []routeStop
+		seenStop := map[string]int{}
+		seenOrder := map[int64]string{}
+		for _, item := range raw {
+			m := item.(map[string]any)
+			stopId, _ := m["stop_id"].(string)
+			seenStop[stopId]++
+			ord, ok := m["order"].(int64)
+			if !ok {
+				res = append(res, routeFinding(SeverityError, "ORDER_MISSING", line, dir, pat, stopId, "SERVES edge has no order"))
+				continue
+			}
+			if ord < 1 {
+				res = append(res, routeFinding(SeverityError, "ORDER_INVALID", line, dir, pat, stopId,
+					fmt.Sprintf("order %d is below 1", ord)))
+			}
+			if prev, dup := seenOrder[ord]; dup {
+				res = append(res, routeFinding(SeverityError, "ORDER_DUPLICATE", line, dir, pat, stopId,
+					fmt.Sprintf("order %d is also used by stop %s", ord, prev)))
+			} else {
+				seenOrder[ord] = stopId
+			}
+			route = append(route, routeStop{stopId: stopId, order: ord})
+		}
+		for stopId, n := range seenStop {
+			if n > 1 {
+				res = append(res, routeFinding(SeverityWarning, "STOP_SERVED_TWICE", line, dir, pat, stopId,
+					fmt.Sprintf("stop is served %d times by this route", n)))
+			}
+		}
+
+		sort.SliceStable(route, func(i, j int) bool { return route[i].order < route[j].order })
+		if len(route) > 0 {
+			last := route[len(route)-1].order
+			for want := int64(1); want <= last; want++ {
+				if _, ok := seenOrder[want]; !ok {
+					res = append(res, routeFinding(SeverityError, "ORDER_GAP", line, dir, pat, "",
+						fmt.Sprintf("order %d is missing from the sequence 1..%d", want, last)))
+				}
+			}
+		}
+		for i := 0; i+1 < len(route); i++ {
+			if route[i].stopId == route[i+1].stopId {
+				continue
+			}
+			pairs = append(pairs, map[string]any{"line": line, "dir": dir, "pattern": pat, "from": route[i].stopId, "to": route[i+1].stopId})
+		}
+	}
+	if err := rs.Err(); err != nil {
+		return nil, err
+	}
+	if len(pairs) == 0 {
+		return res, nil
+	}
+
+	rs, err = tx.Run(ctx, `
+        UNWIND $pairs AS p
+        MATCH (a:Stop {id:p.from}), (b:Stop {id:p.to})
+        WHERE NOT (a)-[:NEXT]->(b)
+        RETURN p.line AS line_id, p.dir AS direction, p.pattern AS pattern, p.from AS from, p.to AS to
+    `, map[string]any{"pairs": pairs})
+	if err != nil {
+		return nil, err
+	}
+	for rs.Next(ctx) {
+		rec := rs.Record()
+		from := rec.Values[3].(string)
+		to := rec.Values[4].(string)
+		res = append(res, routeFinding(SeverityError, "MISSING_NEXT", rec.Values[0].(string), rec.Values[1].(string), rec.Values[2].(string), from,
+			fmt.Sprintf("no NEXT edge between consecutive stops %s -> %s", from, to)))
+	}
+	return res, rs.Err()
+}
+
+func checkCoordinates(latAny, lonAny any) string {
+	lat, okLat := toFloat(latAny)
+	lon, okLon := toFloat(lonAny)
+	switch {
+	case !okLat || !okLon:
+		return "missing or non-numeric lat/lon"
+	case lat < -90 || lat > 90 || lon < -180 || lon > 180:
+		return fmt.Sprintf("lat/lon out of range (%.5f, %.5f)", lat, lon)
+	case lat == 0 && lon == 0:
+		return "lat/lon not set (0, 0)"
+	}
+	return ""
+}
+
+func toFloat(v any) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case int64:
+		return float64(t), true
+	default:
+		return 0, false
+	}
+}
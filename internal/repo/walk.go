@@ -0,0 +1,265 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	helper "route-graph-service/util"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+/*
+Walking transfers are (:Stop)-[:WALK {distance, walk_time}]->(:Stop) links generated in both
+directions between stops closer than a radius. They are never used by line routes, only by
+ShortestPath when walking is allowed and by transfer hub detection.
+*/
+const (
+	DefaultWalkRadius = 150.0
+	DefaultWalkSpeed  = 1.3
+	maxWalkRadius     = 1000.0
+)
+
+type WalkLinkStats struct {
+	Created int
+	Updated int
+	Removed int
+}
+
+// TransferHub is located at its Centre stop; MaxWalkTime is the longest walk from the centre.
+type TransferHub struct {
+	Centre      string
+	Stops       []string
+	Lines       []string
+	Lat         float64
+	Lon         float64
+	MaxWalkTime int32
+}
+
+func (r *NeoRepo) GenerateWalkLinks(ctx context.Context, radius, speed float64, replace bool) (*WalkLinkStats, error) {
+	if radius <= 0 {
+		radius = DefaultWalkRadius
+	}
+	if speed <= 0 {
+		speed = DefaultWalkSpeed
+	}
+	if radius > maxWalkRadius {
+		return nil, fmt.Errorf("radius must not exceed %.0f m", maxWalkRadius)
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	out, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		stats := &WalkLinkStats{}
+		if replace {
+			rs, err := tx.Run(ctx, `MATCH (:Stop)-[w:WALK]->(:Stop) DELETE w RETURN count(*) AS n`, nil)
+			if err != nil {
+				return nil, err
+			}
+			if rs.Next(ctx) {
+				stats.Removed = int(helper.AnyToInt64(rs.Record().Values[0]))
+			}
+		}
+
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Stop) WHERE s.lat IS NOT NULL AND s.lon IS NOT NULL
+            RETURN s.id, s.lat, s.lon ORDER BY s.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		type point struct {
+			id       string
+			lat, lon float64
+		}
+		var stops []point
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			p := point{id: rec.Values[0].(string)}
+			p.lat, _ = toFloat(rec.Values[1])
+			p.lon, _ = toFloat(rec.Values[2])
+			stops = append(stops, p)
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		var links []map[string]any
+		for i := range stops {
+			for j := i + 1; j < len(stops); j++ {
+				a, b := stops[i], stops[j]
+				d := helper.HaversineMeters(a.lat, a.lon, b.lat, b.lon)
+				if d > radius {
+					continue
+				}
+				walk := int32(math.Ceil(d / speed))
+				dist := int32(math.Round(d))
+				links = append(links,
+					map[string]any{"from": a.id, "to": b.id, "distance": dist, "walk_time": walk},
+					map[string]any{"from": b.id, "to": a.id, "distance": dist, "walk_time": walk})
+			}
+		}
+		if len(links) == 0 {
+			return stats, nil
+		}
+		rs, err = tx.Run(ctx, `
+            UNWIND $links AS l
+            MATCH (a:Stop {id:l.from}), (b:Stop {id:l.to})
+            OPTIONAL MATCH (a)-[old:WALK]->(b)
+            WITH a, b, l, old IS NULL AS missing
+            MERGE (a)-[w:WALK]->(b)
+            ON CREATE SET w.created_at = $now
+            SET w.distance = l.distance, w.walk_time = l.walk_time
+            RETURN sum(CASE WHEN missing THEN 1 ELSE 0 END), sum(CASE WHEN missing THEN 0 ELSE 1 END)
+        `, map[string]any{"links": links, "now": time.Now().Unix()})
+		if err != nil {
+			return nil, err
+		}
+		if rs.Next(ctx) {
+			rec := rs.Record()
+			stats.Created = int(helper.AnyToInt64(rec.Values[0]))
+			stats.Updated = int(helper.AnyToInt64(rec.Values[1]))
+		}
+		return stats, rs.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.(*WalkLinkStats), nil
+}
+
+/*
+TransferHubs clusters stops around a centre stop: a hub is the centre and the stops it has a WALK
+link to, so every stop of a hub is within the walk radius of the centre. Candidate centres are
+taken greedily by the number of distinct lines their cluster reaches, then by size, and a stop
+joins at most one hub. Chains of nearby stops therefore split into several hubs instead of
+growing into one long cluster.
+*/
+func (r *NeoRepo) TransferHubs(ctx context.Context, minLines, limit int) ([]TransferHub, error) {
+	if minLines <= 0 {
+		minLines = 2
+	}
+	session := r.drv.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	out, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		rs, err := tx.Run(ctx, `
+            MATCH (s:Stop)
+            OPTIONAL MATCH (l:Line)-[:SERVES]->(s)
+            RETURN s.id, s.lat, s.lon, collect(DISTINCT l.id)
+            ORDER BY s.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		coords := map[string][2]float64{}
+		lines := map[string][]string{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			id := rec.Values[0].(string)
+			ids = append(ids, id)
+			lat, _ := toFloat(rec.Values[1])
+			lon, _ := toFloat(rec.Values[2])
+			coords[id] = [2]float64{lat, lon}
+			for _, l := range rec.Values[3].([]any) {
+				lines[id] = append(lines[id], l.(string))
+			}
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		rs, err = tx.Run(ctx, `
+            MATCH (a:Stop)-[w:WALK]->(b:Stop)
+            RETURN a.id, b.id, coalesce(w.walk_time, 0)
+            ORDER BY a.id, b.id
+        `, nil)
+		if err != nil {
+			return nil, err
+		}
+		type walk struct {
+			to   string
+			time int32
+		}
+		walks := map[string][]walk{}
+		for rs.Next(ctx) {
+			rec := rs.Record()
+			from := rec.Values[0].(string)
+			walks[from] = append(walks[from], walk{to: rec.Values[1].(string), time: helper.AnyToInt32(rec.Values[2])})
+		}
+		if err := rs.Err(); err != nil {
+			return nil, err
+		}
+
+		// hub builds the cluster around centre from the stops not taken by an earlier hub
+		taken := map[string]bool{}
+		hub := func(centre string) (TransferHub, int) {
+			h := TransferHub{Centre: centre, Stops: []string{centre}, Lat: coords[centre][0], Lon: coords[centre][1]}
+			seen := map[string]bool{}
+			for _, l := range lines[centre] {
+				seen[l] = true
+			}
+			for _, w := range walks[centre] {
+				if taken[w.to] {
+					continue
+				}
+				h.Stops = append(h.Stops, w.to)
+				h.MaxWalkTime = max(h.MaxWalkTime, w.time)
+				for _, l := range lines[w.to] {
+					seen[l] = true
+				}
+			}
+			for l := range seen {
+				h.Lines = append(h.Lines, l)
+			}
+			sort.Strings(h.Lines)
+			sort.Strings(h.Stops)
+			return h, len(h.Lines)
+		}
+		byRank := func(hs []TransferHub) {
+			sort.SliceStable(hs, func(i, j int) bool {
+				if len(hs[i].Lines) != len(hs[j].Lines) {
+					return len(hs[i].Lines) > len(hs[j].Lines)
+				}
+				if len(hs[i].Stops) != len(hs[j].Stops) {
+					return len(hs[i].Stops) > len(hs[j].Stops)
+				}
+				return hs[i].Centre < hs[j].Centre
+			})
+		}
+
+		var candidates []TransferHub
+		for _, id := range ids {
+			if h, n := hub(id); n >= minLines {
+				candidates = append(candidates, h)
+			}
+		}
+		byRank(candidates)
+		var res []TransferHub
+		for _, c := range candidates {
+			if taken[c.Centre] {
+				continue
+			}
+			// members taken since the candidate was ranked drop out, which may leave too few lines
+			h, n := hub(c.Centre)
+			if n < minLines {
+				continue
+			}
+			for _, id := range h.Stops {
+				taken[id] = true
+			}
+			res = append(res, h)
+		}
+		byRank(res)
+		if limit > 0 && len(res) > limit {
+			res = res[:limit]
+		}
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.([]TransferHub), nil
+}
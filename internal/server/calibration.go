@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) RecalibrateEdge(ctx context.Context, req *pb.RecalibrateRequest) (*pb.RecalibrateResponse, error) {
+	if req.FromId == "" || req.ToId == "" {
+		return nil, fmt.Errorf("from_id and to_id required")
+	}
+	if req.ObservedAvg < 0 {
+		return nil, fmt.Errorf("observed_avg must not be negative")
+	}
+	var obs *repo.TravelObservation
+	if req.ObservedAvg > 0 {
+		obs = &repo.TravelObservation{
+			From:        req.FromId,
+			To:          req.ToId,
+			At:          timeOrNow(req.ObservedAt),
+			Observed:    req.ObservedAvg,
+			VehicleUUID: req.VehicleUuid,
+		}
+	}
+	c, err := s.repo.RecalibrateNext(ctx, req.FromId, req.ToId, obs, repo.CalibrationOptions{
+		Estimator:  req.Estimator,
+		Window:     time.Duration(req.WindowDays) * 24 * time.Hour,
+		MinSamples: int(req.MinSamples),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.RecalibrateResponse{
+		FromId:        c.From,
+		ToId:          c.To,
+		Estimator:     c.Estimator,
+		OldTravelTime: c.OldTravelTime,
+		NewTravelTime: c.NewTravelTime,
+		Updated:       c.Updated,
+		Samples:       int32(c.Overall.Samples),
+		Confidence:    c.Overall.Confidence,
+		Overall:       travelTimeEstimateToProto(c.Overall),
+	}
+	for _, b := range c.Bands {
+		out.Bands = append(out.Bands, travelTimeEstimateToProto(b))
+	}
+	return out, nil
+}
+
+func travelTimeEstimateToProto(e repo.BandEstimate) *pb.TravelTimeEstimate {
+	return &pb.TravelTimeEstimate{
+		Band:       e.Band,
+		Samples:    int32(e.Samples),
+		Median:     e.Median,
+		P15:        e.P15,
+		P85:        e.P85,
+		Ewma:       e.EWMA,
+		Estimate:   e.Estimate,
+		Confidence: e.Confidence,
+	}
+}
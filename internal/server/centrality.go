@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) StopCentrality(ctx context.Context, req *pb.StopCentralityRequest) (*pb.StopCentralityResponse, error) {
+	metric := req.Metric
+	if metric == "" {
+		metric = repo.MetricBetweenness
+	}
+	res, err := s.repo.StopCentrality(ctx, metric, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.StopCentralityResponse{Metric: metric}
+	for _, c := range res {
+		out.Stops = append(out.Stops, &pb.StopCentralityItem{
+			StopId:      c.StopID,
+			StopName:    c.Name,
+			Degree:      int32(c.Degree),
+			Lines:       int32(c.Lines),
+			Betweenness: c.Betweenness,
+			Closeness:   c.Closeness,
+			Pagerank:    c.PageRank,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) ConnectivityReport(ctx context.Context, req *pb.ConnectivityRequest) (*pb.ConnectivityResponse, error) {
+	res, err := s.repo.ConnectivityReport(ctx, req.RadiusM, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ConnectivityResponse{
+		StronglyConnected: len(res.Strong) <= 1,
+		Strong:            componentsToProto(res.Strong),
+		Weak:              componentsToProto(res.Weak),
+		IsolatedStopIds:   res.Isolated,
+	}
+	for _, c := range res.Candidates {
+		out.Candidates = append(out.Candidates, &pb.LinkCandidate{
+			FromId:        c.From,
+			ToId:          c.To,
+			FromComponent: int32(c.FromComponent),
+			ToComponent:   int32(c.ToComponent),
+			DistanceM:     c.Distance,
+			JoinsWeak:     c.JoinsWeak,
+		})
+	}
+	return out, nil
+}
+
+func componentsToProto(comps [][]string) []*pb.StopComponent {
+	out := make([]*pb.StopComponent, 0, len(comps))
+	for i, c := range comps {
+		out = append(out, &pb.StopComponent{Id: int32(i), Size: int32(len(c)), StopIds: c})
+	}
+	return out
+}
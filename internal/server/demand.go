@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) LoadDemand(ctx context.Context, req *pb.LoadDemandRequest) (*pb.LoadDemandResponse, error) {
+	rows, errs, err := demandFromCSV(req.Csv)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.LoadDemandResponse{Errors: errs}
+	if len(rows) == 0 && !req.Replace {
+		return out, nil
+	}
+	loaded, unknown, err := s.repo.LoadDemand(ctx, rows, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+	out.Loaded = int32(loaded)
+	for _, d := range rows {
+		out.TripsPerHour += d.TripsPerHour
+	}
+	for _, d := range unknown {
+		out.TripsPerHour -= d.TripsPerHour
+		out.Errors = append(out.Errors, fmt.Sprintf("%s -> %s: unknown stop", d.From, d.To))
+	}
+	return out, nil
+}
+
+func (s *Server) AssignDemand(ctx context.Context, req *pb.AssignDemandRequest) (*pb.AssignDemandResponse, error) {
+	a, err := s.repo.AssignDemand(ctx, timeOrNow(req.At))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.AssignDemandResponse{
+		At:              a.At.Unix(),
+		Pairs:           int32(a.Pairs),
+		UnassignedPairs: int32(a.UnassignedPairs),
+		AssignedTrips:   a.AssignedTrips,
+		UnassignedTrips: a.UnassignedTrips,
+	}
+	for _, e := range a.Edges {
+		over := repo.Overloaded(e.Load, e.Capacity)
+		if over {
+			out.OverloadedEdges++
+		}
+		if req.OverloadedOnly && !over {
+			continue
+		}
+		if req.Limit > 0 && len(out.Edges) >= int(req.Limit) {
+			continue
+		}
+		out.Edges = append(out.Edges, &pb.EdgeLoad{
+			FromId:      e.From,
+			ToId:        e.To,
+			LineIds:     e.Lines,
+			Load:        e.Load,
+			Capacity:    e.Capacity,
+			Utilization: repo.Utilization(e.Load, e.Capacity),
+			Overloaded:  over,
+		})
+	}
+	for _, l := range a.Lines {
+		out.Lines = append(out.Lines, &pb.LineLoad{
+			LineId:          l.LineID,
+			FrequencyMins:   l.FrequencyMins,
+			VehicleCapacity: l.VehicleCapacity,
+			Capacity:        l.Capacity,
+			PeakLoad:        l.PeakLoad,
+			PeakFromId:      l.PeakFrom,
+			PeakToId:        l.PeakTo,
+			Utilization:     repo.Utilization(l.PeakLoad, l.Capacity),
+			Overloaded:      l.PeakLoad > 0 && repo.Overloaded(l.PeakLoad, l.Capacity),
+			PassengerHours:  l.PassengerHours,
+		})
+	}
+	return out, nil
+}
+
+/*
+demandFromCSV parses origin,destination,trips_per_hour rows; bad rows are reported, not fatal.
+A pair given twice keeps its last row, as storing it would, and the earlier one is reported.
+*/
+func demandFromCSV(data string) ([]repo.Demand, []string, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	var rows []repo.Demand
+	var errs []string
+	type seenRow struct{ index, line int }
+	seen := map[[2]string]seenRow{}
+	for line := 1; ; line++ {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid demand csv: %w", err)
+		}
+		if len(rec) == 1 && strings.TrimSpace(rec[0]) == "" {
+			continue
+		}
+		if len(rec) < 3 {
+			errs = append(errs, fmt.Sprintf("line %d: expected origin,destination,trips_per_hour", line))
+			continue
+		}
+		from, to := strings.TrimSpace(rec[0]), strings.TrimSpace(rec[1])
+		trips, err := strconv.ParseFloat(strings.TrimSpace(rec[2]), 64)
+		if err != nil {
+			if line == 1 {
+				continue // header
+			}
+			errs = append(errs, fmt.Sprintf("line %d: invalid trips_per_hour %q", line, rec[2]))
+			continue
+		}
+		if from == "" || to == "" || trips < 0 {
+			errs = append(errs, fmt.Sprintf("line %d: origin, destination and non-negative trips required", line))
+			continue
+		}
+		d := repo.Demand{From: from, To: to, TripsPerHour: trips}
+		if prev, ok := seen[[2]string{from, to}]; ok {
+			errs = append(errs, fmt.Sprintf("line %d: %s -> %s repeated on line %d, the later row is kept", prev.line, from, to, line))
+			rows[prev.index] = d
+			seen[[2]string{from, to}] = seenRow{prev.index, line}
+			continue
+		}
+		seen[[2]string{from, to}] = seenRow{len(rows), line}
+		rows = append(rows, d)
+	}
+	return rows, errs, nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+	helper "route-graph-service/util"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultBoardLimit    = 3
+	defaultBoardInterval = 10 * time.Second
+	minBoardInterval     = 2 * time.Second
+)
+
+func (s *Server) DepartureBoard(ctx context.Context, req *pb.DepartureBoardRequest) (*pb.DepartureBoardResponse, error) {
+	if req == nil || req.StopId == "" {
+		return nil, fmt.Errorf("stop_id required")
+	}
+	return s.departureBoard(ctx, req, timeOrNow(req.At))
+}
+
+// StreamDepartureBoard pushes a fresh board every interval_secs until the client goes away.
+func (s *Server) StreamDepartureBoard(req *pb.DepartureBoardRequest, stream grpc.ServerStreamingServer[pb.DepartureBoardResponse]) error {
+	if req == nil || req.StopId == "" {
+		return fmt.Errorf("stop_id required")
+	}
+	interval := defaultBoardInterval
+	if req.IntervalSecs > 0 {
+		interval = time.Duration(req.IntervalSecs) * time.Second
+	}
+	if interval < minBoardInterval {
+		interval = minBoardInterval
+	}
+	ctx := stream.Context()
+	// a fixed "at" replays the board from that moment on
+	shift := time.Duration(0)
+	if req.At > 0 {
+		shift = time.Until(time.Unix(req.At, 0))
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		board, err := s.departureBoard(ctx, req, time.Now().Add(shift))
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(board); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) departureBoard(ctx context.Context, req *pb.DepartureBoardRequest, now time.Time) (*pb.DepartureBoardResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultBoardLimit
+	}
+	board, err := s.repo.DepartureBoard(ctx, req.StopId, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.DepartureBoardResponse{StopId: board.StopID, StopName: board.StopName, GeneratedAt: now.Unix()}
+	for _, d := range board.Departures {
+		out.Departures = append(out.Departures, departureToProto(d, now))
+	}
+	return out, nil
+}
+
+func departureToProto(d repo.Departure, now time.Time) *pb.Departure {
+	out := &pb.Departure{
+		LineId:      d.LineID,
+		LineName:    d.LineName,
+		Direction:   d.Direction,
+		Pattern:     d.Pattern,
+		Destination: d.Destination,
+		ExpectedAt:  d.ExpectedAt.Unix(),
+		Minutes:     int32(d.ExpectedAt.Sub(now) / time.Minute),
+		Realtime:    d.Realtime,
+		VehicleId:   d.VehicleID,
+	}
+	if !d.ScheduledAt.IsZero() {
+		out.Scheduled = helper.ClockString(d.ScheduledAt.Hour()*60 + d.ScheduledAt.Minute())
+	}
+	return out
+}
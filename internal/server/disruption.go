@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) CreateDisruption(ctx context.Context, in *pb.Disruption) (*pb.Disruption, error) {
+	if in == nil {
+		return nil, fmt.Errorf("disruption required")
+	}
+	now := time.Now().UnixMilli()
+	d := repo.Disruption{
+		ID:      in.Id,
+		Kind:    in.Kind,
+		StopID:  in.StopId,
+		FromID:  in.FromId,
+		ToID:    in.ToId,
+		Reason:  in.Reason,
+		StartTs: in.StartTs,
+		EndTs:   in.EndTs,
+	}
+	if d.StartTs == 0 {
+		d.StartTs = now
+	}
+	created, err := s.repo.CreateDisruption(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	// a planned disruption reports the detours it will cause once it starts
+	detours, err := s.repo.Detours(ctx, max(created.StartTs, now))
+	if err != nil {
+		return nil, err
+	}
+	return disruptionToProto(*created, now, detours), nil
+}
+
+func (s *Server) ListDisruptions(ctx context.Context, req *pb.ListDisruptionsRequest) (*pb.ListDisruptionsResponse, error) {
+	at := req.AtTs
+	if at == 0 {
+		at = time.Now().UnixMilli()
+	}
+	list, err := s.repo.ListDisruptions(ctx, at, req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	detours, err := s.repo.Detours(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ListDisruptionsResponse{}
+	for _, d := range list {
+		out.Disruptions = append(out.Disruptions, disruptionToProto(d, at, detours))
+	}
+	return out, nil
+}
+
+func (s *Server) EndDisruption(ctx context.Context, in *pb.ID) (*pb.Disruption, error) {
+	now := time.Now().UnixMilli()
+	if err := s.repo.EndDisruption(ctx, in.Id, now); err != nil {
+		return nil, err
+	}
+	d, err := s.repo.GetDisruption(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return disruptionToProto(*d, now, nil), nil
+}
+
+// disruptionToProto attaches the detours caused by d when it is active at ts.
+func disruptionToProto(d repo.Disruption, ts int64, detours []repo.Detour) *pb.Disruption {
+	out := &pb.Disruption{
+		Id:      d.ID,
+		Kind:    d.Kind,
+		StopId:  d.StopID,
+		FromId:  d.FromID,
+		ToId:    d.ToID,
+		Reason:  d.Reason,
+		StartTs: d.StartTs,
+		EndTs:   d.EndTs,
+		Active:  d.ActiveAt(ts),
+	}
+	for _, det := range detours {
+		if !slices.Contains(det.DisruptionIDs, d.ID) {
+			continue
+		}
+		pd := &pb.Detour{
+			LineId:       det.LineID,
+			Direction:    det.Direction,
+			Pattern:      det.Pattern,
+			FromId:       det.From,
+			ToId:         det.To,
+			SkippedStops: det.SkippedStops,
+			Path:         det.Path,
+			OriginalTime: det.OriginalTime,
+			Found:        det.Found,
+		}
+		if det.Found {
+			pd.DetourTime = det.DetourTime
+			pd.AddedTime = det.DetourTime - det.OriginalTime
+		}
+		out.Detours = append(out.Detours, pd)
+	}
+	return out
+}
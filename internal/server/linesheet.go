@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// GenerateLineSheet prints the route sheet of one line, or of every active line with one line per page.
+func (s *Server) GenerateLineSheet(ctx context.Context, req *pb.LineSheetRequest) (*pb.GenerateReportResponse, error) {
+	if req.LineId == "" && !req.AllActive {
+		return nil, fmt.Errorf("line_id or all_active required")
+	}
+	l, err := reportLocaleFor(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	if req.Store && s.reportDir == "" {
+		return nil, fmt.Errorf("report storage is not configured")
+	}
+	ids := []string{req.LineId}
+	if req.AllActive {
+		ids, err = s.repo.ActiveLineIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("no active lines")
+		}
+	}
+	var sheets []*repo.LineSheet
+	for _, id := range ids {
+		sheet, err := s.repo.LineSheet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		sheets = append(sheets, sheet)
+	}
+	holidays, err := s.repo.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := renderLineSheets(l, sheets, holidays, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.GenerateReportResponse{
+		Created:     true,
+		Content:     content,
+		Format:      ReportPDF,
+		ContentType: "application/pdf",
+		Size:        int64(len(content)),
+	}
+	if req.Store {
+		out.Filename, err = storeReport(s.reportDir, ".pdf", content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func renderLineSheets(l *reportLocale, sheets []*repo.LineSheet, holidays map[string]bool, at time.Time) ([]byte, error) {
+	pdf, err := newReportPDF()
+	if err != nil {
+		return nil, err
+	}
+	pdf.SetTitle(l.T("sheet.doc_title"), true)
+	for _, sheet := range sheets {
+		addLineSheetPage(pdf, l, sheet, holidays, at)
+	}
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+var (
+	sheetStopCols    = []float64{10, 24, 70, 16, 24, 22, 24}
+	sheetPeriodCols  = []float64{40, 30, 30, 30}
+	sheetVehicleCols = []float64{40, 60, 30, 40}
+)
+
+// addLineSheetPage prints the headway at the time of printing and, when the line has them, its service periods.
+func addLineSheetPage(pdf *gofpdf.Fpdf, l *reportLocale, sheet *repo.LineSheet, holidays map[string]bool, at time.Time) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 9, l.T("sheet.title", sheet.LineID, sheet.Name))
+	pdf.Ln(9)
+	interval := l.T("fleet.no_service")
+	if freq, _ := sheet.Service.FrequencyAt(at, holidays); freq > 0 {
+		interval = l.T("fleet.interval", l.Int(int(freq)))
+	}
+	pdf.SetFont(reportFont, "", 11)
+	pdf.Cell(0, 6, l.T("sheet.info", dashIfEmpty(sheet.Mode), interval, l.Bool(sheet.Active)))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, l.T("report.generated", l.Time(at)))
+	pdf.Ln(10)
+
+	if len(sheet.Service.Periods) > 0 {
+		pdf.SetFont(reportFont, "B", 12)
+		pdf.Cell(0, 7, l.T("sheet.periods"))
+		pdf.Ln(8)
+		sheetRow(pdf, sheetPeriodCols, true, 0, l.T("sheet.col.day"), l.T("sheet.col.from"), l.T("sheet.col.to"), l.T("sheet.col.headway"))
+		for _, p := range sheet.Service.Periods {
+			ensureSpace(pdf, 6)
+			sheetRow(pdf, sheetPeriodCols, false, 1, p.DayType, p.StartTime, p.EndTime, l.Int(int(p.FrequencyMins)))
+		}
+		pdf.Ln(6)
+	}
+
+	if len(sheet.Routes) == 0 {
+		pdf.Cell(0, 6, l.T("sheet.no_stops"))
+		pdf.Ln(10)
+	}
+	gaps := false
+	for _, rt := range sheet.Routes {
+		ensureSpace(pdf, 30)
+		pdf.SetFont(reportFont, "B", 12)
+		pdf.Cell(0, 7, l.T("sheet.route", rt.Direction, rt.Pattern))
+		pdf.Ln(8)
+		sheetRow(pdf, sheetStopCols, true, 0, "#", l.T("sheet.col.stop"), l.T("sheet.col.name"), l.T("sheet.col.zone"),
+			l.T("sheet.col.shelter"), l.T("sheet.col.time"), l.T("sheet.col.distance"))
+		for _, st := range rt.Stops {
+			ensureSpace(pdf, 6)
+			order := l.Int(st.Order)
+			if st.Gap {
+				order, gaps = order+"*", true
+			}
+			sheetRow(pdf, sheetStopCols, false, 2, order, st.StopID, st.Name, dashIfEmpty(st.Zone), l.Bool(st.Shelter),
+				l.Num(float64(st.TravelTime)/60, 1), l.Num(float64(st.Distance)/1000, 2))
+		}
+		pdf.Ln(6)
+	}
+	if gaps {
+		pdf.SetFont(reportFont, "I", 9)
+		pdf.Cell(0, 5, l.T("sheet.gap"))
+		pdf.Ln(8)
+	}
+
+	ensureSpace(pdf, 20)
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 7, l.T("sheet.vehicles"))
+	pdf.Ln(8)
+	if len(sheet.Vehicles) == 0 {
+		pdf.SetFont(reportFont, "", 10)
+		pdf.Cell(0, 6, l.T("sheet.no_vehicles"))
+		pdf.Ln(6)
+		return
+	}
+	sheetRow(pdf, sheetVehicleCols, true, 0, l.T("sheet.col.vehicle"), "UUID", l.T("sheet.col.capacity"), l.T("sheet.col.status"))
+	for _, v := range sheet.Vehicles {
+		ensureSpace(pdf, 6)
+		sheetRow(pdf, sheetVehicleCols, false, 0, dashIfEmpty(v.ID), v.VehicleUUID, l.Int(int(v.Capacity)), dashIfEmpty(v.Status))
+	}
+}
+
+// sheetRow prints one bordered table row; the last right cells are right aligned numbers.
+func sheetRow(pdf *gofpdf.Fpdf, widths []float64, header bool, right int, cells ...string) {
+	style := ""
+	if header {
+		style = "B"
+		pdf.SetFillColor(225, 225, 225)
+	}
+	pdf.SetFont(reportFont, style, 9)
+	for i, c := range cells {
+		align := "L"
+		if i >= len(cells)-right {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], 6, c, "1", 0, align, header, 0, "")
+	}
+	pdf.Ln(6)
+}
@@ -0,0 +1,392 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+const (
+	MapSVG = "SVG"
+	MapPNG = "PNG"
+
+	defaultMapSize = 1000
+	maxMapSize     = 4096
+)
+
+type mapColor struct{ R, G, B uint8 }
+
+var (
+	mapBlack = mapColor{0, 0, 0}
+	mapWhite = mapColor{255, 255, 255}
+	mapGrey  = mapColor{170, 170, 170}
+	mapPath  = mapColor{255, 200, 0}
+
+	// lineColors are picked by sorted line id so a line keeps its colour between renders.
+	lineColors = []mapColor{
+		{230, 25, 75}, {60, 180, 75}, {0, 130, 200}, {245, 130, 48}, {145, 30, 180}, {0, 128, 128},
+		{240, 50, 230}, {128, 128, 0}, {170, 110, 40}, {128, 0, 0}, {0, 0, 128}, {90, 90, 90},
+	}
+	zoneColors = []mapColor{
+		{255, 210, 210}, {210, 245, 210}, {210, 225, 255}, {255, 240, 200}, {235, 215, 255}, {205, 240, 240},
+	}
+)
+
+type mapPoint struct{ X, Y float64 }
+
+/*
+mapCanvas is the drawing surface the network map is rendered onto: a PDF page, an SVG document
+or a PNG image. Coordinates are in the canvas' own units; Text is placed by its top-left corner.
+*/
+type mapCanvas interface {
+	Line(a, b mapPoint, width float64, c mapColor, opacity float64)
+	Polygon(pts []mapPoint, c mapColor, opacity float64)
+	Circle(center mapPoint, r float64, c mapColor)
+	Rect(x, y, w, h float64, c mapColor)
+	Text(x, y, size float64, s string, c mapColor)
+	TextWidth(size float64, s string) float64
+}
+
+// mapOptions selects what the network map shows.
+type mapOptions struct {
+	// Path is highlighted when set
+	Path []string
+	// Lines are coloured, empty = all; other lines are drawn as plain NEXT edges
+	Lines  []string
+	Zones  bool
+	Labels bool
+}
+
+type networkMap struct {
+	Network *repo.Network
+	Depots  []repo.DepotLocation
+	mapOptions
+}
+
+func (s *Server) loadNetworkMap(ctx context.Context, opts mapOptions) (*networkMap, error) {
+	n, err := s.repo.LoadNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	depots, err := s.repo.DepotLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &networkMap{Network: n, Depots: depots, mapOptions: opts}, nil
+}
+
+// located reports whether a stop or depot has coordinates; missing ones are read as 0, 0.
+func located(lat, lon float64) bool { return lat != 0 || lon != 0 }
+
+// mapProjection maps lat/lon onto a frame, equirectangular around the mean latitude, keeping the aspect ratio.
+type mapProjection struct {
+	minLat, maxLat, minLon, kx, scale, offX, offY float64
+}
+
+func newMapProjection(m *networkMap, x, y, w, h float64) mapProjection {
+	p := mapProjection{minLat: math.Inf(1), maxLat: math.Inf(-1), minLon: math.Inf(1)}
+	maxLon := math.Inf(-1)
+	add := func(lat, lon float64) {
+		if !located(lat, lon) {
+			return
+		}
+		p.minLat, p.maxLat = math.Min(p.minLat, lat), math.Max(p.maxLat, lat)
+		p.minLon, maxLon = math.Min(p.minLon, lon), math.Max(maxLon, lon)
+	}
+	for _, st := range m.Network.Stops {
+		add(st.Lat, st.Lon)
+	}
+	for _, d := range m.Depots {
+		add(d.Lat, d.Lon)
+	}
+	if math.IsInf(p.minLat, 1) {
+		p.minLat, p.maxLat, p.minLon, maxLon = 0, 0, 0, 0
+	}
+	p.kx = math.Cos((p.minLat + p.maxLat) / 2 * math.Pi / 180)
+	spanX, spanY := (maxLon-p.minLon)*p.kx, p.maxLat-p.minLat
+	p.scale = 1
+	switch {
+	case spanX > 0 && spanY > 0:
+		p.scale = math.Min(w/spanX, h/spanY)
+	case spanX > 0:
+		p.scale = w / spanX
+	case spanY > 0:
+		p.scale = h / spanY
+	}
+	p.offX = x + (w-spanX*p.scale)/2
+	p.offY = y + (h-spanY*p.scale)/2
+	return p
+}
+
+func (p mapProjection) at(lat, lon float64) mapPoint {
+	return mapPoint{p.offX + (lon-p.minLon)*p.kx*p.scale, p.offY + (p.maxLat-lat)*p.scale}
+}
+
+// segmentLines lists, per undirected stop pair, the sorted lines whose routes use it.
+func (m *networkMap) segmentLines() map[[2]string][]string {
+	seg := map[[2]string][]string{}
+	for _, rt := range m.Network.Routes {
+		if len(m.Lines) > 0 && !slices.Contains(m.Lines, rt.LineID) {
+			continue
+		}
+		for i := 1; i < len(rt.Stops); i++ {
+			k := [2]string{rt.Stops[i-1], rt.Stops[i]}
+			if k[0] > k[1] {
+				k[0], k[1] = k[1], k[0]
+			}
+			if !slices.Contains(seg[k], rt.LineID) {
+				seg[k] = append(seg[k], rt.LineID)
+			}
+		}
+	}
+	for _, lines := range seg {
+		sort.Strings(lines)
+	}
+	return seg
+}
+
+func (m *networkMap) lineColor(id string) mapColor {
+	ids := make([]string, 0, len(m.Network.Lines))
+	for lid := range m.Network.Lines {
+		ids = append(ids, lid)
+	}
+	sort.Strings(ids)
+	i, _ := slices.BinarySearch(ids, id)
+	return lineColors[i%len(lineColors)]
+}
+
+/*
+draw renders the map into the frame: shaded zones, NEXT edges, line routes offset side by side
+where lines share a segment, the highlighted path, stops, depots and a legend along the bottom.
+Sizes are relative to the frame so the same drawing works in millimetres and in pixels.
+*/
+func (m *networkMap) draw(c mapCanvas, l *reportLocale, x, y, w, h float64) {
+	u := math.Min(w, h) / 200
+	legendH := 12 * u
+	proj := newMapProjection(m, x+8*u, y+8*u, w-16*u, h-16*u-legendH)
+	pos := map[string]mapPoint{}
+	for id, st := range m.Network.Stops {
+		if located(st.Lat, st.Lon) {
+			pos[id] = proj.at(st.Lat, st.Lon)
+		}
+	}
+
+	if m.Zones {
+		byZone := map[string][]mapPoint{}
+		for id, st := range m.Network.Stops {
+			if p, ok := pos[id]; ok && st.Zone != "" {
+				for k := 0; k < 8; k++ {
+					a := float64(k) * math.Pi / 4
+					byZone[st.Zone] = append(byZone[st.Zone], mapPoint{p.X + 6*u*math.Cos(a), p.Y + 6*u*math.Sin(a)})
+				}
+			}
+		}
+		for i, zone := range sortedKeys(byZone) {
+			c.Polygon(convexHull(byZone[zone]), zoneColors[i%len(zoneColors)], 0.6)
+		}
+	}
+
+	seg := m.segmentLines()
+	for _, e := range m.Network.SortedEdges() {
+		a, okA := pos[e.From]
+		b, okB := pos[e.To]
+		k := [2]string{min(e.From, e.To), max(e.From, e.To)}
+		if okA && okB && len(seg[k]) == 0 {
+			c.Line(a, b, 0.4*u, mapGrey, 1)
+		}
+	}
+
+	if len(m.Path) > 1 {
+		for i := 1; i < len(m.Path); i++ {
+			a, okA := pos[m.Path[i-1]]
+			b, okB := pos[m.Path[i]]
+			if okA && okB {
+				c.Line(a, b, 5*u, mapPath, 0.7)
+			}
+		}
+	}
+
+	lineW := 1.2 * u
+	for _, k := range sortedSegments(seg) {
+		a, okA := pos[k[0]]
+		b, okB := pos[k[1]]
+		if !okA || !okB {
+			continue
+		}
+		dx, dy := b.X-a.X, b.Y-a.Y
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		nx, ny := -dy/length, dx/length
+		lines := seg[k]
+		for i, id := range lines {
+			off := (float64(i) - float64(len(lines)-1)/2) * lineW
+			c.Line(mapPoint{a.X + nx*off, a.Y + ny*off}, mapPoint{b.X + nx*off, b.Y + ny*off}, lineW, m.lineColor(id), 1)
+		}
+	}
+
+	onPath := map[string]bool{}
+	for _, id := range m.Path {
+		onPath[id] = true
+	}
+	for _, id := range m.Network.StopIDs() {
+		p, ok := pos[id]
+		if !ok {
+			continue
+		}
+		r := 1.6 * u
+		if onPath[id] {
+			r = 2.2 * u
+		}
+		c.Circle(p, r, mapBlack)
+		c.Circle(p, r-0.5*u, mapWhite)
+		if m.Labels {
+			c.Text(p.X+r+0.5*u, p.Y-r-2.5*u, 3*u, id, mapBlack)
+		}
+	}
+	for _, d := range m.Depots {
+		p := proj.at(d.Lat, d.Lon)
+		c.Rect(p.X-2*u, p.Y-2*u, 4*u, 4*u, mapBlack)
+		if m.Labels {
+			c.Text(p.X+2.5*u, p.Y-2*u, 3*u, d.ID, mapBlack)
+		}
+	}
+
+	// legend: one swatch per coloured line, then the depot and path symbols
+	lx, ly := x+8*u, y+h-legendH+2*u
+	entry := func(label string, swatch func(x, y float64)) {
+		tw := c.TextWidth(3*u, label)
+		if lx+8*u+tw > x+w-8*u {
+			lx, ly = x+8*u, ly+5*u
+		}
+		swatch(lx, ly)
+		c.Text(lx+6*u, ly, 3*u, label, mapBlack)
+		lx += 10*u + tw
+	}
+	for _, id := range m.legendLines(seg) {
+		col := m.lineColor(id)
+		entry(id, func(x, y float64) { c.Rect(x, y+0.75*u, 5*u, 1.5*u, col) })
+	}
+	if len(m.Depots) > 0 {
+		entry(l.T("map.depot"), func(x, y float64) { c.Rect(x+0.5*u, y, 3*u, 3*u, mapBlack) })
+	}
+	if len(m.Path) > 1 {
+		entry(l.T("map.path"), func(x, y float64) { c.Rect(x, y, 5*u, 3*u, mapPath) })
+	}
+}
+
+func (m *networkMap) legendLines(seg map[[2]string][]string) []string {
+	var ids []string
+	for _, lines := range seg {
+		for _, id := range lines {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func sortedSegments(seg map[[2]string][]string) [][2]string {
+	keys := make([][2]string, 0, len(seg))
+	for k := range seg {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	return keys
+}
+
+// convexHull returns the hull of pts in order (Andrew's monotone chain).
+func convexHull(pts []mapPoint) []mapPoint {
+	pts = slices.Clone(pts)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].X != pts[j].X {
+			return pts[i].X < pts[j].X
+		}
+		return pts[i].Y < pts[j].Y
+	})
+	if len(pts) < 3 {
+		return pts
+	}
+	cross := func(o, a, b mapPoint) float64 { return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X) }
+	var hull []mapPoint
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range pts {
+			for len(hull) >= start+2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1]
+		slices.Reverse(pts)
+	}
+	return hull
+}
+
+// RenderNetworkMap draws the network as a standalone SVG or PNG image.
+func (s *Server) RenderNetworkMap(ctx context.Context, req *pb.NetworkMapRequest) (*pb.NetworkMapResponse, error) {
+	format := strings.ToUpper(req.Format)
+	if format == "" {
+		format = MapSVG
+	}
+	if format != MapSVG && format != MapPNG {
+		return nil, fmt.Errorf("invalid format %q, expected SVG or PNG", req.Format)
+	}
+	width, height := int(req.Width), int(req.Height)
+	if width == 0 {
+		width = defaultMapSize
+	}
+	if height == 0 {
+		height = defaultMapSize
+	}
+	if width < 100 || height < 100 || width > maxMapSize || height > maxMapSize {
+		return nil, fmt.Errorf("map size must be between 100 and %d pixels", maxMapSize)
+	}
+	loc, err := reportLocaleFor(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := mapOptions{Lines: req.Lines, Zones: req.ShadeZones, Labels: !req.HideLabels}
+	if req.StartId != "" && req.EndId != "" {
+		path, err := s.repo.ShortestPath(ctx, req.StartId, req.EndId, int(req.MaxHops), repo.PathFilter{})
+		if err != nil {
+			return nil, fmt.Errorf("no path found: %w", err)
+		}
+		opts.Path = path.Stops
+	}
+	m, err := s.loadNetworkMap(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load network: %w", err)
+	}
+
+	out := &pb.NetworkMapResponse{Format: format, Width: int32(width), Height: int32(height)}
+	if format == MapSVG {
+		c := newSVGCanvas(width, height)
+		m.draw(c, loc, 0, 0, float64(width), float64(height))
+		out.Content, out.ContentType = c.Bytes(), "image/svg+xml"
+		return out, nil
+	}
+	c := newPNGCanvas(width, height)
+	m.draw(c, loc, 0, 0, float64(width), float64(height))
+	out.Content, err = c.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	out.ContentType = "image/png"
+	return out, nil
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// pdfCanvas draws in millimetres onto the current PDF page.
+type pdfCanvas struct{ pdf *gofpdf.Fpdf }
+
+func (c pdfCanvas) Line(a, b mapPoint, width float64, col mapColor, opacity float64) {
+	c.pdf.SetAlpha(opacity, "Normal")
+	c.pdf.SetLineCapStyle("round")
+	c.pdf.SetLineWidth(width)
+	c.pdf.SetDrawColor(int(col.R), int(col.G), int(col.B))
+	c.pdf.Line(a.X, a.Y, b.X, b.Y)
+	c.pdf.SetLineCapStyle("butt")
+	c.pdf.SetAlpha(1, "Normal")
+}
+
+func (c pdfCanvas) Polygon(pts []mapPoint, col mapColor, opacity float64) {
+	if len(pts) < 3 {
+		return
+	}
+	points := make([]gofpdf.PointType, len(pts))
+	for i, p := range pts {
+		points[i] = gofpdf.PointType{X: p.X, Y: p.Y}
+	}
+	c.pdf.SetAlpha(opacity, "Normal")
+	c.pdf.SetFillColor(int(col.R), int(col.G), int(col.B))
+	c.pdf.Polygon(points, "F")
+	c.pdf.SetAlpha(1, "Normal")
+}
+
+func (c pdfCanvas) Circle(p mapPoint, r float64, col mapColor) {
+	c.pdf.SetFillColor(int(col.R), int(col.G), int(col.B))
+	c.pdf.Circle(p.X, p.Y, r, "F")
+}
+
+func (c pdfCanvas) Rect(x, y, w, h float64, col mapColor) {
+	c.pdf.SetFillColor(int(col.R), int(col.G), int(col.B))
+	c.pdf.Rect(x, y, w, h, "F")
+}
+
+func (c pdfCanvas) Text(x, y, size float64, s string, col mapColor) {
+	c.pdf.SetFont(reportFont, "", 0)
+	c.pdf.SetFontUnitSize(size)
+	c.pdf.SetTextColor(int(col.R), int(col.G), int(col.B))
+	c.pdf.Text(x, y+0.8*size, s)
+	c.pdf.SetTextColor(0, 0, 0)
+}
+
+func (c pdfCanvas) TextWidth(size float64, s string) float64 {
+	c.pdf.SetFont(reportFont, "", 0)
+	c.pdf.SetFontUnitSize(size)
+	return c.pdf.GetStringWidth(s)
+}
+
+// svgCanvas collects SVG elements in pixels.
+type svgCanvas struct {
+	buf bytes.Buffer
+}
+
+func newSVGCanvas(width, height int) *svgCanvas {
+	c := &svgCanvas{}
+	fmt.Fprintf(&c.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", width, height, width, height)
+	fmt.Fprintf(&c.buf, `<rect width="%d" height="%d" fill="#fff"/>`+"\n", width, height)
+	return c
+}
+
+func (col mapColor) hex() string { return fmt.Sprintf("#%02x%02x%02x", col.R, col.G, col.B) }
+
+func (c *svgCanvas) Line(a, b mapPoint, width float64, col mapColor, opacity float64) {
+	fmt.Fprintf(&c.buf, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="%.2f" stroke-linecap="round" stroke-opacity="%.2f"/>`+"\n",
+		a.X, a.Y, b.X, b.Y, col.hex(), width, opacity)
+}
+
+func (c *svgCanvas) Polygon(pts []mapPoint, col mapColor, opacity float64) {
+	if len(pts) < 3 {
+		return
+	}
+	coords := make([]string, len(pts))
+	for i, p := range pts {
+		coords[i] = fmt.Sprintf("%.1f,%.1f", p.X, p.Y)
+	}
+	fmt.Fprintf(&c.buf, `<polygon points="%s" fill="%s" fill-opacity="%.2f"/>`+"\n", strings.Join(coords, " "), col.hex(), opacity)
+}
+
+func (c *svgCanvas) Circle(p mapPoint, r float64, col mapColor) {
+	fmt.Fprintf(&c.buf, `<circle cx="%.1f" cy="%.1f" r="%.2f" fill="%s"/>`+"\n", p.X, p.Y, r, col.hex())
+}
+
+func (c *svgCanvas) Rect(x, y, w, h float64, col mapColor) {
+	fmt.Fprintf(&c.buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`+"\n", x, y, w, h, col.hex())
+}
+
+func (c *svgCanvas) Text(x, y, size float64, s string, col mapColor) {
+	fmt.Fprintf(&c.buf, `<text x="%.1f" y="%.1f" font-family="DejaVu Sans, sans-serif" font-size="%.1f" fill="%s">%s</text>`+"\n",
+		x, y+0.8*size, size, col.hex(), html.EscapeString(s))
+}
+
+func (c *svgCanvas) TextWidth(size float64, s string) float64 {
+	return 0.6 * size * float64(len([]rune(s)))
+}
+
+func (c *svgCanvas) Bytes() []byte {
+	return append(c.buf.Bytes(), "</svg>\n"...)
+}
+
+// pngCanvas rasterises onto an RGBA image; text uses a built-in 3x5 pixel font.
+type pngCanvas struct {
+	img *image.RGBA
+}
+
+func newPNGCanvas(width, height int) *pngCanvas {
+	c := &pngCanvas{img: image.NewRGBA(image.Rect(0, 0, width, height))}
+	c.Rect(0, 0, float64(width), float64(height), mapWhite)
+	return c
+}
+
+// blend mixes col into the pixel with the given opacity.
+func (c *pngCanvas) blend(x, y int, col mapColor, opacity float64) {
+	if !(image.Point{x, y}.In(c.img.Rect)) {
+		return
+	}
+	o := c.img.RGBAAt(x, y)
+	mix := func(a, b uint8) uint8 { return uint8(float64(a)*(1-opacity) + float64(b)*opacity + 0.5) }
+	c.img.SetRGBA(x, y, color.RGBA{mix(o.R, col.R), mix(o.G, col.G), mix(o.B, col.B), 255})
+}
+
+// Line covers every pixel within width/2 of the segment, each pixel blended once.
+func (c *pngCanvas) Line(a, b mapPoint, width float64, col mapColor, opacity float64) {
+	r := math.Max(width/2, 0.5)
+	x0, x1 := int(math.Floor(math.Min(a.X, b.X)-r)), int(math.Ceil(math.Max(a.X, b.X)+r))
+	y0, y1 := int(math.Floor(math.Min(a.Y, b.Y)-r)), int(math.Ceil(math.Max(a.Y, b.Y)+r))
+	dx, dy := b.X-a.X, b.Y-a.Y
+	l2 := dx*dx + dy*dy
+	for y := max(y0, 0); y <= min(y1, c.img.Rect.Max.Y-1); y++ {
+		for x := max(x0, 0); x <= min(x1, c.img.Rect.Max.X-1); x++ {
+			px, py := float64(x)+0.5, float64(y)+0.5
+			t := 0.0
+			if l2 > 0 {
+				t = math.Max(0, math.Min(1, ((px-a.X)*dx+(py-a.Y)*dy)/l2))
+			}
+			if math.Hypot(px-(a.X+t*dx), py-(a.Y+t*dy)) <= r {
+				c.blend(x, y, col, opacity)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) Polygon(pts []mapPoint, col mapColor, opacity float64) {
+	if len(pts) < 3 {
+		return
+	}
+	minY, maxY := pts[0].Y, pts[0].Y
+	for _, p := range pts {
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+	// even-odd scanline fill at pixel centres
+	for y := max(int(minY), 0); y <= min(int(maxY), c.img.Rect.Max.Y-1); y++ {
+		py := float64(y) + 0.5
+		var xs []float64
+		for i := range pts {
+			a, b := pts[i], pts[(i+1)%len(pts)]
+			if (a.Y <= py) != (b.Y <= py) {
+				xs = append(xs, a.X+(py-a.Y)/(b.Y-a.Y)*(b.X-a.X))
+			}
+		}
+		sort.Float64s(xs)
+		for i := 0; i+1 < len(xs); i += 2 {
+			for x := max(int(math.Ceil(xs[i]-0.5)), 0); float64(x)+0.5 <= xs[i+1] && x < c.img.Rect.Max.X; x++ {
+				c.blend(x, y, col, opacity)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) Circle(p mapPoint, r float64, col mapColor) {
+	for y := int(p.Y - r - 1); y <= int(p.Y+r+1); y++ {
+		for x := int(p.X - r - 1); x <= int(p.X+r+1); x++ {
+			if math.Hypot(float64(x)+0.5-p.X, float64(y)+0.5-p.Y) <= r {
+				c.blend(x, y, col, 1)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) Rect(x, y, w, h float64, col mapColor) {
+	for py := int(math.Round(y)); py < int(math.Round(y+h)); py++ {
+		for px := int(math.Round(x)); px < int(math.Round(x+w)); px++ {
+			c.blend(px, py, col, 1)
+		}
+	}
+}
+
+func (c *pngCanvas) Text(x, y, size float64, s string, col mapColor) {
+	scale := max(int(size/5), 1)
+	cx := int(x)
+	for _, r := range strings.ToUpper(foldDiacritics(s)) {
+		g := pixelFont[r]
+		for row, bits := range g {
+			for bit := 0; bit < 3; bit++ {
+				if bits&(4>>bit) == 0 {
+					continue
+				}
+				for dy := 0; dy < scale; dy++ {
+					for dx := 0; dx < scale; dx++ {
+						c.blend(cx+bit*scale+dx, int(y)+row*scale+dy, col, 1)
+					}
+				}
+			}
+		}
+		cx += 4 * scale
+	}
+}
+
+func (c *pngCanvas) TextWidth(size float64, s string) float64 {
+	return float64(4 * max(int(size/5), 1) * len([]rune(s)))
+}
+
+func (c *pngCanvas) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, c.img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// foldDiacritics maps Serbian Latin letters onto ASCII for the pixel font.
+func foldDiacritics(s string) string {
+	return strings.NewReplacer("č", "c", "ć", "c", "đ", "dj", "š", "s", "ž", "z",
+		"Č", "C", "Ć", "C", "Đ", "Dj", "Š", "S", "Ž", "Z").Replace(s)
+}
+
+// pixelFont is a 3x5 font; each row is three bits, the highest bit being the left column.
+var pixelFont = map[rune][5]uint8{
+	'0': {7, 5, 5, 5, 7}, '1': {2, 6, 2, 2, 7}, '2': {7, 1, 7, 4, 7}, '3': {7, 1, 3, 1, 7}, '4': {5, 5, 7, 1, 1},
+	'5': {7, 4, 7, 1, 7}, '6': {7, 4, 7, 5, 7}, '7': {7, 1, 1, 2, 2}, '8': {7, 5, 7, 5, 7}, '9': {7, 5, 7, 1, 7},
+	'A': {2, 5, 7, 5, 5}, 'B': {6, 5, 6, 5, 6}, 'C': {3, 4, 4, 4, 3}, 'D': {6, 5, 5, 5, 6}, 'E': {7, 4, 6, 4, 7},
+	'F': {7, 4, 6, 4, 4}, 'G': {3, 4, 5, 5, 3}, 'H': {5, 5, 7, 5, 5}, 'I': {7, 2, 2, 2, 7}, 'J': {1, 1, 1, 5, 2},
+	'K': {5, 5, 6, 5, 5}, 'L': {4, 4, 4, 4, 7}, 'M': {5, 7, 7, 5, 5}, 'N': {6, 5, 5, 5, 5}, 'O': {2, 5, 5, 5, 2},
+	'P': {6, 5, 6, 4, 4}, 'Q': {2, 5, 5, 6, 3}, 'R': {6, 5, 6, 5, 5}, 'S': {3, 4, 2, 1, 6}, 'T': {7, 2, 2, 2, 2},
+	'U': {5, 5, 5, 5, 7}, 'V': {5, 5, 5, 5, 2}, 'W': {5, 5, 7, 7, 5}, 'X': {5, 5, 2, 5, 5}, 'Y': {5, 5, 2, 2, 2},
+	'Z': {7, 1, 2, 4, 7}, '-': {0, 0, 7, 0, 0}, '_': {0, 0, 0, 0, 7}, '.': {0, 0, 0, 0, 2}, ':': {0, 2, 0, 2, 0},
+	'(': {1, 2, 2, 2, 1}, ')': {4, 2, 2, 2, 4}, '/': {1, 1, 2, 4, 4},
+}
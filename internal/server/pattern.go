@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+	helper "route-graph-service/util"
+)
+
+func (s *Server) UpsertPattern(ctx context.Context, in *pb.RoutePattern) (*pb.RoutePattern, error) {
+	if in == nil || in.LineId == "" || in.Name == "" {
+		return nil, fmt.Errorf("line_id and name required")
+	}
+	for _, t := range []string{in.StartTime, in.EndTime} {
+		if t == "" {
+			continue
+		}
+		if _, err := helper.ClockMinutes(t); err != nil {
+			return nil, err
+		}
+	}
+	if (in.StartTime == "") != (in.EndTime == "") {
+		return nil, fmt.Errorf("start_time and end_time must be set together")
+	}
+	if in.Direction == "" {
+		in.Direction = repo.DirectionOutbound
+	}
+	props := map[string]any{"start_time": in.StartTime, "end_time": in.EndTime, "description": in.Description}
+	if err := s.repo.UpsertPattern(ctx, in.LineId, in.Name, in.Direction, props); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func (s *Server) ListPatterns(ctx context.Context, in *pb.ListPatternsRequest) (*pb.ListPatternsResponse, error) {
+	if in == nil || in.LineId == "" {
+		return nil, fmt.Errorf("line_id required")
+	}
+	at := -1
+	if in.ActiveAt != "" {
+		m, err := helper.ClockMinutes(in.ActiveAt)
+		if err != nil {
+			return nil, err
+		}
+		at = m
+	}
+	rows, err := s.repo.ListPatterns(ctx, in.LineId)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ListPatternsResponse{}
+	for _, r := range rows {
+		p := &pb.RoutePattern{
+			LineId:      in.LineId,
+			Name:        helper.AnyToString(r["name"]),
+			Direction:   helper.AnyToString(r["direction"]),
+			StartTime:   helper.AnyToString(r["start_time"]),
+			EndTime:     helper.AnyToString(r["end_time"]),
+			Description: helper.AnyToString(r["description"]),
+			Stops:       helper.AnyToInt32(r["stops"]),
+		}
+		if at >= 0 && !repo.PatternActiveAt(p.StartTime, p.EndTime, at) {
+			continue
+		}
+		out.Patterns = append(out.Patterns, p)
+	}
+	return out, nil
+}
+
+func (s *Server) DeletePattern(ctx context.Context, in *pb.RoutePattern) (*pb.Empty, error) {
+	if in == nil || in.LineId == "" || in.Name == "" {
+		return nil, fmt.Errorf("line_id and name required")
+	}
+	if err := s.repo.DeletePattern(ctx, in.LineId, in.Name, in.Direction); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
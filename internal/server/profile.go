@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) GetEdgeProfile(ctx context.Context, in *pb.NextEdge) (*pb.EdgeProfile, error) {
+	e, err := s.repo.GetEdgeProfile(ctx, in.FromId, in.ToId)
+	if err != nil {
+		return nil, err
+	}
+	return edgeProfileToProto(*e), nil
+}
+
+func (s *Server) SetEdgeProfile(ctx context.Context, in *pb.EdgeProfile) (*pb.EdgeProfile, error) {
+	if in == nil || in.FromId == "" || in.ToId == "" {
+		return nil, fmt.Errorf("from_id and to_id required")
+	}
+	profile := repo.EdgeProfile{}
+	for _, e := range in.Entries {
+		if !repo.ValidProfileKey(e.Day, e.Band) {
+			return nil, fmt.Errorf("invalid day %q or band %q", e.Day, e.Band)
+		}
+		profile[repo.ProfileKey(e.Day, e.Band)] = e.TravelTime
+	}
+	if err := s.repo.SetEdgeProfile(ctx, in.FromId, in.ToId, profile, in.Replace); err != nil {
+		return nil, err
+	}
+	return s.GetEdgeProfile(ctx, &pb.NextEdge{FromId: in.FromId, ToId: in.ToId})
+}
+
+func (s *Server) BuildEdgeProfiles(ctx context.Context, req *pb.BuildEdgeProfilesRequest) (*pb.BuildEdgeProfilesResponse, error) {
+	edges, err := s.repo.BuildEdgeProfiles(ctx, req.FromId, req.ToId, repo.CalibrationOptions{
+		Estimator:  req.Estimator,
+		Window:     time.Duration(req.WindowDays) * 24 * time.Hour,
+		MinSamples: int(req.MinSamples),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.BuildEdgeProfilesResponse{}
+	for _, e := range edges {
+		if len(e.Profile) == 0 {
+			continue
+		}
+		out.Edges++
+		out.Entries += int32(len(e.Profile))
+		out.Profiles = append(out.Profiles, edgeProfileToProto(e))
+	}
+	return out, nil
+}
+
+func edgeProfileToProto(e repo.ProfiledEdge) *pb.EdgeProfile {
+	out := &pb.EdgeProfile{FromId: e.From, ToId: e.To, TravelTime: e.TravelTime}
+	for _, key := range e.Profile.SortedKeys() {
+		day, band := repo.SplitProfileKey(key)
+		out.Entries = append(out.Entries, &pb.ProfileEntry{
+			Day:        day,
+			Band:       band,
+			TravelTime: e.Profile[key],
+			Samples:    int32(e.Samples[key]),
+		})
+	}
+	return out
+}
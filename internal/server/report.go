@@ -0,0 +1,429 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	ReportPDF  = "PDF"
+	ReportCSV  = "CSV"
+	ReportHTML = "HTML"
+	ReportJSON = "JSON"
+
+	reportChunkSize = 64 * 1024
+)
+
+type Vehicle = repo.Vehicle
+type Stop = repo.Stop
+type LinePattern = repo.LinePattern
+
+// reportData is everything a report shows, collected once and rendered into any format.
+type reportData struct {
+	GeneratedAt      time.Time                `json:"generated_at"`
+	Locale           string                   `json:"locale"`
+	At               time.Time                `json:"at"`
+	Sections         []string                 `json:"sections"`
+	Limits           map[string]int           `json:"limits"`
+	Filter           reportFilter             `json:"filter"`
+	Notices          map[string]string        `json:"notices,omitempty"`
+	StartID          string                   `json:"start_id"`
+	EndID            string                   `json:"end_id"`
+	VehiclesByDepot  map[string][]Vehicle     `json:"vehicles_by_depot"`
+	StopsByZone      map[string][]Stop        `json:"stops_by_zone"`
+	LinePatterns     []LinePattern            `json:"line_patterns"`
+	Fleet            []FleetRow               `json:"fleet"`
+	TopPairs         *pb.TopPairsResponse     `json:"top_pairs"`
+	DepotStats       *pb.DepotsResponse       `json:"depot_stats"`
+	CentralityMetric string                   `json:"centrality_metric"`
+	TopStops         []repo.StopCentrality    `json:"top_stops"`
+	Critical         *repo.Resilience         `json:"critical_elements"`
+	Connectivity     *repo.Connectivity       `json:"connectivity"`
+	Ridership        *repo.Ridership          `json:"ridership"`
+	Assignment       *repo.Assignment         `json:"demand_assignment"`
+	ShortestPath     *repo.PathResult         `json:"shortest_path"`
+	Map              *networkMap              `json:"-"`
+	Timetable        *repo.Timetable          `json:"timetable,omitempty"`
+	Scenario         *repo.Scenario           `json:"scenario,omitempty"`
+	ScenarioEval     *repo.ScenarioEvaluation `json:"scenario_evaluation,omitempty"`
+
+	loc       *reportLocale
+	progress  reportProgress
+	collected int
+}
+
+type renderedReport struct {
+	Format      string
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+func (s *Server) GenerateReport(ctx context.Context, req *pb.GenerateReportRequest) (*pb.GenerateReportResponse, error) {
+	rep, err := s.buildReport(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenerateReportResponse{
+		Created:     true,
+		Filename:    rep.Filename,
+		Content:     rep.Content,
+		Format:      rep.Format,
+		ContentType: rep.ContentType,
+		Size:        int64(len(rep.Content)),
+	}, nil
+}
+
+// GenerateReportStream sends the report in chunks; the first chunk carries the metadata.
+func (s *Server) GenerateReportStream(req *pb.GenerateReportRequest, stream grpc.ServerStreamingServer[pb.ReportChunk]) error {
+	rep, err := s.buildReport(stream.Context(), req, nil)
+	if err != nil {
+		return err
+	}
+	return sendReportChunks(rep, stream)
+}
+
+func sendReportChunks(rep *renderedReport, stream grpc.ServerStreamingServer[pb.ReportChunk]) error {
+	for off := 0; off == 0 || off < len(rep.Content); off += reportChunkSize {
+		end := min(off+reportChunkSize, len(rep.Content))
+		chunk := &pb.ReportChunk{Data: rep.Content[off:end], Offset: int64(off), Last: end == len(rep.Content)}
+		if off == 0 {
+			chunk.Format, chunk.ContentType, chunk.Filename = rep.Format, rep.ContentType, rep.Filename
+			chunk.TotalSize = int64(len(rep.Content))
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildReport collects, renders and, when asked, stores the report in the output directory.
+// progress, when set, is told which section is being collected.
+func (s *Server) buildReport(ctx context.Context, req *pb.GenerateReportRequest, progress reportProgress) (*renderedReport, error) {
+	rep, err := s.newRenderedReport(req)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.collectReport(ctx, req, progress)
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil {
+		progress("render", 90)
+	}
+	switch rep.Format {
+	case ReportPDF:
+		rep.Content, err = renderPDF(d)
+	case ReportCSV:
+		rep.Content, err = renderCSVBundle(d)
+	case ReportHTML:
+		rep.Content, err = renderHTML(d)
+	case ReportJSON:
+		rep.Content, err = renderJSON(d)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.Store {
+		rep.Filename, err = storeReport(s.reportDir, reportExtension(rep.Format), rep.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rep, nil
+}
+
+// newRenderedReport checks the format and storage options before any data is collected.
+func (s *Server) newRenderedReport(req *pb.GenerateReportRequest) (*renderedReport, error) {
+	format := strings.ToUpper(req.Format)
+	if format == "" {
+		format = ReportPDF
+	}
+	rep := &renderedReport{Format: format}
+	switch format {
+	case ReportPDF:
+		rep.ContentType = "application/pdf"
+	case ReportCSV:
+		rep.ContentType = "application/zip"
+	case ReportHTML:
+		rep.ContentType = "text/html; charset=utf-8"
+	case ReportJSON:
+		rep.ContentType = "application/json"
+	default:
+		return nil, fmt.Errorf("invalid format %q, expected PDF, CSV, HTML or JSON", req.Format)
+	}
+	if req.Store && s.reportDir == "" {
+		return nil, fmt.Errorf("report storage is not configured")
+	}
+	return rep, nil
+}
+
+func reportExtension(format string) string {
+	if format == ReportCSV {
+		return ".zip"
+	}
+	return "." + strings.ToLower(format)
+}
+
+// storeReport writes content under a unique name so concurrent reports never overwrite each other.
+func storeReport(dir, ext string, content []byte) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create report directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, "report-"+time.Now().Format("20060102-150405")+"-*"+ext)
+	if err != nil {
+		return "", fmt.Errorf("failed to create report file: %w", err)
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+	return filepath.Base(f.Name()), nil
+}
+
+// collectReport gathers the selected sections; a section that cannot be built gets a notice instead.
+func (s *Server) collectReport(ctx context.Context, req *pb.GenerateReportRequest, progress reportProgress) (*reportData, error) {
+	d, err := newReportData(req)
+	if err != nil {
+		return nil, err
+	}
+	d.progress = progress
+	d.At = timeOrNow(req.At)
+
+	var zoneOf map[string]string
+	if d.enter(SectionStops) || len(d.Filter.Zones) > 0 {
+		byZone, err := s.repo.GetStopsByZone()
+		if err != nil {
+			d.noData(SectionStops, fmt.Errorf("failed to fetch stops: %w", err))
+		}
+		zoneOf = map[string]string{}
+		for zone, stops := range byZone {
+			for _, st := range stops {
+				zoneOf[st.ID] = zone
+			}
+			if d.includes(SectionStops) && d.Filter.zone(zone) {
+				if d.StopsByZone == nil {
+					d.StopsByZone = map[string][]Stop{}
+				}
+				d.StopsByZone[zone] = limitRows(stops, d.Limits[SectionStops])
+			}
+		}
+		if err == nil && len(d.StopsByZone) == 0 {
+			d.noData(SectionStops, nil)
+		}
+	}
+
+	if d.enter(SectionVehicles) {
+		byDepot, err := s.repo.GetVehiclesByDepot()
+		if err != nil {
+			d.noData(SectionVehicles, fmt.Errorf("failed to fetch vehicles: %w", err))
+		}
+		for depot, vehicles := range byDepot {
+			if d.Filter.depot(depot) {
+				if d.VehiclesByDepot == nil {
+					d.VehiclesByDepot = map[string][]Vehicle{}
+				}
+				d.VehiclesByDepot[depot] = limitRows(vehicles, d.Limits[SectionVehicles])
+			}
+		}
+		if err == nil && len(d.VehiclesByDepot) == 0 {
+			d.noData(SectionVehicles, nil)
+		}
+	}
+
+	if d.enter(SectionLinePatterns) {
+		patterns, err := s.repo.GetLinePatterns()
+		if err != nil {
+			d.noData(SectionLinePatterns, fmt.Errorf("failed to fetch line patterns: %w", err))
+		}
+		for _, p := range patterns {
+			if d.Filter.line(p.LineID) {
+				d.LinePatterns = append(d.LinePatterns, p)
+			}
+		}
+		d.LinePatterns = limitRows(d.LinePatterns, d.Limits[SectionLinePatterns])
+		if err == nil && len(d.LinePatterns) == 0 {
+			d.noData(SectionLinePatterns, nil)
+		}
+	}
+
+	timetableLine := req.TimetableLineId
+	if d.enter(SectionFleet) || (d.includes(SectionTimetable) && timetableLine == "") {
+		fleet, err := s.fleetAt(ctx, d.At)
+		if err != nil {
+			d.noData(SectionFleet, fmt.Errorf("failed to fetch fleet sizing: %w", err))
+		}
+		var rows []FleetRow
+		for _, f := range fleet {
+			if d.Filter.line(f.LineID) {
+				rows = append(rows, f)
+			}
+		}
+		if timetableLine == "" && len(rows) > 0 {
+			timetableLine = rows[0].LineID
+		}
+		if d.includes(SectionFleet) {
+			d.Fleet = limitRows(rows, d.Limits[SectionFleet])
+			if err == nil && len(d.Fleet) == 0 {
+				d.noData(SectionFleet, nil)
+			}
+		}
+	}
+
+	if d.enter(SectionTimetable) {
+		if timetableLine == "" {
+			d.noData(SectionTimetable, nil)
+		} else {
+			day := time.Date(d.At.Year(), d.At.Month(), d.At.Day(), 0, 0, 0, 0, d.At.Location())
+			d.Timetable, err = s.repo.GenerateTimetable(ctx, timetableLine, repo.DirectionOutbound, repo.PatternMain, day, 0, nil)
+			if err != nil {
+				d.noData(SectionTimetable, fmt.Errorf("failed to generate timetable: %w", err))
+			}
+		}
+	}
+
+	if d.enter(SectionScenario) {
+		if req.ScenarioId == "" {
+			d.noData(SectionScenario, fmt.Errorf("scenario_id is not set"))
+		} else {
+			d.Scenario, d.ScenarioEval, err = s.evaluateScenario(ctx, req.ScenarioId, req.StartId, req.EndId, 0, req.At)
+			if err != nil {
+				d.noData(SectionScenario, fmt.Errorf("failed to evaluate scenario: %w", err))
+			}
+		}
+	}
+
+	if d.enter(SectionCentrality) {
+		limit := d.Limits[SectionCentrality]
+		if len(d.Filter.Zones) > 0 {
+			limit = 0
+		}
+		stops, err := s.repo.StopCentrality(ctx, d.CentralityMetric, limit)
+		if err != nil {
+			d.noData(SectionCentrality, fmt.Errorf("failed to rank stops: %w", err))
+		}
+		for _, st := range stops {
+			if len(d.Filter.Zones) == 0 || d.Filter.zone(zoneOf[st.StopID]) {
+				d.TopStops = append(d.TopStops, st)
+			}
+		}
+		d.TopStops = limitRows(d.TopStops, d.Limits[SectionCentrality])
+		if err == nil && len(d.TopStops) == 0 {
+			d.noData(SectionCentrality, nil)
+		}
+	}
+
+	if d.enter(SectionCritical) {
+		d.Critical, err = s.repo.CriticalElements(ctx, 0, d.Limits[SectionCritical])
+		if err != nil {
+			d.noData(SectionCritical, fmt.Errorf("failed to compute critical elements: %w", err))
+		}
+	}
+
+	if d.enter(SectionConnectivity) {
+		d.Connectivity, err = s.repo.ConnectivityReport(ctx, 0, d.Limits[SectionConnectivity])
+		if err != nil {
+			d.noData(SectionConnectivity, fmt.Errorf("failed to compute connectivity: %w", err))
+		}
+	}
+
+	if d.enter(SectionRidership) {
+		limit := d.Limits[SectionRidership]
+		if !d.Filter.empty() {
+			limit = 0
+		}
+		d.Ridership, err = s.repo.RidershipStats(ctx, d.At, limit)
+		if err != nil {
+			d.noData(SectionRidership, fmt.Errorf("failed to compute ridership: %w", err))
+		} else {
+			d.filterRidership()
+			if len(d.Ridership.Stops) == 0 && len(d.Ridership.Lines) == 0 {
+				d.noData(SectionRidership, nil)
+			}
+		}
+	}
+
+	if d.enter(SectionDemand) {
+		d.Assignment, err = s.repo.AssignDemand(ctx, d.At)
+		switch {
+		case err != nil:
+			d.noData(SectionDemand, fmt.Errorf("failed to assign demand: %w", err))
+		case d.Assignment.Pairs == 0:
+			d.noData(SectionDemand, nil)
+		default:
+			d.filterAssignment()
+		}
+	}
+
+	if d.enter(SectionShortestPath) {
+		if req.StartId == "" || req.EndId == "" {
+			d.noData(SectionShortestPath, fmt.Errorf("start_id and end_id are not set"))
+		} else {
+			d.ShortestPath, err = s.repo.ShortestPath(ctx, req.StartId, req.EndId, int(req.MaxHops), repo.PathFilter{At: d.At.UnixMilli()})
+			if err != nil {
+				d.noData(SectionShortestPath, fmt.Errorf("no path found: %w", err))
+			}
+		}
+	}
+
+	if d.enter(SectionTopPairs) {
+		d.TopPairs, err = s.TopPairs(ctx, &pb.TopPairsRequest{Limit: int32(d.Limits[SectionTopPairs])})
+		if err != nil {
+			d.noData(SectionTopPairs, fmt.Errorf("failed to fetch top pairs: %w", err))
+		} else if len(d.TopPairs.Pairs) == 0 {
+			d.noData(SectionTopPairs, nil)
+		}
+	}
+
+	if d.enter(SectionDepots) {
+		limit := int32(d.Limits[SectionDepots])
+		if len(d.Filter.Depots) > 0 {
+			limit = math.MaxInt32
+		}
+		d.DepotStats, err = s.DepotsIdleStats(ctx, &pb.DepotsRequest{Limit: limit})
+		if err != nil {
+			d.noData(SectionDepots, fmt.Errorf("failed to fetch depot stats: %w", err))
+		} else {
+			var stats []*pb.DepotStat
+			for _, st := range d.DepotStats.Stats {
+				if d.Filter.depot(st.DepotId) || d.Filter.depot(st.DepotName) {
+					stats = append(stats, st)
+				}
+			}
+			d.DepotStats.Stats = limitRows(stats, d.Limits[SectionDepots])
+			if len(stats) == 0 {
+				d.noData(SectionDepots, nil)
+			}
+		}
+	}
+
+	if d.enter(SectionNetworkMap) {
+		opts := mapOptions{Lines: d.Filter.Lines, Zones: req.MapZones, Labels: true}
+		if d.ShortestPath != nil {
+			opts.Path = d.ShortestPath.Stops
+		}
+		d.Map, err = s.loadNetworkMap(ctx, opts)
+		if err != nil {
+			d.noData(SectionNetworkMap, fmt.Errorf("failed to load network map: %w", err))
+		} else if len(d.Map.Network.Stops) == 0 {
+			d.noData(SectionNetworkMap, nil)
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,268 @@
+package server
+
+// Report message catalogues. Numbers are passed already formatted by the locale, so every
+// placeholder is %s; keep both catalogues in sync when adding a key.
+
+var catalogSerbian = map[string]string{
+	"yes":              "da",
+	"no":               "ne",
+	"doc.title":        "Izveštaj iz baze",
+	"report.title":     "Izveštaj javnog preduzeća",
+	"report.generated": "Datum generisanja: %s",
+	"no_data":          "Nema podataka.",
+	"no_data.reason":   "Nema podataka: %s",
+
+	"section.vehicles":          "Vozila parkirana u depoima",
+	"section.stops":             "Stajališta po zonama",
+	"section.line_patterns":     "Linije i varijante trasa",
+	"section.fleet":             "Frekvencije i potreban broj vozila",
+	"section.top_pairs":         "Najčešće povezani parovi stajališta",
+	"section.depots":            "Statistike depoa",
+	"section.centrality":        "Najviše povezana stajališta",
+	"section.critical_elements": "Kritični elementi mreže",
+	"section.connectivity":      "Povezanost mreže",
+	"section.ridership":         "Izveštaj o broju putnika",
+	"section.demand":            "Opterećenje mreže prema matrici putovanja",
+	"section.network_map":       "Mapa mreže",
+	"section.shortest_path":     "Najkraća putanja",
+	"section.timetable":         "Red vožnje",
+	"section.scenario":          "Poređenje scenarija",
+
+	"vehicles.heading": "Izveštaj o trenutnim vozilima parkiranim u depoima",
+	"vehicles.depot":   "Depo: %s",
+	"vehicles.row":     "Vozilo: %s | Status: %s | Kapacitet: %s",
+	"stops.heading":    "Izveštaj o stajalištima po zonama",
+	"stops.zone":       "Zona: %s",
+	"stops.row":        "Stajalište: %s | Naziv: %s | Nadkriveno: %s",
+
+	"patterns.heading": "Izveštaj o linijama i varijantama trasa",
+	"patterns.all_day": "ceo dan",
+	"patterns.row":     "Linija: %s | Smer: %s | Varijanta: %s | Stajališta: %s | Važi: %s",
+
+	"fleet.heading":    "Frekvencije i potreban broj vozila (%s)",
+	"fleet.no_service": "ne saobraća",
+	"fleet.interval":   "%s min",
+	"fleet.row":        "Linija: %s | Interval: %s | Obrt: %s min | Potrebno vozila: %s | Dodeljeno: %s",
+
+	"top_pairs.heading": "Top %s najčešće povezanih parova stanica",
+	"top_pairs.row":     "Od: %-8s  Do: %-8s  | Broj linija: %s",
+	"depots.heading":    "Statistike depoa - broj parkiranih vozila i prosečno vreme mirovanja",
+	"depots.row":        "Depo: %-12s | Parkirano: %s | Prosečno mirovanje: %s ms",
+
+	"centrality.heading": "Izveštaj o najviše povezanim stajalištima (%s)",
+	"centrality.none":    "Nisu pronađena povezana stajališta.",
+	"centrality.note":    "%s | stepen %s | linija %s",
+
+	"map.depot": "Depo",
+	"map.path":  "Najkraća putanja",
+
+	"path.heading": "Izveštaj o najkraćoj putanji između %s i %s",
+	"path.none":    "Nema pronađenog puta između zadatih čvorova.",
+
+	"timetable.heading":    "Red vožnje linije %s (%s, %s) za %s - %s",
+	"timetable.offsets":    "Vreme vožnje od početnog stajališta",
+	"timetable.departures": "Polasci sa početnog stajališta",
+	"timetable.no_service": "Linija ne saobraća tog dana.",
+
+	"scenario.heading":          "Poređenje scenarija %s (%s) sa trenutnom mrežom",
+	"scenario.changes":          "Broj izmena: %s",
+	"scenario.stops_added":      "Dodata stajališta: %s",
+	"scenario.stops_removed":    "Uklonjena stajališta: %s",
+	"scenario.edges":            "Nove ili izmenjene NEXT veze: %s | Uklonjene NEXT veze: %s",
+	"scenario.routes":           "Izmenjene trase: %s",
+	"scenario.vehicles":         "Potrebno vozila: %s -> %s (%s)",
+	"scenario.path":             "Najkraća putanja: %s (%s s) -> %s (%s s)",
+	"scenario.lines":            "Promene po linijama",
+	"scenario.no_fleet_changes": "Nema promena u obrtu i potrebnom broju vozila.",
+	"scenario.fleet_row":        "Linija: %s | Interval: %s -> %s min | Obrt: %s -> %s min | Vozila: %s -> %s",
+	"scenario.warnings":         "Upozorenja u scenariju",
+
+	"critical.articulation": "Artikulaciona stajališta: %s",
+	"critical.summary":      "Mostovi: %s | Uzorak parova: %s | Prosečno vreme putovanja: %s s",
+	"critical.row":          "%s %s | Razdvojeni parovi: %s/%s | Povećanje vremena: %s s",
+
+	"connectivity.summary":   "Jako povezane komponente: %s | Slabo povezane komponente: %s",
+	"connectivity.isolated":  "Izolovana stajališta: %s",
+	"connectivity.outside":   "Van glavne komponente (%s stajališta): %s",
+	"connectivity.candidate": "Predlog veze %s -> %s | Rastojanje: %s m | Komponente: %s - %s",
+
+	"demand.summary":         "Parovi: %s | Raspoređeno: %s putnika/h | Bez putanje: %s putnika/h (%s parova)",
+	"demand.lines":           "Linije",
+	"demand.line_row":        "%s | Kapacitet: %s putnika/h | Najveće opterećenje: %s (%s - %s) | Iskorišćenost: %s%%",
+	"demand.overloaded":      "Preopterećeni segmenti",
+	"demand.edge_row":        "%s -> %s | Linije: %s | Opterećenje: %s / %s putnika/h",
+	"demand.none_overloaded": "Nema preopterećenih segmenata.",
+
+	"ridership.summary":   "Ukrcavanja: %s/h | Kapacitet: %s putnika/h | Ukrcavanja po kapacitet-satu: %s",
+	"ridership.stops":     "Najopterećenija stajališta",
+	"ridership.stop_note": "%s/h | linija %s",
+	"ridership.lines":     "Najopterećenije linije",
+	"ridership.line_note": "%s/h | %s po kap.-satu",
+	"ridership.zones":     "Ukrcavanja po zonama",
+	"ridership.zone":      "Zona %s",
+	"ridership.zone_note": "%s/h | stajališta %s",
+
+	"table.centrality":      "Najviše povezana stajališta (%s)",
+	"table.components":      "Komponente povezanosti",
+	"table.link_candidates": "Predlozi novih veza",
+	"table.demand_edges":    "Opterećenje segmenata",
+	"table.demand_lines":    "Opterećenje linija",
+	"table.shortest_path":   "Najkraća putanja %s - %s",
+	"table.timetable":       "Red vožnje linije %s (%s, %s) za %s",
+	"table.scenario":        "Scenario %s - %s",
+
+	"sheet.doc_title":    "Plan linije",
+	"sheet.title":        "Plan linije %s - %s",
+	"sheet.info":         "Vrsta: %s | Trenutni interval: %s | Aktivna: %s",
+	"sheet.route":        "Smer %s, varijanta %s",
+	"sheet.no_stops":     "Linija nema stajališta.",
+	"sheet.gap":          "* nema NEXT veze od prethodnog stajališta, deonica nije uračunata",
+	"sheet.vehicles":     "Dodeljena vozila",
+	"sheet.no_vehicles":  "Nema dodeljenih vozila.",
+	"sheet.col.stop":     "Stajalište",
+	"sheet.col.name":     "Naziv",
+	"sheet.col.zone":     "Zona",
+	"sheet.col.shelter":  "Nadstrešnica",
+	"sheet.col.time":     "Vreme (min)",
+	"sheet.col.distance": "Rastojanje (km)",
+	"sheet.col.vehicle":  "Vozilo",
+	"sheet.col.capacity": "Kapacitet",
+	"sheet.col.status":   "Status",
+	"sheet.periods":      "Periodi saobraćaja",
+	"sheet.col.day":      "Tip dana",
+	"sheet.col.from":     "Od",
+	"sheet.col.to":       "Do",
+	"sheet.col.headway":  "Interval (min)",
+}
+
+var catalogEnglish = map[string]string{
+	"yes":              "yes",
+	"no":               "no",
+	"doc.title":        "Database report",
+	"report.title":     "Public transport company report",
+	"report.generated": "Generated: %s",
+	"no_data":          "No data.",
+	"no_data.reason":   "No data: %s",
+
+	"section.vehicles":          "Vehicles parked in depots",
+	"section.stops":             "Stops by zone",
+	"section.line_patterns":     "Lines and route patterns",
+	"section.fleet":             "Frequencies and required vehicles",
+	"section.top_pairs":         "Most connected stop pairs",
+	"section.depots":            "Depot statistics",
+	"section.centrality":        "Most connected stops",
+	"section.critical_elements": "Critical network elements",
+	"section.connectivity":      "Network connectivity",
+	"section.ridership":         "Ridership",
+	"section.demand":            "Network load from the trip matrix",
+	"section.network_map":       "Network map",
+	"section.shortest_path":     "Shortest path",
+	"section.timetable":         "Timetable",
+	"section.scenario":          "Scenario comparison",
+
+	"vehicles.heading": "Vehicles currently parked in depots",
+	"vehicles.depot":   "Depot: %s",
+	"vehicles.row":     "Vehicle: %s | Status: %s | Capacity: %s",
+	"stops.heading":    "Stops by zone",
+	"stops.zone":       "Zone: %s",
+	"stops.row":        "Stop: %s | Name: %s | Sheltered: %s",
+
+	"patterns.heading": "Lines and route patterns",
+	"patterns.all_day": "all day",
+	"patterns.row":     "Line: %s | Direction: %s | Pattern: %s | Stops: %s | Valid: %s",
+
+	"fleet.heading":    "Frequencies and required vehicles (%s)",
+	"fleet.no_service": "no service",
+	"fleet.interval":   "%s min",
+	"fleet.row":        "Line: %s | Headway: %s | Cycle: %s min | Required vehicles: %s | Assigned: %s",
+
+	"top_pairs.heading": "Top %s most connected stop pairs",
+	"top_pairs.row":     "From: %-8s  To: %-8s  | Lines: %s",
+	"depots.heading":    "Depot statistics - parked vehicles and average idle time",
+	"depots.row":        "Depot: %-12s | Parked: %s | Average idle: %s ms",
+
+	"centrality.heading": "Most connected stops (%s)",
+	"centrality.none":    "No connected stops found.",
+	"centrality.note":    "%s | degree %s | lines %s",
+
+	"map.depot": "Depot",
+	"map.path":  "Shortest path",
+
+	"path.heading": "Shortest path between %s and %s",
+	"path.none":    "No path found between the given stops.",
+
+	"timetable.heading":    "Timetable of line %s (%s, %s) for %s - %s",
+	"timetable.offsets":    "Running time from the first stop",
+	"timetable.departures": "Departures from the first stop",
+	"timetable.no_service": "The line does not run on that day.",
+
+	"scenario.heading":          "Scenario %s (%s) compared with the current network",
+	"scenario.changes":          "Changes: %s",
+	"scenario.stops_added":      "Stops added: %s",
+	"scenario.stops_removed":    "Stops removed: %s",
+	"scenario.edges":            "New or changed NEXT links: %s | Removed NEXT links: %s",
+	"scenario.routes":           "Changed routes: %s",
+	"scenario.vehicles":         "Required vehicles: %s -> %s (%s)",
+	"scenario.path":             "Shortest path: %s (%s s) -> %s (%s s)",
+	"scenario.lines":            "Changes per line",
+	"scenario.no_fleet_changes": "No changes in cycle time or required vehicles.",
+	"scenario.fleet_row":        "Line: %s | Headway: %s -> %s min | Cycle: %s -> %s min | Vehicles: %s -> %s",
+	"scenario.warnings":         "Scenario warnings",
+
+	"critical.articulation": "Articulation stops: %s",
+	"critical.summary":      "Bridges: %s | Sampled pairs: %s | Average travel time: %s s",
+	"critical.row":          "%s %s | Disconnected pairs: %s/%s | Travel time increase: %s s",
+
+	"connectivity.summary":   "Strongly connected components: %s | Weakly connected components: %s",
+	"connectivity.isolated":  "Isolated stops: %s",
+	"connectivity.outside":   "Outside the main component (%s stops): %s",
+	"connectivity.candidate": "Proposed link %s -> %s | Distance: %s m | Components: %s - %s",
+
+	"demand.summary":         "Pairs: %s | Assigned: %s passengers/h | Without a path: %s passengers/h (%s pairs)",
+	"demand.lines":           "Lines",
+	"demand.line_row":        "%s | Capacity: %s passengers/h | Peak load: %s (%s - %s) | Utilisation: %s%%",
+	"demand.overloaded":      "Overloaded segments",
+	"demand.edge_row":        "%s -> %s | Lines: %s | Load: %s / %s passengers/h",
+	"demand.none_overloaded": "No overloaded segments.",
+
+	"ridership.summary":   "Boardings: %s/h | Capacity: %s passengers/h | Boardings per capacity-hour: %s",
+	"ridership.stops":     "Busiest stops",
+	"ridership.stop_note": "%s/h | lines %s",
+	"ridership.lines":     "Busiest lines",
+	"ridership.line_note": "%s/h | %s per cap.-hour",
+	"ridership.zones":     "Boardings by zone",
+	"ridership.zone":      "Zone %s",
+	"ridership.zone_note": "%s/h | stops %s",
+
+	"table.centrality":      "Most connected stops (%s)",
+	"table.components":      "Connectivity components",
+	"table.link_candidates": "Proposed new links",
+	"table.demand_edges":    "Segment load",
+	"table.demand_lines":    "Line load",
+	"table.shortest_path":   "Shortest path %s - %s",
+	"table.timetable":       "Timetable of line %s (%s, %s) for %s",
+	"table.scenario":        "Scenario %s - %s",
+
+	"sheet.doc_title":    "Route sheet",
+	"sheet.title":        "Route sheet of line %s - %s",
+	"sheet.info":         "Mode: %s | Headway now: %s | Active: %s",
+	"sheet.route":        "Direction %s, pattern %s",
+	"sheet.no_stops":     "The line has no stops.",
+	"sheet.gap":          "* no NEXT edge from the previous stop, the leg is not counted",
+	"sheet.vehicles":     "Assigned vehicles",
+	"sheet.no_vehicles":  "No vehicles assigned.",
+	"sheet.col.stop":     "Stop",
+	"sheet.col.name":     "Name",
+	"sheet.col.zone":     "Zone",
+	"sheet.col.shelter":  "Shelter",
+	"sheet.col.time":     "Time (min)",
+	"sheet.col.distance": "Distance (km)",
+	"sheet.col.vehicle":  "Vehicle",
+	"sheet.col.capacity": "Capacity",
+	"sheet.col.status":   "Status",
+	"sheet.periods":      "Service periods",
+	"sheet.col.day":      "Day type",
+	"sheet.col.from":     "From",
+	"sheet.col.to":       "To",
+	"sheet.col.headway":  "Headway (min)",
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "route-graph-service/proto/routegraph"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// reportDatasets are the sections ReportData can return as typed rows.
+var reportDatasets = []string{SectionVehicles, SectionStops, SectionTopPairs, SectionDepots, SectionCentrality}
+
+/*
+ReportData returns the datasets behind the report instead of a document. It collects them with
+collectReport, the same code the PDF uses, and builds the typed rows and the CSV files from that one
+collection, so both always agree with each other and with a PDF of the same request.
+*/
+func (s *Server) ReportData(ctx context.Context, in *pb.ReportDataRequest) (*pb.ReportDataResponse, error) {
+	req := &pb.GenerateReportRequest{}
+	if in.Request != nil {
+		req = proto.Clone(in.Request).(*pb.GenerateReportRequest)
+	}
+	req.Store = false
+	if len(req.Sections) == 0 {
+		req.Sections = reportDatasets
+	}
+	for _, name := range req.Sections {
+		if !slices.Contains(reportDatasets, strings.ToLower(strings.TrimSpace(name))) {
+			return nil, fmt.Errorf("section %q has no dataset, expected %s", name, strings.Join(reportDatasets, ", "))
+		}
+	}
+	d, err := s.collectReport(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.ReportDataResponse{
+		GeneratedTs: d.GeneratedAt.UnixMilli(),
+		At:          d.At.Unix(),
+		Sections:    d.Sections,
+		Notices:     d.Notices,
+	}
+	for _, depot := range sortedKeys(d.VehiclesByDepot) {
+		row := &pb.DepotVehicles{Depot: depot}
+		for _, v := range d.VehiclesByDepot[depot] {
+			row.Vehicles = append(row.Vehicles, &pb.Vehicle{VehicleUuid: v.UUID, Status: v.Status, Capacity: int32(v.Capacity)})
+		}
+		out.VehiclesByDepot = append(out.VehiclesByDepot, row)
+	}
+	for _, zone := range sortedKeys(d.StopsByZone) {
+		row := &pb.ZoneStops{Zone: zone}
+		for _, st := range d.StopsByZone[zone] {
+			row.Stops = append(row.Stops, &pb.Stop{Id: st.ID, Name: st.Name, Zone: st.Zone, Shelter: st.Shelter})
+		}
+		out.StopsByZone = append(out.StopsByZone, row)
+	}
+	if d.TopPairs != nil {
+		out.TopPairs = d.TopPairs.Pairs
+	}
+	if d.DepotStats != nil {
+		out.DepotStats = d.DepotStats.Stats
+	}
+	if d.includes(SectionCentrality) {
+		out.TopStops = &pb.StopCentralityResponse{Metric: d.CentralityMetric}
+		for _, c := range d.TopStops {
+			out.TopStops.Stops = append(out.TopStops.Stops, &pb.StopCentralityItem{
+				StopId:      c.StopID,
+				StopName:    c.Name,
+				Degree:      int32(c.Degree),
+				Lines:       int32(c.Lines),
+				Betweenness: c.Betweenness,
+				Closeness:   c.Closeness,
+				Pagerank:    c.PageRank,
+			})
+		}
+	}
+	if in.IncludeCsv {
+		out.Csv, err = renderCSVBundle(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,286 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strconv"
+
+	"route-graph-service/internal/repo"
+	helper "route-graph-service/util"
+)
+
+// reportTable is one tabular part of the report, shared by the CSV bundle and the HTML page.
+type reportTable struct {
+	Name   string
+	Title  string
+	Header []string
+	Rows   [][]string
+	// Notice replaces the rows when the section has no data
+	Notice string
+	// Graphic is inline SVG shown instead of rows on the HTML page
+	Graphic template.HTML
+}
+
+func itoa(v int) string { return strconv.Itoa(v) }
+
+func ftoa(v float64) string { return strconv.FormatFloat(v, 'f', 2, 64) }
+
+/*
+reportTables lays the report out as tables. With human set numbers and booleans are formatted
+for the report locale (HTML); otherwise they stay machine-readable (CSV).
+*/
+func reportTables(d *reportData, human bool) []reportTable {
+	l := d.loc
+	num, integer, boolean := ftoa, itoa, strconv.FormatBool
+	if human {
+		num = func(v float64) string { return l.Num(v, 2) }
+		integer, boolean = l.Int, l.Bool
+	}
+	var tables []reportTable
+	add := func(name, title string, header []string, rows [][]string) {
+		tables = append(tables, reportTable{Name: name, Title: title, Header: header, Rows: rows})
+	}
+
+	for _, sec := range d.Sections {
+		if msg, ok := d.Notices[sec]; ok {
+			tables = append(tables, reportTable{Name: sec, Title: l.T("section." + sec), Notice: msg})
+			continue
+		}
+		var rows [][]string
+		switch sec {
+		case SectionVehicles:
+			for _, depot := range sortedKeys(d.VehiclesByDepot) {
+				for _, v := range d.VehiclesByDepot[depot] {
+					rows = append(rows, []string{depot, v.UUID, v.Status, integer(v.Capacity)})
+				}
+			}
+			add("vehicles", l.T("section.vehicles"), []string{"depot", "vehicle_uuid", "status", "capacity"}, rows)
+
+		case SectionStops:
+			for _, zone := range sortedKeys(d.StopsByZone) {
+				for _, st := range d.StopsByZone[zone] {
+					rows = append(rows, []string{zone, st.ID, st.Name, boolean(st.Shelter)})
+				}
+			}
+			add("stops", l.T("section.stops"), []string{"zone", "stop_id", "name", "shelter"}, rows)
+
+		case SectionLinePatterns:
+			for _, p := range d.LinePatterns {
+				rows = append(rows, []string{p.LineID, p.Direction, p.Name, integer(p.Stops), p.StartTime, p.EndTime})
+			}
+			add("line_patterns", l.T("section.line_patterns"), []string{"line_id", "direction", "pattern", "stops", "start_time", "end_time"}, rows)
+
+		case SectionFleet:
+			for _, f := range d.Fleet {
+				rows = append(rows, []string{f.LineID, integer(int(f.FrequencyMins)), integer(f.CycleSeconds / 60), integer(f.Required), integer(f.AssignedCount)})
+			}
+			add("fleet", l.T("section.fleet"), []string{"line_id", "frequency_mins", "cycle_mins", "required", "assigned"}, rows)
+
+		case SectionTopPairs:
+			for _, p := range d.TopPairs.GetPairs() {
+				rows = append(rows, []string{p.From, p.To, integer(int(p.Lines))})
+			}
+			add("top_pairs", l.T("section.top_pairs"), []string{"from", "to", "lines"}, rows)
+
+		case SectionDepots:
+			for _, st := range d.DepotStats.GetStats() {
+				rows = append(rows, []string{st.DepotId, st.DepotName, integer(int(st.ParkedCount)), num(st.AvgIdleMs)})
+			}
+			add("depots", l.T("section.depots"), []string{"depot_id", "depot_name", "parked", "avg_idle_ms"}, rows)
+
+		case SectionCentrality:
+			for _, st := range d.TopStops {
+				rows = append(rows, []string{st.StopID, st.Name, num(st.Value(d.CentralityMetric)), integer(st.Degree), integer(st.Lines)})
+			}
+			add("centrality", l.T("table.centrality", d.CentralityMetric), []string{"stop_id", "name", "value", "degree", "lines"}, rows)
+
+		case SectionCritical:
+			for _, c := range d.Critical.Ranked {
+				rows = append(rows, []string{c.Kind, c.StopID, c.From, c.To, boolean(c.Cut),
+					integer(c.DisconnectedPairs), integer(c.Pairs), num(c.Increase())})
+			}
+			add("critical_elements", l.T("section.critical_elements"), []string{"kind", "stop_id", "from", "to", "cut", "disconnected_pairs", "pairs", "avg_increase_secs"}, rows)
+
+		case SectionConnectivity:
+			for i, c := range d.Connectivity.Strong {
+				for _, id := range c {
+					rows = append(rows, []string{"strong", integer(i), id})
+				}
+			}
+			for i, c := range d.Connectivity.Weak {
+				for _, id := range c {
+					rows = append(rows, []string{"weak", integer(i), id})
+				}
+			}
+			add("components", l.T("table.components"), []string{"kind", "component", "stop_id"}, rows)
+
+			rows = nil
+			for _, c := range d.Connectivity.Candidates {
+				rows = append(rows, []string{c.From, c.To, integer(c.FromComponent), integer(c.ToComponent), num(c.Distance), boolean(c.JoinsWeak)})
+			}
+			add("link_candidates", l.T("table.link_candidates"), []string{"from", "to", "from_component", "to_component", "distance_m", "joins_weak"}, rows)
+
+		case SectionRidership:
+			for _, st := range d.Ridership.Stops {
+				rows = append(rows, []string{st.StopID, st.Name, st.Zone, num(st.Boardings), num(st.BoardingsPerHour)})
+			}
+			add("ridership_stops", l.T("ridership.stops"), []string{"stop_id", "name", "zone", "boardings", "boardings_per_hour"}, rows)
+
+			rows = nil
+			for _, l := range d.Ridership.Lines {
+				rows = append(rows, []string{l.LineID, l.Name, num(l.Boardings), num(l.BoardingsPerHour), num(l.Capacity), num(l.PerCapacityHour())})
+			}
+			add("ridership_lines", l.T("ridership.lines"), []string{"line_id", "name", "boardings", "boardings_per_hour", "capacity", "per_capacity_hour"}, rows)
+
+			rows = nil
+			for _, z := range d.Ridership.Zones {
+				rows = append(rows, []string{z.Zone, integer(z.Stops), num(z.Boardings), num(z.BoardingsPerHour)})
+			}
+			add("ridership_zones", l.T("ridership.zones"), []string{"zone", "stops", "boardings", "boardings_per_hour"}, rows)
+
+		case SectionDemand:
+			for _, e := range d.Assignment.Edges {
+				rows = append(rows, []string{e.From, e.To, joinOrDash(e.Lines), num(e.Load), num(e.Capacity), boolean(repo.Overloaded(e.Load, e.Capacity))})
+			}
+			add("demand_edges", l.T("table.demand_edges"), []string{"from", "to", "lines", "load", "capacity", "overloaded"}, rows)
+
+			rows = nil
+			for _, l := range d.Assignment.Lines {
+				rows = append(rows, []string{l.LineID, num(l.Capacity), num(l.PeakLoad), l.PeakFrom, l.PeakTo, num(repo.Utilization(l.PeakLoad, l.Capacity))})
+			}
+			add("demand_lines", l.T("table.demand_lines"), []string{"line_id", "capacity", "peak_load", "peak_from", "peak_to", "utilization"}, rows)
+
+		case SectionNetworkMap:
+			if human {
+				c := newSVGCanvas(800, 800)
+				d.Map.draw(c, l, 0, 0, 800, 800)
+				tables = append(tables, reportTable{Name: sec, Title: l.T("section.network_map"), Graphic: template.HTML(c.Bytes())})
+			}
+
+		case SectionShortestPath:
+			for i, id := range d.ShortestPath.Stops {
+				link := ""
+				if i > 0 {
+					link = d.ShortestPath.Links[i-1]
+				}
+				rows = append(rows, []string{integer(i), id, link})
+			}
+			add("shortest_path", l.T("table.shortest_path", d.StartID, d.EndID), []string{"hop", "stop_id", "link"}, rows)
+
+		case SectionTimetable:
+			header := []string{"trip"}
+			for _, st := range d.Timetable.Stops {
+				header = append(header, st.StopID)
+			}
+			for i := range d.Timetable.Departures {
+				row := []string{integer(i + 1)}
+				for j := range d.Timetable.Stops {
+					row = append(row, helper.ClockString(d.Timetable.ArrivalAt(i, j)))
+				}
+				rows = append(rows, row)
+			}
+			add("timetable", l.T("table.timetable", d.Timetable.LineID, d.Timetable.Direction, d.Timetable.Pattern, d.Timetable.Date), header, rows)
+
+		case SectionScenario:
+			b, s := d.ScenarioEval.Baseline, d.ScenarioEval.Scenario
+			add("scenario", l.T("table.scenario", d.Scenario.ID, d.Scenario.Name), []string{"metric", "baseline", "scenario"}, [][]string{
+				{"path", joinOrDash(b.Path), joinOrDash(s.Path)},
+				{"hops", integer(b.Hops), integer(s.Hops)},
+				{"travel_time", integer(int(b.TravelTime)), integer(int(s.TravelTime))},
+				{"required_vehicles", integer(b.RequiredVehicles), integer(s.RequiredVehicles)},
+			})
+		}
+	}
+	return tables
+}
+
+// renderCSVBundle zips one CSV file per report table and lists sections without data in notices.csv.
+func renderCSVBundle(d *reportData) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	var notices [][]string
+	for _, t := range reportTables(d, false) {
+		if t.Notice != "" {
+			notices = append(notices, []string{t.Name, t.Notice})
+			continue
+		}
+		f, err := zw.Create(t.Name + ".csv")
+		if err != nil {
+			return nil, err
+		}
+		w := csv.NewWriter(f)
+		if err := w.Write(t.Header); err != nil {
+			return nil, err
+		}
+		if err := w.WriteAll(t.Rows); err != nil {
+			return nil, err
+		}
+	}
+	if len(notices) > 0 {
+		f, err := zw.Create("notices.csv")
+		if err != nil {
+			return nil, err
+		}
+		w := csv.NewWriter(f)
+		if err := w.WriteAll(append([][]string{{"section", "notice"}}, notices...)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write csv bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+var reportHTML = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #999; padding: 2px 8px; font-size: 0.9em; }
+th { background: #4682b4; color: #fff; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Generated}}</p>
+{{range .Tables}}
+<h2>{{.Title}}</h2>
+{{if .Notice}}<p><em>{{.Notice}}</em></p>{{else if .Graphic}}{{.Graphic}}{{else if .Rows}}<table>
+<tr>{{range .Header}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>{{else}}<p>{{$.NoData}}</p>{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+func renderHTML(d *reportData) ([]byte, error) {
+	var buf bytes.Buffer
+	err := reportHTML.Execute(&buf, map[string]any{
+		"Lang":      d.loc.Tag,
+		"Title":     d.loc.T("report.title"),
+		"Generated": d.loc.T("report.generated", d.loc.Time(d.GeneratedAt)),
+		"NoData":    d.loc.T("no_data"),
+		"Tables":    reportTables(d, true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render html: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderJSON(d *reportData) ([]byte, error) {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render json: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,391 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"route-graph-service/internal/cron"
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	JobQueued  = "QUEUED"
+	JobRunning = "RUNNING"
+	JobDone    = "DONE"
+	JobFailed  = "FAILED"
+
+	reportJobQueueSize = 100
+	// finished jobs and their content are kept this long, and at most maxReportJobs of them
+	reportJobRetention = 24 * time.Hour
+	maxReportJobs      = 200
+)
+
+// reportProgress is told the stage being worked on and the share of the report done, 0-100.
+type reportProgress func(stage string, percent int)
+
+type reportJob struct {
+	ID         string
+	ScheduleID string
+	Request    *pb.GenerateReportRequest
+	Status     string
+	Progress   int
+	Stage      string
+	Created    time.Time
+	Started    time.Time
+	Finished   time.Time
+	Err        string
+	Report     *renderedReport
+}
+
+/*
+reportJobs holds submitted jobs in memory; jobs wait in a bounded queue until one of the workers
+started by StartReportJobs picks them up. Jobs do not survive a restart, schedules do.
+*/
+type reportJobs struct {
+	mu    sync.Mutex
+	jobs  map[string]*reportJob
+	queue chan *reportJob
+}
+
+func newReportJobs() *reportJobs {
+	return &reportJobs{jobs: map[string]*reportJob{}, queue: make(chan *reportJob, reportJobQueueSize)}
+}
+
+func newJobID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return "job-" + hex.EncodeToString(b)
+}
+
+func (q *reportJobs) submit(req *pb.GenerateReportRequest, scheduleID string) (*reportJob, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.prune()
+	job := &reportJob{ID: newJobID(), ScheduleID: scheduleID, Request: req, Status: JobQueued, Stage: "queued", Created: time.Now()}
+	select {
+	case q.queue <- job:
+	default:
+		return nil, fmt.Errorf("report queue is full, try again later")
+	}
+	q.jobs[job.ID] = job
+	return job, nil
+}
+
+// prune drops expired finished jobs, then the oldest finished ones above maxReportJobs.
+func (q *reportJobs) prune() {
+	var finished []*reportJob
+	for id, job := range q.jobs {
+		if job.Status != JobDone && job.Status != JobFailed {
+			continue
+		}
+		if time.Since(job.Finished) > reportJobRetention {
+			delete(q.jobs, id)
+			continue
+		}
+		finished = append(finished, job)
+	}
+	if len(q.jobs) < maxReportJobs {
+		return
+	}
+	slices.SortFunc(finished, func(a, b *reportJob) int { return a.Finished.Compare(b.Finished) })
+	for _, job := range finished[:min(len(finished), len(q.jobs)-maxReportJobs+1)] {
+		delete(q.jobs, job.ID)
+	}
+}
+
+func (q *reportJobs) update(job *reportJob, fn func(*reportJob)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fn(job)
+}
+
+// get returns a copy of the job so callers can read it without holding the lock.
+func (q *reportJobs) get(id string) (reportJob, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	job, ok := q.jobs[id]
+	if !ok {
+		return reportJob{}, fmt.Errorf("report job %s not found", id)
+	}
+	return *job, nil
+}
+
+func (q *reportJobs) list() []reportJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make([]reportJob, 0, len(q.jobs))
+	for _, job := range q.jobs {
+		out = append(out, *job)
+	}
+	slices.SortFunc(out, func(a, b reportJob) int { return b.Created.Compare(a.Created) })
+	return out
+}
+
+// StartReportJobs starts the report workers and the schedule loop; both stop when ctx is done.
+func (s *Server) StartReportJobs(ctx context.Context, workers int) {
+	for range max(workers, 1) {
+		go s.reportWorker(ctx)
+	}
+	go s.reportScheduler(ctx)
+}
+
+func (s *Server) reportWorker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-s.jobs.queue:
+			s.runReportJob(ctx, job)
+		}
+	}
+}
+
+func (s *Server) runReportJob(ctx context.Context, job *reportJob) {
+	s.jobs.update(job, func(j *reportJob) {
+		j.Status, j.Stage, j.Started = JobRunning, "started", time.Now()
+	})
+	rep, err := s.buildReport(ctx, job.Request, func(stage string, percent int) {
+		s.jobs.update(job, func(j *reportJob) { j.Stage, j.Progress = stage, percent })
+	})
+	s.jobs.update(job, func(j *reportJob) {
+		j.Finished = time.Now()
+		if err != nil {
+			j.Status, j.Err = JobFailed, err.Error()
+			return
+		}
+		j.Status, j.Stage, j.Progress, j.Report = JobDone, "done", 100, rep
+	})
+	if err != nil {
+		log.Printf("report job %s failed: %v", job.ID, err)
+	}
+}
+
+// reportScheduler checks the schedules at the start of every minute.
+func (s *Server) reportScheduler(ctx context.Context) {
+	for {
+		now := time.Now()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(now.Truncate(time.Minute).Add(time.Minute).Sub(now)):
+		}
+		if err := s.runDueSchedules(ctx, time.Now()); err != nil {
+			log.Printf("report schedules: %v", err)
+		}
+	}
+}
+
+/*
+runDueSchedules queues every schedule whose next run after its last run (or creation) has come.
+Runs missed while the server was down are caught up with a single job.
+*/
+func (s *Server) runDueSchedules(ctx context.Context, now time.Time) error {
+	list, err := s.repo.ListReportSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sc := range list {
+		sched, err := cron.Parse(sc.Cron)
+		if err != nil {
+			log.Printf("report schedule %s: %v", sc.ID, err)
+			continue
+		}
+		next := sched.Next(time.UnixMilli(max(sc.LastRunTs, sc.CreatedTs)))
+		if next.IsZero() || next.After(now) {
+			continue
+		}
+		req, err := s.scheduledRequest(sc.Request)
+		if err != nil {
+			log.Printf("report schedule %s: %v", sc.ID, err)
+			continue
+		}
+		job, err := s.jobs.submit(req, sc.ID)
+		if err != nil {
+			log.Printf("report schedule %s: %v", sc.ID, err)
+			continue
+		}
+		if err := s.repo.MarkReportScheduleRun(ctx, sc.ID, now.UnixMilli(), job.ID); err != nil {
+			log.Printf("report schedule %s: %v", sc.ID, err)
+		}
+	}
+	return nil
+}
+
+// scheduledRequest decodes a stored request; scheduled reports are stored whenever storage is configured.
+func (s *Server) scheduledRequest(raw string) (*pb.GenerateReportRequest, error) {
+	req := &pb.GenerateReportRequest{}
+	if err := protojson.Unmarshal([]byte(raw), req); err != nil {
+		return nil, fmt.Errorf("invalid stored request: %w", err)
+	}
+	req.Store = s.reportDir != ""
+	return req, nil
+}
+
+// validateReportRequest catches request errors before a job is queued.
+func (s *Server) validateReportRequest(req *pb.GenerateReportRequest) error {
+	if _, err := s.newRenderedReport(req); err != nil {
+		return err
+	}
+	_, err := newReportData(req)
+	return err
+}
+
+func (s *Server) SubmitReport(ctx context.Context, req *pb.GenerateReportRequest) (*pb.ReportJob, error) {
+	if err := s.validateReportRequest(req); err != nil {
+		return nil, err
+	}
+	job, err := s.jobs.submit(req, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.GetReportJob(ctx, &pb.ID{Id: job.ID})
+}
+
+func (s *Server) GetReportJob(ctx context.Context, in *pb.ID) (*pb.ReportJob, error) {
+	job, err := s.jobs.get(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return reportJobToProto(job), nil
+}
+
+func (s *Server) ListReportJobs(ctx context.Context, _ *pb.Empty) (*pb.ReportJobsResponse, error) {
+	out := &pb.ReportJobsResponse{}
+	for _, job := range s.jobs.list() {
+		out.Jobs = append(out.Jobs, reportJobToProto(job))
+	}
+	return out, nil
+}
+
+// DownloadReport streams the result of a finished job the same way GenerateReportStream does.
+func (s *Server) DownloadReport(in *pb.ID, stream grpc.ServerStreamingServer[pb.ReportChunk]) error {
+	job, err := s.jobs.get(in.Id)
+	if err != nil {
+		return err
+	}
+	switch job.Status {
+	case JobDone:
+		return sendReportChunks(job.Report, stream)
+	case JobFailed:
+		return fmt.Errorf("report job %s failed: %s", job.ID, job.Err)
+	}
+	return fmt.Errorf("report job %s is %s, progress %d%%", job.ID, strings.ToLower(job.Status), job.Progress)
+}
+
+func reportJobToProto(job reportJob) *pb.ReportJob {
+	out := &pb.ReportJob{
+		Id:         job.ID,
+		Status:     job.Status,
+		Progress:   int32(job.Progress),
+		Stage:      job.Stage,
+		ScheduleId: job.ScheduleID,
+		CreatedTs:  job.Created.UnixMilli(),
+		Error:      job.Err,
+		Format:     strings.ToUpper(job.Request.Format),
+	}
+	if out.Format == "" {
+		out.Format = ReportPDF
+	}
+	if !job.Started.IsZero() {
+		out.StartedTs = job.Started.UnixMilli()
+	}
+	if !job.Finished.IsZero() {
+		out.FinishedTs = job.Finished.UnixMilli()
+	}
+	if job.Report != nil {
+		out.Filename = job.Report.Filename
+		out.Size = int64(len(job.Report.Content))
+	}
+	return out
+}
+
+func (s *Server) CreateReportSchedule(ctx context.Context, in *pb.ReportSchedule) (*pb.ReportSchedule, error) {
+	if in == nil || in.Id == "" {
+		return nil, fmt.Errorf("invalid report schedule")
+	}
+	sched, err := cron.Parse(in.Cron)
+	if err != nil {
+		return nil, err
+	}
+	if sched.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("cron %q never runs", in.Cron)
+	}
+	if in.Request == nil {
+		in.Request = &pb.GenerateReportRequest{}
+	}
+	req := proto.Clone(in.Request).(*pb.GenerateReportRequest)
+	req.Store = s.reportDir != ""
+	if err := s.validateReportRequest(req); err != nil {
+		return nil, err
+	}
+	raw, err := protojson.Marshal(in.Request)
+	if err != nil {
+		return nil, err
+	}
+	sc := repo.ReportSchedule{ID: in.Id, Name: in.Name, Cron: in.Cron, Request: string(raw)}
+	if err := s.repo.CreateReportSchedule(ctx, sc); err != nil {
+		return nil, err
+	}
+	created, err := s.repo.GetReportSchedule(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return reportScheduleToProto(*created)
+}
+
+func (s *Server) ListReportSchedules(ctx context.Context, _ *pb.Empty) (*pb.ReportSchedulesResponse, error) {
+	list, err := s.repo.ListReportSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ReportSchedulesResponse{}
+	for _, sc := range list {
+		p, err := reportScheduleToProto(sc)
+		if err != nil {
+			return nil, err
+		}
+		out.Schedules = append(out.Schedules, p)
+	}
+	return out, nil
+}
+
+func (s *Server) DeleteReportSchedule(ctx context.Context, in *pb.ID) (*pb.Empty, error) {
+	if err := s.repo.DeleteReportSchedule(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func reportScheduleToProto(sc repo.ReportSchedule) (*pb.ReportSchedule, error) {
+	req := &pb.GenerateReportRequest{}
+	if err := protojson.Unmarshal([]byte(sc.Request), req); err != nil {
+		return nil, fmt.Errorf("report schedule %s: invalid stored request: %w", sc.ID, err)
+	}
+	out := &pb.ReportSchedule{
+		Id:        sc.ID,
+		Name:      sc.Name,
+		Cron:      sc.Cron,
+		Request:   req,
+		CreatedTs: sc.CreatedTs,
+		LastRunTs: sc.LastRunTs,
+		LastJobId: sc.LastJobID,
+	}
+	if sched, err := cron.Parse(sc.Cron); err == nil {
+		if next := sched.Next(time.UnixMilli(max(sc.LastRunTs, sc.CreatedTs))); !next.IsZero() {
+			out.NextRunTs = next.UnixMilli()
+		}
+	}
+	return out, nil
+}
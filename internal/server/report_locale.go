@@ -0,0 +1,100 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	LocaleSerbian = "sr-Latn"
+	LocaleEnglish = "en"
+)
+
+// reportLocale translates report strings and formats dates and numbers for one language.
+type reportLocale struct {
+	Tag      string
+	DateTime string
+	Decimal  string
+	Group    string
+	messages map[string]string
+}
+
+var reportLocales = map[string]*reportLocale{
+	LocaleSerbian: {Tag: LocaleSerbian, DateTime: "02.01.2006. 15:04", Decimal: ",", Group: ".", messages: catalogSerbian},
+	LocaleEnglish: {Tag: LocaleEnglish, DateTime: "02 Jan 2006 15:04", Decimal: ".", Group: ",", messages: catalogEnglish},
+}
+
+// reportLocaleFor resolves a language tag; empty means Serbian, regional variants fall back to the language.
+func reportLocaleFor(tag string) (*reportLocale, error) {
+	t := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	switch {
+	case t == "", t == "sr", t == "sr-rs", t == "sr-latn", strings.HasPrefix(t, "sr-latn-"):
+		return reportLocales[LocaleSerbian], nil
+	case t == "en", strings.HasPrefix(t, "en-"):
+		return reportLocales[LocaleEnglish], nil
+	}
+	return nil, fmt.Errorf("unsupported locale %q, expected %s or %s", tag, LocaleSerbian, LocaleEnglish)
+}
+
+// T returns the message for key, formatted with args; missing keys fall back to Serbian, then to the key.
+func (l *reportLocale) T(key string, args ...any) string {
+	msg, ok := l.messages[key]
+	if !ok {
+		msg, ok = catalogSerbian[key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+func (l *reportLocale) Time(t time.Time) string { return t.Format(l.DateTime) }
+
+// Num formats v with the locale's decimal separator and thousands grouping.
+func (l *reportLocale) Num(v float64, decimals int) string {
+	s := strconv.FormatFloat(v, 'f', decimals, 64)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+		if strings.Trim(s, "0.") == "" {
+			sign = ""
+		}
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, c := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(l.Group)
+		}
+		b.WriteRune(c)
+	}
+	if frac != "" {
+		b.WriteString(l.Decimal)
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+func (l *reportLocale) Int(v int) string { return l.Num(float64(v), 0) }
+
+// Signed is Num with an explicit plus sign for positive values.
+func (l *reportLocale) Signed(v float64, decimals int) string {
+	s := l.Num(v, decimals)
+	if v > 0 && strings.Trim(s, "0"+l.Decimal+l.Group) != "" {
+		return "+" + s
+	}
+	return s
+}
+
+func (l *reportLocale) Bool(v bool) string {
+	if v {
+		return l.T("yes")
+	}
+	return l.T("no")
+}
@@ -0,0 +1,593 @@
+package server
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// reportFont is a UTF-8 font embedded into every PDF so that č, ć, đ, š and ž render.
+const reportFont = "DejaVu"
+
+//go:embed fonts/*.ttf
+var reportFonts embed.FS
+
+// newReportPDF creates an A4 document with the embedded report fonts registered.
+func newReportPDF() (*gofpdf.Fpdf, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	for style, file := range map[string]string{
+		"":  "DejaVuSansCondensed.ttf",
+		"B": "DejaVuSansCondensed-Bold.ttf",
+		"I": "DejaVuSansCondensed-Oblique.ttf",
+	} {
+		b, err := reportFonts.ReadFile("fonts/" + file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load report font: %w", err)
+		}
+		pdf.AddUTF8FontFromBytes(reportFont, style, b)
+	}
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("failed to load report font: %w", err)
+	}
+	return pdf, nil
+}
+
+func renderPDF(d *reportData) ([]byte, error) {
+	l := d.loc
+	pdf, err := newReportPDF()
+	if err != nil {
+		return nil, err
+	}
+	pdf.SetTitle(l.T("doc.title"), true)
+	pdf.AddPage()
+
+	pdf.SetFont(reportFont, "B", 18)
+	pdf.Cell(0, 10, l.T("report.title"))
+	pdf.Ln(12)
+
+	pdf.SetFont(reportFont, "", 12)
+	pdf.Cell(0, 8, l.T("report.generated", l.Time(d.GeneratedAt)))
+	pdf.Ln(10)
+
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetLineWidth(0.5)
+	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
+	pdf.Ln(8)
+
+	for _, sec := range d.Sections {
+		if msg, ok := d.Notices[sec]; ok {
+			addNotice(pdf, l.T("section."+sec), msg)
+			continue
+		}
+		switch sec {
+		case SectionVehicles:
+			addVehiclesSection(pdf, l, d.VehiclesByDepot)
+		case SectionStops:
+			addStopsSection(pdf, l, d.StopsByZone)
+		case SectionLinePatterns:
+			addLinePatternsSection(pdf, l, d.LinePatterns)
+		case SectionFleet:
+			addFleetSection(pdf, l, d.Fleet, d.At)
+		case SectionTopPairs:
+			addTopPairsSection(pdf, l, d.TopPairs)
+		case SectionDepots:
+			addDepotsIdleStats(pdf, l, d.DepotStats)
+		case SectionCentrality:
+			addTopConnectedStopsChart(pdf, l, d.TopStops, d.CentralityMetric)
+		case SectionCritical:
+			addCriticalElementsSection(pdf, l, d.Critical)
+		case SectionConnectivity:
+			addConnectivitySection(pdf, l, d.Connectivity)
+		case SectionRidership:
+			addRidershipSection(pdf, l, d.Ridership)
+		case SectionDemand:
+			addDemandSection(pdf, l, d.Assignment)
+		case SectionNetworkMap:
+			addNetworkMapPage(pdf, l, d.Map)
+		case SectionShortestPath:
+			addShortestPath(pdf, l, d.ShortestPath.Stops, d.StartID, d.EndID)
+		case SectionTimetable:
+			addTimetablePage(pdf, l, d.Timetable)
+		case SectionScenario:
+			addScenarioPage(pdf, l, d.Scenario, d.ScenarioEval)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// addNotice stands in for a section that has nothing to show.
+func addNotice(pdf *gofpdf.Fpdf, title, msg string) {
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, title)
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "I", 11)
+	pdf.MultiCell(0, 6, msg, "", "L", false)
+	pdf.Ln(6)
+}
+
+func addVehiclesSection(pdf *gofpdf.Fpdf, l *reportLocale, byDepot map[string][]Vehicle) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 16, l.T("vehicles.heading"))
+	pdf.Ln(10)
+	for _, depot := range sortedKeys(byDepot) {
+		pdf.SetFont(reportFont, "B", 14)
+		pdf.Cell(0, 8, l.T("vehicles.depot", depot))
+		pdf.Ln(10)
+		addVehiclesTable(pdf, l, byDepot[depot])
+	}
+}
+
+func addStopsSection(pdf *gofpdf.Fpdf, l *reportLocale, byZone map[string][]Stop) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 16, l.T("stops.heading"))
+	pdf.Ln(10)
+	for _, zone := range sortedKeys(byZone) {
+		pdf.SetFont(reportFont, "B", 14)
+		pdf.Cell(0, 8, l.T("stops.zone", zone))
+		pdf.Ln(10)
+		addStopsTable(pdf, l, byZone[zone])
+	}
+}
+
+func addVehiclesTable(pdf *gofpdf.Fpdf, l *reportLocale, vehicles []Vehicle) {
+	pdf.SetFont(reportFont, "", 12)
+	for _, v := range vehicles {
+		pdf.Cell(0, 6, l.T("vehicles.row", v.UUID, v.Status, l.Int(v.Capacity)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addStopsTable(pdf *gofpdf.Fpdf, l *reportLocale, stops []Stop) {
+	pdf.SetFont(reportFont, "", 12)
+	for _, s := range stops {
+		pdf.Cell(0, 6, l.T("stops.row", s.ID, s.Name, l.Bool(s.Shelter)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addLinePatternsSection(pdf *gofpdf.Fpdf, l *reportLocale, patterns []LinePattern) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 16, l.T("patterns.heading"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 12)
+	for _, p := range patterns {
+		window := l.T("patterns.all_day")
+		if p.StartTime != "" && p.EndTime != "" {
+			window = fmt.Sprintf("%s-%s", p.StartTime, p.EndTime)
+		}
+		pdf.Cell(0, 6, l.T("patterns.row", p.LineID, p.Direction, p.Name, l.Int(p.Stops), window))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addFleetSection(pdf *gofpdf.Fpdf, l *reportLocale, fleet []FleetRow, at time.Time) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 16, l.T("fleet.heading", l.Time(at)))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 12)
+	for _, f := range fleet {
+		interval := l.T("fleet.no_service")
+		if f.FrequencyMins > 0 {
+			interval = l.T("fleet.interval", l.Int(int(f.FrequencyMins)))
+		}
+		pdf.Cell(0, 6, l.T("fleet.row", f.LineID, interval, l.Int(f.CycleSeconds/60), l.Int(f.Required), l.Int(f.AssignedCount)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addTopPairsSection(pdf *gofpdf.Fpdf, l *reportLocale, resp *pb.TopPairsResponse) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 10, l.T("top_pairs.heading", l.Int(len(resp.Pairs))))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 12)
+
+	for _, pair := range resp.Pairs {
+		pdf.Cell(0, 8, l.T("top_pairs.row", pair.From, pair.To, l.Int(int(pair.Lines))))
+		pdf.Ln(8)
+	}
+	pdf.Ln(10)
+}
+
+func addDepotsIdleStats(pdf *gofpdf.Fpdf, l *reportLocale, resp *pb.DepotsResponse) {
+	pdf.SetFont(reportFont, "B", 16)
+	pdf.Cell(0, 10, l.T("depots.heading"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 12)
+
+	for _, stat := range resp.Stats {
+		pdf.Cell(0, 8, l.T("depots.row", stat.DepotName, l.Int(int(stat.ParkedCount)), l.Num(stat.AvgIdleMs, 2)))
+		pdf.Ln(8)
+	}
+	pdf.Ln(10)
+}
+
+func addTopConnectedStopsChart(pdf *gofpdf.Fpdf, l *reportLocale, stops []repo.StopCentrality, metric string) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("centrality.heading", metric))
+	pdf.Ln(12)
+
+	if len(stops) == 0 {
+		pdf.SetFont(reportFont, "", 12)
+		pdf.Cell(0, 6, l.T("centrality.none"))
+		pdf.Ln(8)
+		return
+	}
+
+	labels := make([]string, 0, len(stops))
+	vals := make([]float64, 0, len(stops))
+	notes := make([]string, 0, len(stops))
+	for _, s := range stops {
+		name := s.StopID
+		if s.Name != "" {
+			name = fmt.Sprintf("%s (%s)", name, s.Name)
+		}
+		labels = append(labels, name)
+		v := s.Value(metric)
+		vals = append(vals, v)
+		notes = append(notes, l.T("centrality.note", l.Num(v, 4), l.Int(s.Degree), l.Int(s.Lines)))
+	}
+	addBarChart(pdf, labels, vals, notes)
+}
+
+// addBarChart draws one horizontal bar per label, scaled to the largest value, with a note right of it.
+func addBarChart(pdf *gofpdf.Fpdf, labels []string, vals []float64, notes []string) {
+	maxv := float64(0)
+	for _, v := range vals {
+		if v > maxv {
+			maxv = v
+		}
+	}
+
+	left := 30.0
+	top := pdf.GetY() + 10.0
+	chartW := 120.0
+	for i := range labels {
+		y := top + float64(i)*(barHeight+barGap)
+		pdf.SetXY(left, y)
+		w := 0.0
+		if maxv > 0 {
+			w = (vals[i] / maxv) * chartW
+		}
+		pdf.SetFillColor(70, 130, 180)
+		pdf.Rect(left, y, w, barHeight, "F")
+		pdf.SetXY(10, y)
+		pdf.SetFont(reportFont, "", 9)
+		pdf.CellFormat(0, barHeight, labels[i], "", 0, "", false, 0, "")
+		pdf.SetXY(left+chartW+5, y)
+		pdf.CellFormat(45, barHeight, notes[i], "", 0, "L", false, 0, "")
+	}
+	pdf.SetXY(10, top+float64(len(labels))*(barHeight+barGap))
+	pdf.Ln(4)
+}
+
+const (
+	barHeight = 8.0
+	barGap    = 6.0
+)
+
+// barChartHeight is the vertical space addBarChart uses, including the gap above the bars.
+func barChartHeight(bars int) float64 { return float64(bars)*(barHeight+barGap) + 10 }
+
+// ensureSpace starts a new page when less than h mm are left above the bottom margin.
+func ensureSpace(pdf *gofpdf.Fpdf, h float64) {
+	_, pageH := pdf.GetPageSize()
+	_, _, _, bottom := pdf.GetMargins()
+	if pdf.GetY()+h > pageH-bottom {
+		pdf.AddPage()
+	}
+}
+
+// addNetworkMapPage draws the geographic network map on a page of its own.
+func addNetworkMapPage(pdf *gofpdf.Fpdf, l *reportLocale, m *networkMap) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("section.network_map"))
+	pdf.Ln(10)
+	top := pdf.GetY()
+	m.draw(pdfCanvas{pdf}, l, 10, top, 190, 260-top)
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetLineWidth(0.2)
+	pdf.SetFont(reportFont, "", 12)
+	pdf.SetXY(10, 262)
+}
+
+func addShortestPath(pdf *gofpdf.Fpdf, l *reportLocale, path []string, start, stop string) {
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("path.heading", start, stop))
+	pdf.Ln(12)
+
+	if len(path) == 0 {
+		pdf.SetFont(reportFont, "", 12)
+		pdf.Cell(0, 6, l.T("path.none"))
+		pdf.Ln(6)
+		return
+	}
+
+	startX := 30.0
+	y := pdf.GetY() + 20.0
+	step := 30.0
+	r := 6.0
+	pdf.SetLineWidth(0.7)
+	for i, node := range path {
+		x := startX + float64(i)*step
+		pdf.Circle(x, y, r, "D")
+		pdf.SetFont(reportFont, "", 8)
+		pdf.SetXY(x-8, y-3)
+		pdf.CellFormat(16, 6, node, "", 0, "C", false, 0, "")
+		if i < len(path)-1 {
+			x2 := startX + float64(i+1)*step
+			pdf.Line(x+r, y, x2-r, y)
+		}
+	}
+	pdf.Ln(40)
+}
+
+func addTimetablePage(pdf *gofpdf.Fpdf, l *reportLocale, tt *repo.Timetable) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("timetable.heading", tt.LineID, tt.Direction, tt.Pattern, tt.Date, tt.DayType))
+	pdf.Ln(12)
+
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("timetable.offsets"))
+	pdf.Ln(8)
+	pdf.SetFont(reportFont, "", 10)
+	for _, st := range tt.Stops {
+		pdf.Cell(0, 5, fmt.Sprintf("%2d. %-6s %s  +%d min", st.Order, st.StopID, st.Name, (st.Offset+30)/60))
+		pdf.Ln(5)
+	}
+	pdf.Ln(6)
+
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("timetable.departures"))
+	pdf.Ln(8)
+	if len(tt.Departures) == 0 {
+		pdf.SetFont(reportFont, "", 10)
+		pdf.Cell(0, 5, l.T("timetable.no_service"))
+		pdf.Ln(5)
+		return
+	}
+	var hours []int
+	byHour := map[int][]string{}
+	for _, dep := range tt.Departures {
+		h := dep / 60
+		if _, ok := byHour[h]; !ok {
+			hours = append(hours, h)
+		}
+		byHour[h] = append(byHour[h], fmt.Sprintf("%02d", dep%60))
+	}
+	for _, h := range hours {
+		pdf.SetFont(reportFont, "B", 10)
+		pdf.CellFormat(12, 6, fmt.Sprintf("%02d", h), "1", 0, "C", false, 0, "")
+		pdf.SetFont(reportFont, "", 10)
+		pdf.CellFormat(0, 6, " "+strings.Join(byHour[h], "  "), "1", 1, "L", false, 0, "")
+	}
+}
+
+func addScenarioPage(pdf *gofpdf.Fpdf, l *reportLocale, sc *repo.Scenario, ev *repo.ScenarioEvaluation) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("scenario.heading", sc.Name, sc.Status))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 11)
+	if sc.Description != "" {
+		pdf.MultiCell(0, 5, sc.Description, "", "L", false)
+		pdf.Ln(2)
+	}
+	d := ev.Diff
+	lines := []string{
+		l.T("scenario.changes", l.Int(len(sc.Changes))),
+		l.T("scenario.stops_added", joinOrDash(d.StopsAdded)),
+		l.T("scenario.stops_removed", joinOrDash(d.StopsRemoved)),
+		l.T("scenario.edges", l.Int(len(d.EdgesAdded)), l.Int(len(d.EdgesRemoved))),
+		l.T("scenario.routes", joinOrDash(d.RoutesChanged)),
+		l.T("scenario.vehicles", l.Int(ev.Baseline.RequiredVehicles), l.Int(ev.Scenario.RequiredVehicles), l.Signed(float64(d.RequiredVehiclesDelta), 0)),
+	}
+	if ev.Baseline.PathFound || ev.Scenario.PathFound {
+		lines = append(lines, l.T("scenario.path",
+			joinOrDash(ev.Baseline.Path), l.Int(int(ev.Baseline.TravelTime)), joinOrDash(ev.Scenario.Path), l.Int(int(ev.Scenario.TravelTime))))
+	}
+	for _, line := range lines {
+		pdf.Cell(0, 6, line)
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("scenario.lines"))
+	pdf.Ln(8)
+	pdf.SetFont(reportFont, "", 11)
+	if len(d.Fleet) == 0 {
+		pdf.Cell(0, 6, l.T("scenario.no_fleet_changes"))
+		pdf.Ln(6)
+	}
+	for _, f := range d.Fleet {
+		pdf.Cell(0, 6, l.T("scenario.fleet_row", f.LineID, l.Int(int(f.FrequencyBefore)), l.Int(int(f.FrequencyAfter)),
+			l.Int(f.CycleBefore/60), l.Int(f.CycleAfter/60), l.Int(f.RequiredBefore), l.Int(f.RequiredAfter)))
+		pdf.Ln(6)
+	}
+	if len(ev.Scenario.Warnings) > 0 {
+		pdf.Ln(4)
+		pdf.SetFont(reportFont, "B", 12)
+		pdf.Cell(0, 6, l.T("scenario.warnings"))
+		pdf.Ln(8)
+		pdf.SetFont(reportFont, "", 10)
+		for _, w := range ev.Scenario.Warnings {
+			pdf.Cell(0, 5, w)
+			pdf.Ln(5)
+		}
+	}
+}
+
+func joinOrDash(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	return strings.Join(items, ", ")
+}
+
+func addCriticalElementsSection(pdf *gofpdf.Fpdf, l *reportLocale, res *repo.Resilience) {
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("section.critical_elements"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 11)
+	pdf.Cell(0, 6, l.T("critical.articulation", joinOrDash(res.ArticulationPoints)))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, l.T("critical.summary", l.Int(len(res.Bridges)), l.Int(res.SampledPairs), l.Num(res.AvgTravelTime, 0)))
+	pdf.Ln(8)
+	for _, c := range res.Ranked {
+		name := c.StopID
+		if c.Kind == repo.DisruptionEdge {
+			name = c.From + " - " + c.To
+		}
+		pdf.Cell(0, 6, l.T("critical.row", c.Kind, name, l.Int(c.DisconnectedPairs), l.Int(c.Pairs), l.Signed(c.Increase(), 0)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addConnectivitySection(pdf *gofpdf.Fpdf, l *reportLocale, res *repo.Connectivity) {
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("section.connectivity"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 11)
+	pdf.Cell(0, 6, l.T("connectivity.summary", l.Int(len(res.Strong)), l.Int(len(res.Weak))))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, l.T("connectivity.isolated", joinOrDash(res.Isolated)))
+	pdf.Ln(6)
+	if len(res.Strong) > 1 {
+		var unreachable []string
+		for _, c := range res.Strong[1:] {
+			unreachable = append(unreachable, c...)
+		}
+		sort.Strings(unreachable)
+		pdf.MultiCell(0, 6, l.T("connectivity.outside", l.Int(len(unreachable)), joinOrDash(unreachable)), "", "L", false)
+	}
+	for _, c := range res.Candidates {
+		pdf.Cell(0, 6, l.T("connectivity.candidate", c.From, c.To, l.Num(c.Distance, 0), l.Int(c.FromComponent), l.Int(c.ToComponent)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func addDemandSection(pdf *gofpdf.Fpdf, l *reportLocale, a *repo.Assignment) {
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("section.demand"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 11)
+	pdf.Cell(0, 6, l.T("demand.summary", l.Int(a.Pairs), l.Num(a.AssignedTrips, 0), l.Num(a.UnassignedTrips, 0), l.Int(a.UnassignedPairs)))
+	pdf.Ln(8)
+
+	pdf.SetFont(reportFont, "B", 11)
+	pdf.Cell(0, 6, l.T("demand.lines"))
+	pdf.Ln(6)
+	pdf.SetFont(reportFont, "", 11)
+	for _, line := range a.Lines {
+		pdf.Cell(0, 6, l.T("demand.line_row", line.LineID, l.Num(line.Capacity, 0), l.Num(line.PeakLoad, 0),
+			dashIfEmpty(line.PeakFrom), dashIfEmpty(line.PeakTo), l.Num(100*repo.Utilization(line.PeakLoad, line.Capacity), 0)))
+		pdf.Ln(6)
+	}
+	pdf.Ln(2)
+
+	pdf.SetFont(reportFont, "B", 11)
+	pdf.Cell(0, 6, l.T("demand.overloaded"))
+	pdf.Ln(6)
+	pdf.SetFont(reportFont, "", 11)
+	shown := 0
+	for _, e := range a.Edges {
+		if !repo.Overloaded(e.Load, e.Capacity) {
+			continue
+		}
+		shown++
+		pdf.SetTextColor(200, 0, 0)
+		pdf.Cell(0, 6, l.T("demand.edge_row", e.From, e.To, joinOrDash(e.Lines), l.Num(e.Load, 0), l.Num(e.Capacity, 0)))
+		pdf.Ln(6)
+	}
+	pdf.SetTextColor(0, 0, 0)
+	if shown == 0 {
+		pdf.Cell(0, 6, l.T("demand.none_overloaded"))
+		pdf.Ln(6)
+	}
+	pdf.Ln(4)
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func addRidershipSection(pdf *gofpdf.Fpdf, l *reportLocale, r *repo.Ridership) {
+	pdf.AddPage()
+	pdf.SetFont(reportFont, "B", 14)
+	pdf.Cell(0, 8, l.T("section.ridership"))
+	pdf.Ln(10)
+	pdf.SetFont(reportFont, "", 11)
+	pdf.Cell(0, 6, l.T("ridership.summary", l.Num(r.BoardingsPerHour, 0), l.Num(r.Capacity, 0), l.Num(r.PerCapacityHour(), 2)))
+	pdf.Ln(10)
+
+	ensureSpace(pdf, barChartHeight(len(r.Stops))+10)
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("ridership.stops"))
+	pdf.Ln(2)
+	var labels, notes []string
+	var vals []float64
+	for _, st := range r.Stops {
+		labels = append(labels, st.StopID)
+		vals = append(vals, st.BoardingsPerHour)
+		notes = append(notes, l.T("ridership.stop_note", l.Num(st.BoardingsPerHour, 0), l.Int(len(st.Lines))))
+	}
+	addBarChart(pdf, labels, vals, notes)
+
+	ensureSpace(pdf, barChartHeight(len(r.Lines))+10)
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("ridership.lines"))
+	pdf.Ln(2)
+	labels, vals, notes = nil, nil, nil
+	for _, line := range r.Lines {
+		labels = append(labels, line.LineID)
+		vals = append(vals, line.BoardingsPerHour)
+		notes = append(notes, l.T("ridership.line_note", l.Num(line.BoardingsPerHour, 0), l.Num(line.PerCapacityHour(), 2)))
+	}
+	addBarChart(pdf, labels, vals, notes)
+
+	ensureSpace(pdf, barChartHeight(len(r.Zones))+10)
+	pdf.SetFont(reportFont, "B", 12)
+	pdf.Cell(0, 6, l.T("ridership.zones"))
+	pdf.Ln(2)
+	labels, vals, notes = nil, nil, nil
+	for _, z := range r.Zones {
+		labels = append(labels, l.T("ridership.zone", z.Zone))
+		vals = append(vals, z.BoardingsPerHour)
+		notes = append(notes, l.T("ridership.zone_note", l.Num(z.BoardingsPerHour, 0), l.Int(z.Stops)))
+	}
+	addBarChart(pdf, labels, vals, notes)
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+const (
+	SectionVehicles     = "vehicles"
+	SectionStops        = "stops"
+	SectionLinePatterns = "line_patterns"
+	SectionFleet        = "fleet"
+	SectionTopPairs     = "top_pairs"
+	SectionDepots       = "depots"
+	SectionCentrality   = "centrality"
+	SectionCritical     = "critical_elements"
+	SectionConnectivity = "connectivity"
+	SectionRidership    = "ridership"
+	SectionDemand       = "demand"
+	SectionNetworkMap   = "network_map"
+	SectionShortestPath = "shortest_path"
+	SectionTimetable    = "timetable"
+	SectionScenario     = "scenario"
+)
+
+// reportSection is one part of the report; Limit is the default number of rows, 0 = all.
+// Titles live in the catalogue under "section.<name>".
+type reportSection struct {
+	Name  string
+	Limit int
+}
+
+// reportSections lists every section in the order it is rendered.
+var reportSections = []reportSection{
+	{SectionVehicles, 0},
+	{SectionStops, 0},
+	{SectionLinePatterns, 0},
+	{SectionFleet, 0},
+	{SectionTopPairs, 5},
+	{SectionDepots, 5},
+	{SectionCentrality, 10},
+	{SectionCritical, 5},
+	{SectionConnectivity, 5},
+	{SectionRidership, 8},
+	{SectionDemand, 10},
+	{SectionNetworkMap, 0},
+	{SectionShortestPath, 0},
+	{SectionTimetable, 0},
+	{SectionScenario, 0},
+}
+
+func validSection(name string) bool {
+	return slices.ContainsFunc(reportSections, func(sec reportSection) bool { return sec.Name == name })
+}
+
+// reportFilter restricts the rows of sections that carry a zone, depot or line; empty lists match everything.
+type reportFilter struct {
+	Zones  []string `json:"zones,omitempty"`
+	Depots []string `json:"depots,omitempty"`
+	Lines  []string `json:"lines,omitempty"`
+}
+
+func (f reportFilter) empty() bool {
+	return len(f.Zones) == 0 && len(f.Depots) == 0 && len(f.Lines) == 0
+}
+
+func (f reportFilter) zone(z string) bool  { return len(f.Zones) == 0 || slices.Contains(f.Zones, z) }
+func (f reportFilter) depot(d string) bool { return len(f.Depots) == 0 || slices.Contains(f.Depots, d) }
+func (f reportFilter) line(l string) bool  { return len(f.Lines) == 0 || slices.Contains(f.Lines, l) }
+
+// newReportData validates the locale, section selection, limits and filters of the request.
+func newReportData(req *pb.GenerateReportRequest) (*reportData, error) {
+	loc, err := reportLocaleFor(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	d := &reportData{
+		Locale:           loc.Tag,
+		loc:              loc,
+		GeneratedAt:      time.Now(),
+		StartID:          req.StartId,
+		EndID:            req.EndId,
+		CentralityMetric: req.CentralityMetric,
+		Limits:           map[string]int{},
+		Notices:          map[string]string{},
+		Filter:           reportFilter{Zones: req.Zones, Depots: req.Depots, Lines: req.Lines},
+	}
+	if d.CentralityMetric == "" {
+		d.CentralityMetric = repo.MetricBetweenness
+	}
+	if !repo.ValidCentralityMetric(d.CentralityMetric) {
+		return nil, fmt.Errorf("invalid centrality metric %q", req.CentralityMetric)
+	}
+
+	selected := map[string]bool{}
+	for _, name := range req.Sections {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !validSection(name) {
+			return nil, fmt.Errorf("unknown report section %q", name)
+		}
+		selected[name] = true
+	}
+	for _, sec := range reportSections {
+		if len(selected) == 0 && sec.Name == SectionScenario && req.ScenarioId == "" {
+			continue
+		}
+		if len(selected) == 0 || selected[sec.Name] {
+			d.Sections = append(d.Sections, sec.Name)
+			d.Limits[sec.Name] = sec.Limit
+		}
+	}
+
+	for name, limit := range req.Limits {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !validSection(name) {
+			return nil, fmt.Errorf("unknown report section %q in limits", name)
+		}
+		if limit <= 0 {
+			return nil, fmt.Errorf("limit of section %s must be positive", name)
+		}
+		if _, ok := d.Limits[name]; ok {
+			d.Limits[name] = int(limit)
+		}
+	}
+	return d, nil
+}
+
+func (d *reportData) includes(section string) bool {
+	return slices.Contains(d.Sections, section)
+}
+
+// enter is includes for the block that collects the section; it also reports progress.
+func (d *reportData) enter(section string) bool {
+	if !d.includes(section) {
+		return false
+	}
+	if d.progress != nil {
+		d.progress(section, 80*d.collected/len(d.Sections))
+	}
+	d.collected++
+	return true
+}
+
+// noData replaces a section with a notice; err, when set, is logged and shown as the reason.
+func (d *reportData) noData(section string, err error) {
+	if err == nil {
+		d.Notices[section] = d.loc.T("no_data")
+		return
+	}
+	log.Printf("report section %s: %v", section, err)
+	d.Notices[section] = d.loc.T("no_data.reason", err.Error())
+}
+
+func limitRows[T any](rows []T, limit int) []T {
+	if limit > 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+func (d *reportData) filterRidership() {
+	r, f, limit := d.Ridership, d.Filter, d.Limits[SectionRidership]
+	var stops []repo.StopRidership
+	for _, st := range r.Stops {
+		if f.zone(st.Zone) && (len(f.Lines) == 0 || slices.ContainsFunc(st.Lines, f.line)) {
+			stops = append(stops, st)
+		}
+	}
+	var lines []repo.LineRidership
+	for _, l := range r.Lines {
+		if f.line(l.LineID) {
+			lines = append(lines, l)
+		}
+	}
+	var zones []repo.ZoneRidership
+	for _, z := range r.Zones {
+		if f.zone(z.Zone) {
+			zones = append(zones, z)
+		}
+	}
+	r.Stops, r.Lines, r.Zones = limitRows(stops, limit), limitRows(lines, limit), zones
+}
+
+// filterAssignment keeps the selected lines and the most loaded edges they serve.
+func (d *reportData) filterAssignment() {
+	a, f := d.Assignment, d.Filter
+	var lines []repo.LineLoad
+	for _, l := range a.Lines {
+		if f.line(l.LineID) {
+			lines = append(lines, l)
+		}
+	}
+	var edges []repo.EdgeLoad
+	for _, e := range a.Edges {
+		if len(f.Lines) == 0 || slices.ContainsFunc(e.Lines, f.line) {
+			edges = append(edges, e)
+		}
+	}
+	a.Lines, a.Edges = lines, limitRows(edges, d.Limits[SectionDemand])
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) CriticalElements(ctx context.Context, req *pb.CriticalElementsRequest) (*pb.CriticalElementsResponse, error) {
+	res, err := s.repo.CriticalElements(ctx, int(req.SamplePairs), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.CriticalElementsResponse{
+		ArticulationPoints: res.ArticulationPoints,
+		SampledPairs:       int32(res.SampledPairs),
+		AvgTravelTime:      res.AvgTravelTime,
+	}
+	for _, b := range res.Bridges {
+		out.Bridges = append(out.Bridges, &pb.NextEdge{FromId: b[0], ToId: b[1]})
+	}
+	for _, c := range res.Ranked {
+		out.Ranked = append(out.Ranked, criticalElementToProto(c))
+	}
+	return out, nil
+}
+
+func criticalElementToProto(c repo.CriticalElement) *pb.CriticalElement {
+	return &pb.CriticalElement{
+		Kind:                c.Kind,
+		StopId:              c.StopID,
+		FromId:              c.From,
+		ToId:                c.To,
+		Cut:                 c.Cut,
+		Degree:              int32(c.Degree),
+		Pairs:               int32(c.Pairs),
+		DisconnectedPairs:   int32(c.DisconnectedPairs),
+		AvgTravelTimeBefore: c.AvgBefore,
+		AvgTravelTimeAfter:  c.AvgAfter,
+		AvgIncrease:         c.Increase(),
+	}
+}
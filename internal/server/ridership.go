@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) RidershipStats(ctx context.Context, req *pb.RidershipRequest) (*pb.RidershipResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	r, err := s.repo.RidershipStats(ctx, timeOrNow(req.At), limit)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.RidershipResponse{
+		At:                       r.At.Unix(),
+		BoardingsPerHour:         r.BoardingsPerHour,
+		Capacity:                 r.Capacity,
+		BoardingsPerCapacityHour: r.PerCapacityHour(),
+	}
+	for _, st := range r.Stops {
+		out.BusiestStops = append(out.BusiestStops, &pb.StopRidership{
+			StopId:           st.StopID,
+			Name:             st.Name,
+			Zone:             st.Zone,
+			LineIds:          st.Lines,
+			Boardings:        st.Boardings,
+			BoardingsPerHour: st.BoardingsPerHour,
+		})
+	}
+	for _, l := range r.Lines {
+		out.BusiestLines = append(out.BusiestLines, &pb.LineRidership{
+			LineId:                   l.LineID,
+			Name:                     l.Name,
+			FrequencyMins:            l.FrequencyMins,
+			Boardings:                l.Boardings,
+			BoardingsPerHour:         l.BoardingsPerHour,
+			VehicleCapacity:          l.VehicleCapacity,
+			Capacity:                 l.Capacity,
+			BoardingsPerCapacityHour: l.PerCapacityHour(),
+		})
+	}
+	for _, z := range r.Zones {
+		out.Zones = append(out.Zones, &pb.ZoneRidership{
+			Zone:             z.Zone,
+			Stops:            int32(z.Stops),
+			Boardings:        z.Boardings,
+			BoardingsPerHour: z.BoardingsPerHour,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) SetLineRoute(ctx context.Context, req *pb.SetLineRouteRequest) (*pb.LineRouteResponse, error) {
+	if req == nil || req.LineId == "" {
+		return nil, fmt.Errorf("line_id required")
+	}
+	route := make([]repo.RouteStop, 0, len(req.Stops))
+	for _, st := range req.Stops {
+		route = append(route, repo.RouteStop{StopID: st.StopId, TravelTime: st.TravelTime, Distance: st.Distance})
+	}
+	change, err := s.repo.SetLineRoute(ctx, req.LineId, req.Direction, req.Pattern, route)
+	if err != nil {
+		return nil, err
+	}
+	return routeResponse(req.LineId, req.Direction, req.Pattern, change), nil
+}
+
+func (s *Server) InsertStopIntoLine(ctx context.Context, req *pb.InsertStopRequest) (*pb.LineRouteResponse, error) {
+	if req == nil || req.LineId == "" || req.StopId == "" {
+		return nil, fmt.Errorf("line_id and stop_id required")
+	}
+	in := repo.RouteStop{TravelTime: req.TravelTimeIn, Distance: req.DistanceIn}
+	out := repo.RouteStop{TravelTime: req.TravelTimeOut, Distance: req.DistanceOut}
+	change, err := s.repo.InsertStopIntoLine(ctx, req.LineId, req.Direction, req.Pattern, req.StopId, int(req.Order), in, out)
+	if err != nil {
+		return nil, err
+	}
+	return routeResponse(req.LineId, req.Direction, req.Pattern, change), nil
+}
+
+func (s *Server) RemoveStopFromLine(ctx context.Context, req *pb.RemoveStopRequest) (*pb.LineRouteResponse, error) {
+	if req == nil || req.LineId == "" || req.StopId == "" {
+		return nil, fmt.Errorf("line_id and stop_id required")
+	}
+	bridge := repo.RouteStop{TravelTime: req.TravelTime, Distance: req.Distance}
+	change, err := s.repo.RemoveStopFromLine(ctx, req.LineId, req.Direction, req.Pattern, req.StopId, bridge)
+	if err != nil {
+		return nil, err
+	}
+	return routeResponse(req.LineId, req.Direction, req.Pattern, change), nil
+}
+
+func routeResponse(lineId, dir, pat string, change *repo.RouteChange) *pb.LineRouteResponse {
+	if dir == "" {
+		dir = repo.DirectionOutbound
+	}
+	if pat == "" {
+		pat = repo.PatternMain
+	}
+	out := &pb.LineRouteResponse{LineId: lineId, Direction: dir, Pattern: pat}
+	for i, id := range change.Stops {
+		out.Stops = append(out.Stops, &pb.ServesEdge{LineId: lineId, StopId: id, Order: int32(i + 1), Direction: dir, Pattern: pat})
+	}
+	for _, e := range change.Created {
+		out.CreatedEdges = append(out.CreatedEdges, &pb.NextEdge{FromId: e.From, ToId: e.To, TravelTime: e.TravelTime, Distance: e.Distance})
+	}
+	for _, e := range change.Removed {
+		out.RemovedEdges = append(out.RemovedEdges, &pb.NextEdge{FromId: e.From, ToId: e.To, TravelTime: e.TravelTime, Distance: e.Distance})
+	}
+	return out
+}
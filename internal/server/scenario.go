@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+const defaultScenarioLimit = 5
+
+func (s *Server) CreateScenario(ctx context.Context, in *pb.Scenario) (*pb.Scenario, error) {
+	if in == nil || in.Id == "" {
+		return nil, fmt.Errorf("invalid scenario")
+	}
+	sc, err := scenarioFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateScenario(ctx, *sc); err != nil {
+		return nil, err
+	}
+	return s.GetScenario(ctx, &pb.ID{Id: in.Id})
+}
+
+func (s *Server) GetScenario(ctx context.Context, in *pb.ID) (*pb.Scenario, error) {
+	sc, err := s.repo.GetScenario(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return scenarioToProto(*sc), nil
+}
+
+func (s *Server) ListScenarios(ctx context.Context, _ *pb.Empty) (*pb.ScenariosResponse, error) {
+	list, err := s.repo.ListScenarios(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ScenariosResponse{}
+	for _, sc := range list {
+		out.Scenarios = append(out.Scenarios, scenarioToProto(sc))
+	}
+	return out, nil
+}
+
+func (s *Server) UpdateScenario(ctx context.Context, in *pb.Scenario) (*pb.Scenario, error) {
+	if in == nil || in.Id == "" {
+		return nil, fmt.Errorf("invalid scenario")
+	}
+	sc, err := scenarioFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateScenario(ctx, *sc); err != nil {
+		return nil, err
+	}
+	return s.GetScenario(ctx, &pb.ID{Id: in.Id})
+}
+
+func (s *Server) DeleteScenario(ctx context.Context, in *pb.ID) (*pb.Empty, error) {
+	if err := s.repo.DeleteScenario(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *Server) EvaluateScenario(ctx context.Context, req *pb.EvaluateScenarioRequest) (*pb.ScenarioEvaluation, error) {
+	if req == nil || req.ScenarioId == "" {
+		return nil, fmt.Errorf("scenario_id required")
+	}
+	_, ev, err := s.evaluateScenario(ctx, req.ScenarioId, req.StartId, req.EndId, int(req.Limit), req.At)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ScenarioEvaluation{
+		ScenarioId: req.ScenarioId,
+		Baseline:   metricsToProto(ev.Baseline),
+		Scenario:   metricsToProto(ev.Scenario),
+		Diff:       diffToProto(ev.Diff),
+	}, nil
+}
+
+func (s *Server) PromoteScenario(ctx context.Context, in *pb.ID) (*pb.Scenario, error) {
+	if err := s.repo.PromoteScenario(ctx, in.Id); err != nil {
+		return nil, err
+	}
+	return s.GetScenario(ctx, in)
+}
+
+func (s *Server) evaluateScenario(ctx context.Context, id, start, end string, limit int, at int64) (*repo.Scenario, *repo.ScenarioEvaluation, error) {
+	if limit <= 0 {
+		limit = defaultScenarioLimit
+	}
+	return s.repo.EvaluateScenario(ctx, id, repo.ScenarioQuery{StartID: start, EndID: end, Limit: limit, At: timeOrNow(at)})
+}
+
+func scenarioFromProto(in *pb.Scenario) (*repo.Scenario, error) {
+	sc := &repo.Scenario{ID: in.Id, Name: in.Name, Description: in.Description}
+	for i, c := range in.Changes {
+		ch := repo.ScenarioChange{Kind: c.Kind, StopID: c.StopId}
+		switch c.Kind {
+		case repo.ChangeAddStop:
+			if c.Stop == nil || c.Stop.Id == "" {
+				return nil, fmt.Errorf("change %d: stop with id required", i+1)
+			}
+			ch.Stop = repo.NetStop{ID: c.Stop.Id, Name: c.Stop.Name, Zone: c.Stop.Zone, Lat: c.Stop.Lat, Lon: c.Stop.Lon}
+			ch.Shelter = c.Stop.Shelter
+		case repo.ChangeRemoveStop:
+			if c.StopId == "" {
+				return nil, fmt.Errorf("change %d: stop_id required", i+1)
+			}
+		case repo.ChangeAddEdge, repo.ChangeRemoveEdge:
+			if c.Edge == nil || c.Edge.FromId == "" || c.Edge.ToId == "" {
+				return nil, fmt.Errorf("change %d: edge with from_id and to_id required", i+1)
+			}
+			ch.Edge = repo.Edge{From: c.Edge.FromId, To: c.Edge.ToId, TravelTime: c.Edge.TravelTime, Distance: c.Edge.Distance}
+		case repo.ChangeSetRoute:
+			if c.Route == nil || c.Route.LineId == "" {
+				return nil, fmt.Errorf("change %d: route with line_id required", i+1)
+			}
+			ch.LineID, ch.Direction, ch.Pattern = c.Route.LineId, c.Route.Direction, c.Route.Pattern
+			for _, st := range c.Route.Stops {
+				ch.Route = append(ch.Route, repo.RouteStop{StopID: st.StopId, TravelTime: st.TravelTime, Distance: st.Distance})
+			}
+		case repo.ChangeSetFrequency:
+			if c.Service == nil || c.Service.LineId == "" {
+				return nil, fmt.Errorf("change %d: service with line_id required", i+1)
+			}
+			ch.LineID, ch.FrequencyMins = c.Service.LineId, c.Service.FrequencyMins
+			if len(c.Service.Periods) > 0 {
+				periods, err := servicePeriodsFromProto(c.Service.Periods)
+				if err != nil {
+					return nil, fmt.Errorf("change %d: %w", i+1, err)
+				}
+				ch.Periods = periods
+			}
+			if ch.FrequencyMins <= 0 && ch.Periods == nil {
+				return nil, fmt.Errorf("change %d: frequency_mins or periods required", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("change %d: invalid kind %q", i+1, c.Kind)
+		}
+		sc.Changes = append(sc.Changes, ch)
+	}
+	return sc, nil
+}
+
+func scenarioToProto(sc repo.Scenario) *pb.Scenario {
+	out := &pb.Scenario{
+		Id:          sc.ID,
+		Name:        sc.Name,
+		Description: sc.Description,
+		Status:      sc.Status,
+		CreatedTs:   sc.CreatedTs,
+		PromotedTs:  sc.PromotedTs,
+	}
+	for _, c := range sc.Changes {
+		pc := &pb.ScenarioChange{Kind: c.Kind, StopId: c.StopID}
+		switch c.Kind {
+		case repo.ChangeAddStop:
+			pc.Stop = &pb.Stop{Id: c.Stop.ID, Name: c.Stop.Name, Lat: c.Stop.Lat, Lon: c.Stop.Lon, Zone: c.Stop.Zone, Shelter: c.Shelter}
+		case repo.ChangeAddEdge, repo.ChangeRemoveEdge:
+			pc.Edge = &pb.NextEdge{FromId: c.Edge.From, ToId: c.Edge.To, TravelTime: c.Edge.TravelTime, Distance: c.Edge.Distance}
+		case repo.ChangeSetRoute:
+			pc.Route = &pb.SetLineRouteRequest{LineId: c.LineID, Direction: c.Direction, Pattern: c.Pattern}
+			for _, st := range c.Route {
+				pc.Route.Stops = append(pc.Route.Stops, &pb.RouteStop{StopId: st.StopID, TravelTime: st.TravelTime, Distance: st.Distance})
+			}
+		case repo.ChangeSetFrequency:
+			pc.Service = &pb.ServicePeriodsResponse{LineId: c.LineID, FrequencyMins: c.FrequencyMins}
+			for _, p := range c.Periods {
+				pc.Service.Periods = append(pc.Service.Periods, servicePeriodToProto(p))
+			}
+		}
+		out.Changes = append(out.Changes, pc)
+	}
+	return out
+}
+
+func metricsToProto(m repo.NetworkMetrics) *pb.NetworkMetrics {
+	out := &pb.NetworkMetrics{
+		Path:             &pb.PathResponse{NodeIds: m.Path, Hops: int32(m.Hops)},
+		PathTravelTime:   m.TravelTime,
+		RequiredVehicles: int32(m.RequiredVehicles),
+		Warnings:         m.Warnings,
+	}
+	for _, p := range m.TopPairs {
+		out.TopPairs = append(out.TopPairs, &pb.Pair{From: p["from"].(string), To: p["to"].(string), Lines: int32(p["lines"].(int64))})
+	}
+	for _, st := range m.TopStops {
+		out.TopStops = append(out.TopStops, &pb.ConnectedStop{
+			StopId:   st["stop_id"].(string),
+			StopName: st["stop_name"].(string),
+			Degree:   int32(st["degree"].(int64)),
+		})
+	}
+	for _, f := range m.Fleet {
+		out.Fleet = append(out.Fleet, &pb.LineFleetSize{
+			LineId:        f.LineID,
+			FrequencyMins: f.FrequencyMins,
+			CycleSecs:     int32(f.CycleSeconds),
+			Required:      int32(f.Required),
+			Assigned:      int32(f.AssignedCount),
+		})
+	}
+	return out
+}
+
+func diffToProto(d repo.MetricsDiff) *pb.ScenarioDiff {
+	out := &pb.ScenarioDiff{
+		StopsAdded:            d.StopsAdded,
+		StopsRemoved:          d.StopsRemoved,
+		RoutesChanged:         d.RoutesChanged,
+		RequiredVehiclesDelta: int32(d.RequiredVehiclesDelta),
+		PathHopsDelta:         int32(d.HopsDelta),
+		PathTravelTimeDelta:   d.TravelTimeDelta,
+	}
+	for _, e := range d.EdgesAdded {
+		out.EdgesAdded = append(out.EdgesAdded, &pb.NextEdge{FromId: e.From, ToId: e.To, TravelTime: e.TravelTime, Distance: e.Distance})
+	}
+	for _, e := range d.EdgesRemoved {
+		out.EdgesRemoved = append(out.EdgesRemoved, &pb.NextEdge{FromId: e.From, ToId: e.To, TravelTime: e.TravelTime, Distance: e.Distance})
+	}
+	for _, f := range d.Fleet {
+		out.Fleet = append(out.Fleet, &pb.FleetDelta{
+			LineId:          f.LineID,
+			FrequencyBefore: f.FrequencyBefore,
+			FrequencyAfter:  f.FrequencyAfter,
+			CycleSecsBefore: int32(f.CycleBefore),
+			CycleSecsAfter:  int32(f.CycleAfter),
+			RequiredBefore:  int32(f.RequiredBefore),
+			RequiredAfter:   int32(f.RequiredAfter),
+		})
+	}
+	return out
+}
@@ -3,23 +3,23 @@ package server
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"route-graph-service/internal/repo"
 	pb "route-graph-service/proto/routegraph"
 	helper "route-graph-service/util"
-
-	"github.com/jung-kurt/gofpdf"
 )
 
 type Server struct {
 	pb.UnimplementedRouteGraphServer
 	repo *repo.NeoRepo
+	// reportDir is where stored reports are written; empty disables storing.
+	reportDir string
+	jobs      *reportJobs
 }
 
-func NewServer(r *repo.NeoRepo) *Server {
-	return &Server{repo: r}
+func NewServer(r *repo.NeoRepo, reportDir string) *Server {
+	return &Server{repo: r, reportDir: reportDir, jobs: newReportJobs()}
 }
 
 func (s *Server) CreateStop(ctx context.Context, in *pb.Stop) (*pb.Stop, error) {
@@ -103,6 +103,9 @@ func (s *Server) CreateVehicle(ctx context.Context, in *pb.Vehicle) (*pb.Vehicle
 		"vehicle_uuid": in.VehicleUuid, "id": in.Id, "capacity": in.Capacity, "status": in.Status,
 		"last_seen_ts": in.LastSeenTs, "last_known_lat": in.LastKnownLat, "last_known_lon": in.LastKnownLon,
 	}
+	if err := vehicleMotion(in, props); err != nil {
+		return nil, err
+	}
 	if err := s.repo.CreateVehicle(ctx, props); err != nil {
 		return nil, err
 	}
@@ -124,11 +127,35 @@ func (s *Server) GetVehicle(ctx context.Context, in *pb.ID) (*pb.Vehicle, error)
 }
 func (s *Server) UpdateVehicle(ctx context.Context, in *pb.Vehicle) (*pb.Vehicle, error) {
 	props := map[string]any{"id": in.VehicleUuid, "capacity": in.Capacity, "status": in.Status, "last_seen_ts": in.LastSeenTs, "last_known_lat": in.LastKnownLat, "last_known_lon": in.LastKnownLon}
+	if err := vehicleMotion(in, props); err != nil {
+		return nil, err
+	}
+	if in.Heading == nil {
+		// the heading belongs to the previous position, drop it
+		props["heading"] = nil
+	}
 	if err := s.repo.UpdateVehicle(ctx, props); err != nil {
 		return nil, err
 	}
 	return in, nil
 }
+
+// vehicleMotion validates and adds the direction and heading of the last known position.
+func vehicleMotion(in *pb.Vehicle, props map[string]any) error {
+	switch in.Direction {
+	case "", repo.DirectionOutbound, repo.DirectionInbound:
+		props["direction"] = in.Direction
+	default:
+		return fmt.Errorf("invalid direction %q, expected %s or %s", in.Direction, repo.DirectionOutbound, repo.DirectionInbound)
+	}
+	if in.Heading != nil {
+		if *in.Heading < 0 || *in.Heading >= 360 {
+			return fmt.Errorf("heading must be in [0, 360)")
+		}
+		props["heading"] = *in.Heading
+	}
+	return nil
+}
 func (s *Server) DeleteVehicle(ctx context.Context, in *pb.ID) (*pb.Empty, error) {
 	if err := s.repo.DeleteVehicle(ctx, in.Id); err != nil {
 		return nil, err
@@ -208,7 +235,7 @@ func (s *Server) DeleteNextEdge(ctx context.Context, in *pb.NextEdge) (*pb.Empty
 }
 
 func (s *Server) GetServesEdge(ctx context.Context, in *pb.ServesEdge) (*pb.ServesEdge, error) {
-	m, err := s.repo.GetServes(ctx, in.LineId, in.StopId)
+	m, err := s.repo.GetServes(ctx, in.LineId, in.StopId, in.Direction, in.Pattern)
 	if err != nil {
 		return nil, err
 	}
@@ -220,20 +247,30 @@ func (s *Server) GetServesEdge(ctx context.Context, in *pb.ServesEdge) (*pb.Serv
 	if v, ok := props["order"]; ok {
 		ord = helper.AnyToInt32(v)
 	}
-	return &pb.ServesEdge{LineId: in.LineId, StopId: in.StopId, Order: ord}, nil
+	dir := repo.DirectionOutbound
+	if d, ok := props["direction"].(string); ok && d != "" {
+		dir = d
+	}
+	pat := repo.PatternMain
+	if p, ok := props["pattern"].(string); ok && p != "" {
+		pat = p
+	}
+	return &pb.ServesEdge{LineId: in.LineId, StopId: in.StopId, Order: ord, Direction: dir, Pattern: pat, AverageBoardings: boardingsOrNil(props["average_boardings"])}, nil
 }
 
 func (s *Server) ServesList(ctx context.Context, in *pb.ServesListRequest) (*pb.ServesListResponse, error) {
 	if in == nil || in.LineId == "" {
 		return nil, fmt.Errorf("line_id required")
 	}
-	rows, err := s.repo.GetServesList(ctx, in.LineId)
+	rows, err := s.repo.GetServesList(ctx, in.LineId, in.Direction, in.Pattern)
 	if err != nil {
 		return nil, err
 	}
 	out := &pb.ServesListResponse{}
 	for _, r := range rows {
 		stopId, _ := r["stopId"].(string)
+		dir, _ := r["direction"].(string)
+		pat, _ := r["pattern"].(string)
 		ordAny := r["order"]
 		var ord int32 = 0
 		if ordAny != nil {
@@ -247,31 +284,57 @@ func (s *Server) ServesList(ctx context.Context, in *pb.ServesListRequest) (*pb.
 			}
 		}
 		out.Edges = append(out.Edges, &pb.ServesEdge{
-			LineId: in.LineId,
-			StopId: stopId,
-			Order:  ord,
+			LineId:           in.LineId,
+			StopId:           stopId,
+			Order:            ord,
+			Direction:        dir,
+			Pattern:          pat,
+			AverageBoardings: boardingsOrNil(r["boardings"]),
 		})
 	}
 	return out, nil
 }
 
 func (s *Server) CreateServesEdge(ctx context.Context, in *pb.ServesEdge) (*pb.ServesEdge, error) {
-	if err := s.repo.CreateServes(ctx, in.LineId, in.StopId, in.Order); err != nil {
+	if in.GetAverageBoardings() < 0 {
+		return nil, fmt.Errorf("average_boardings must not be negative")
+	}
+	if err := s.repo.CreateServes(ctx, in.LineId, in.StopId, in.Direction, in.Pattern, in.Order, in.AverageBoardings); err != nil {
 		return nil, err
 	}
 	return in, nil
 }
 
 func (s *Server) UpdateServesEdge(ctx context.Context, in *pb.ServesEdge) (*pb.ServesEdge, error) {
-	props := map[string]any{"order": in.Order}
-	if err := s.repo.UpdateServes(ctx, in.LineId, in.StopId, props); err != nil {
+	props := map[string]any{}
+	if in.Order > 0 {
+		props["order"] = in.Order
+	}
+	if in.AverageBoardings != nil {
+		if *in.AverageBoardings < 0 {
+			return nil, fmt.Errorf("average_boardings must not be negative")
+		}
+		props["average_boardings"] = *in.AverageBoardings
+	}
+	if len(props) == 0 {
+		return nil, fmt.Errorf("nothing to update, set order or average_boardings")
+	}
+	if err := s.repo.UpdateServes(ctx, in.LineId, in.StopId, in.Direction, in.Pattern, props); err != nil {
 		return nil, err
 	}
 	return in, nil
 }
 
+func boardingsOrNil(v any) *int32 {
+	if v == nil {
+		return nil
+	}
+	b := helper.AnyToInt32(v)
+	return &b
+}
+
 func (s *Server) DeleteServesEdge(ctx context.Context, in *pb.ServesEdge) (*pb.Empty, error) {
-	if err := s.repo.DeleteServes(ctx, in.LineId, in.StopId); err != nil {
+	if err := s.repo.DeleteServes(ctx, in.LineId, in.StopId, in.Direction, in.Pattern); err != nil {
 		return nil, err
 	}
 	return &pb.Empty{}, nil
@@ -372,21 +435,14 @@ func (s *Server) AssignVehicle(ctx context.Context, req *pb.AssignVehicleRequest
 	return &pb.AssignVehicleResponse{Vehicle: v, LineId: req.LineId}, nil
 }
 
-func (s *Server) RecalibrateEdge(ctx context.Context, req *pb.RecalibrateRequest) (*pb.NextEdge, error) {
-	out, err := s.repo.RecalibrateNext(ctx, req.FromId, req.ToId, req.ObservedAvg)
-	if err != nil {
-		return nil, err
-	}
-	newVal := int32(out["new"].(int64))
-	return &pb.NextEdge{FromId: req.FromId, ToId: req.ToId, TravelTime: newVal}, nil
-}
-
 func (s *Server) ShortestPath(ctx context.Context, req *pb.PathRequest) (*pb.PathResponse, error) {
-	ids, hops, err := s.repo.ShortestPath(ctx, req.StartId, req.EndId, int(req.MaxHops))
+	filter := repo.PathFilter{LineID: req.LineId, Direction: req.Direction, Pattern: req.Pattern, At: req.AtTs, Walk: req.AllowWalk}
+	res, err := s.repo.ShortestPath(ctx, req.StartId, req.EndId, int(req.MaxHops), filter)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.PathResponse{NodeIds: ids, Hops: int32(hops)}, nil
+	arrival := res.Departure.Add(time.Duration(res.TravelTime) * time.Second)
+	return &pb.PathResponse{NodeIds: res.Stops, Hops: int32(res.Hops), LinkTypes: res.Links, TravelTime: res.TravelTime, ArrivalTs: arrival.UnixMilli()}, nil
 }
 
 func (s *Server) TopPairs(ctx context.Context, req *pb.TopPairsRequest) (*pb.TopPairsResponse, error) {
@@ -419,211 +475,3 @@ func (s *Server) DepotsIdleStats(ctx context.Context, req *pb.DepotsRequest) (*p
 }
 
 /*Reports*/
-type Vehicle = repo.Vehicle
-type Stop = repo.Stop
-
-func (s *Server) GenerateReport(ctx context.Context, req *pb.GenerateReportRequest) (*pb.GenerateReportResponse, error) {
-	pdf := gofpdf.New("P", "mm", "A4", "")
-	pdf.SetTitle("Izvestaj iz baze", false)
-	pdf.AddPage()
-
-	pdf.SetFont("Arial", "B", 18)
-	pdf.Cell(0, 10, "Izvestaj javnog preduzeca")
-	pdf.Ln(12)
-
-	pdf.SetFont("Arial", "", 12)
-	pdf.Cell(0, 8, fmt.Sprintf("Datum generisanja: %s", time.Now().Format("02.01.2006. 15:04")))
-	pdf.Ln(10)
-
-	pdf.SetDrawColor(0, 0, 0)
-	pdf.SetLineWidth(0.5)
-	pdf.Line(10, pdf.GetY(), 200, pdf.GetY())
-	pdf.Ln(8)
-
-	vehiclesByDepot, err := s.repo.GetVehiclesByDepot()
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch vehicles: %w", err)
-	}
-	stopsByZone, err := s.repo.GetStopsByZone()
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch stops: %w", err)
-	}
-
-	topStops, err := s.repo.GetTopConnectedStops(ctx, 10)
-	if err != nil {
-		return nil, fmt.Errorf("no top connected stops found: %w", err)
-	}
-
-	shortestPath, _, err := s.repo.ShortestPath(ctx, req.StartId, req.EndId, int(req.MaxHops))
-	if err != nil {
-		log.Printf("no path found: %v", err)
-		return nil, fmt.Errorf("no path found: %w", err)
-	}
-
-	topPairsResp, err := s.TopPairs(ctx, &pb.TopPairsRequest{Limit: 5})
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch top pairs: %w", err)
-	}
-
-	depotStatsResp, err := s.DepotsIdleStats(ctx, &pb.DepotsRequest{Limit: 5})
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch depot stats: %w", err)
-	}
-
-	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(0, 16, "Izvestaj o trenutnim vozilima parkiranim u depoima")
-	pdf.Ln(10)
-	for depot, vehicles := range vehiclesByDepot {
-		pdf.SetFont("Arial", "B", 14)
-		pdf.Cell(0, 8, fmt.Sprintf("Depo: %s", depot))
-		pdf.Ln(10)
-		addVehiclesTable(pdf, vehicles)
-	}
-
-	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(0, 16, "Izvestaj o stajalistima po zonama")
-	pdf.Ln(10)
-	for zone, stops := range stopsByZone {
-		pdf.SetFont("Arial", "B", 14)
-		pdf.Cell(0, 8, fmt.Sprintf("Zona: %s", zone))
-		pdf.Ln(10)
-		addStopsTable(pdf, stops)
-	}
-
-	addTopPairsSection(pdf, topPairsResp)
-	addDepotsIdleStats(pdf, depotStatsResp)
-	addTopConnectedStopsChart(pdf, topStops)
-	addShortestPath(pdf, shortestPath, req.StartId, req.EndId)
-
-	filename := "report.pdf"
-	pdf.OutputFileAndClose(filename)
-	return &pb.GenerateReportResponse{Created: true, Filename: filename}, nil
-}
-
-func addVehiclesTable(pdf *gofpdf.Fpdf, vehicles []Vehicle) {
-	pdf.SetFont("Arial", "", 12)
-	for _, v := range vehicles {
-		pdf.Cell(0, 6, fmt.Sprintf("Vozilo: %s | Status: %s | Kapacitet: %d", v.UUID, v.Status, v.Capacity))
-		pdf.Ln(6)
-	}
-	pdf.Ln(4)
-}
-
-func addStopsTable(pdf *gofpdf.Fpdf, stops []Stop) {
-	pdf.SetFont("Arial", "", 12)
-	for _, s := range stops {
-		pdf.Cell(0, 6, fmt.Sprintf("Stajaliste: %s | Naziv: %s | Nadkriveno: %v", s.ID, s.Name, s.Shelter))
-		pdf.Ln(6)
-	}
-	pdf.Ln(4)
-}
-
-func addTopPairsSection(pdf *gofpdf.Fpdf, resp *pb.TopPairsResponse) {
-	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(0, 10, "Top 5 Najcesce povezanih parova Stanica")
-	pdf.Ln(10)
-	pdf.SetFont("Arial", "", 12)
-
-	for _, pair := range resp.Pairs {
-		pdf.Cell(0, 8, fmt.Sprintf("Od: %-8s  Do: %-8s  | Broj linija: %d", pair.From, pair.To, pair.Lines))
-		pdf.Ln(8)
-	}
-	pdf.Ln(10)
-}
-
-func addDepotsIdleStats(pdf *gofpdf.Fpdf, resp *pb.DepotsResponse) {
-	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(0, 10, "Statistike depoa - broj parkiranih vozila i prosecno vreme mirovanja")
-	pdf.Ln(10)
-	pdf.SetFont("Arial", "", 12)
-
-	for _, stat := range resp.Stats {
-		pdf.Cell(0, 8, fmt.Sprintf("Depo: %-12s | Parkirano: %d | Prosecno mirovanje: %.2f ms",
-			stat.DepotName, stat.ParkedCount, stat.AvgIdleMs))
-		pdf.Ln(8)
-	}
-	pdf.Ln(10)
-}
-
-func addTopConnectedStopsChart(pdf *gofpdf.Fpdf, stops []map[string]any) {
-	pdf.AddPage()
-	pdf.SetFont("Arial", "B", 14)
-	pdf.Cell(0, 8, "Izvestaj o najvise povezanim stajalistima")
-	pdf.Ln(12)
-
-	if len(stops) == 0 {
-		pdf.SetFont("Arial", "", 12)
-		pdf.Cell(0, 6, "Nisu pronadjena povezna stajalista.")
-		pdf.Ln(8)
-		return
-	}
-
-	labels := make([]string, 0, len(stops))
-	vals := make([]float64, 0, len(stops))
-	maxv := float64(0)
-	for _, s := range stops {
-		name := s["stop_id"].(string)
-		if n, ok := s["stop_name"].(string); ok && n != "" {
-			name = fmt.Sprintf("%s (%s)", name, n)
-		}
-		labels = append(labels, name)
-		deg := float64(s["degree"].(int64))
-		vals = append(vals, deg)
-		if deg > maxv {
-			maxv = deg
-		}
-	}
-
-	left := 30.0
-	top := pdf.GetY() + 10.0
-	barH := 8.0
-	gap := 6.0
-	chartW := 150.0
-	for i := range labels {
-		y := top + float64(i)*(barH+gap)
-		pdf.SetXY(left, y)
-		w := 0.0
-		if maxv > 0 {
-			w = (vals[i] / maxv) * chartW
-		}
-		pdf.SetFillColor(70, 130, 180)
-		pdf.Rect(left, y, w, barH, "F")
-		pdf.SetXY(10, y)
-		pdf.SetFont("Arial", "", 9)
-		pdf.CellFormat(0, barH, labels[i], "", 0, "", false, 0, "")
-		pdf.SetXY(left+chartW+5, y)
-		pdf.CellFormat(20, barH, fmt.Sprintf("%.0f", vals[i]), "", 0, "R", false, 0, "")
-	}
-	pdf.Ln(float64(len(labels))*(barH+gap) + 10)
-}
-
-func addShortestPath(pdf *gofpdf.Fpdf, path []string, start, stop string) {
-	pdf.SetFont("Arial", "B", 14)
-	pdf.Cell(0, 8, fmt.Sprintf("Izvestaj o najkracoj putanji izmedju %s i %s", start, stop))
-	pdf.Ln(12)
-
-	if len(path) == 0 {
-		pdf.SetFont("Arial", "", 12)
-		pdf.Cell(0, 6, "Nema pronađenog puta između zadatih čvorova.")
-		pdf.Ln(6)
-		return
-	}
-
-	startX := 30.0
-	y := pdf.GetY() + 20.0
-	step := 30.0
-	r := 6.0
-	pdf.SetLineWidth(0.7)
-	for i, node := range path {
-		x := startX + float64(i)*step
-		pdf.Circle(x, y, r, "D")
-		pdf.SetFont("Arial", "", 8)
-		pdf.SetXY(x-8, y-3)
-		pdf.CellFormat(16, 6, node, "", 0, "C", false, 0, "")
-		if i < len(path)-1 {
-			x2 := startX + float64(i+1)*step
-			pdf.Line(x+r, y, x2-r, y)
-		}
-	}
-	pdf.Ln(40)
-}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+	helper "route-graph-service/util"
+)
+
+func (s *Server) SetServicePeriods(ctx context.Context, req *pb.ServicePeriodsRequest) (*pb.ServicePeriodsResponse, error) {
+	if req == nil || req.LineId == "" {
+		return nil, fmt.Errorf("line_id required")
+	}
+	periods, err := servicePeriodsFromProto(req.Periods)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetServicePeriods(ctx, req.LineId, periods); err != nil {
+		return nil, err
+	}
+	return s.ListServicePeriods(ctx, &pb.ID{Id: req.LineId})
+}
+
+func (s *Server) ListServicePeriods(ctx context.Context, in *pb.ID) (*pb.ServicePeriodsResponse, error) {
+	ls, err := s.repo.GetLineService(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ServicePeriodsResponse{LineId: ls.LineID, FrequencyMins: ls.FrequencyMins}
+	for _, p := range ls.Periods {
+		out.Periods = append(out.Periods, servicePeriodToProto(p))
+	}
+	return out, nil
+}
+
+func (s *Server) CreateHoliday(ctx context.Context, in *pb.Holiday) (*pb.Holiday, error) {
+	if in == nil || !repo.ValidDate(in.Date) {
+		return nil, fmt.Errorf("invalid date, expected YYYY-MM-DD")
+	}
+	if err := s.repo.CreateHoliday(ctx, in.Date, in.Name); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func (s *Server) DeleteHoliday(ctx context.Context, in *pb.Holiday) (*pb.Empty, error) {
+	if err := s.repo.DeleteHoliday(ctx, in.Date); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *Server) ListHolidays(ctx context.Context, _ *pb.Empty) (*pb.HolidaysResponse, error) {
+	hs, err := s.repo.ListHolidays(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.HolidaysResponse{}
+	for date, name := range hs {
+		out.Holidays = append(out.Holidays, &pb.Holiday{Date: date, Name: name})
+	}
+	sort.Slice(out.Holidays, func(i, j int) bool { return out.Holidays[i].Date < out.Holidays[j].Date })
+	return out, nil
+}
+
+func (s *Server) LineFrequency(ctx context.Context, req *pb.LineFrequencyRequest) (*pb.LineFrequencyResponse, error) {
+	if req == nil || req.LineId == "" {
+		return nil, fmt.Errorf("line_id required")
+	}
+	at := timeOrNow(req.At)
+	ls, err := s.repo.GetLineService(ctx, req.LineId)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := s.repo.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	freq, period := ls.FrequencyAt(at, holidays)
+	out := &pb.LineFrequencyResponse{
+		LineId:        req.LineId,
+		At:            at.Unix(),
+		DayType:       ls.DayType(at, holidays),
+		FrequencyMins: freq,
+		InService:     freq > 0,
+	}
+	if period != nil {
+		out.Period = servicePeriodToProto(*period)
+	}
+	return out, nil
+}
+
+type FleetRow = repo.LineFleetSize
+
+// fleetAt sizes every line for the headway its service periods give at t.
+func (s *Server) fleetAt(ctx context.Context, at time.Time) ([]FleetRow, error) {
+	fleet, err := s.repo.GetLineFleet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	services, err := s.repo.GetLineServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := s.repo.HolidaySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byLine := map[string]repo.LineService{}
+	for _, ls := range services {
+		byLine[ls.LineID] = ls
+	}
+	return repo.SizeFleet(fleet, byLine, holidays, at), nil
+}
+
+func timeOrNow(unix int64) time.Time {
+	if unix <= 0 {
+		return time.Now()
+	}
+	return time.Unix(unix, 0)
+}
+
+func servicePeriodsFromProto(in []*pb.ServicePeriod) ([]repo.ServicePeriod, error) {
+	covered := map[string]*[24 * 60]bool{}
+	res := make([]repo.ServicePeriod, 0, len(in))
+	for _, p := range in {
+		if !repo.ValidDayType(p.DayType) {
+			return nil, fmt.Errorf("invalid day_type %q", p.DayType)
+		}
+		if p.FrequencyMins <= 0 {
+			return nil, fmt.Errorf("frequency_mins must be positive")
+		}
+		from, err := helper.ClockMinutes(p.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		to, err := helper.ClockMinutes(p.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if from == to {
+			return nil, fmt.Errorf("empty band %s-%s", p.StartTime, p.EndTime)
+		}
+		day := covered[p.DayType]
+		if day == nil {
+			day = &[24 * 60]bool{}
+			covered[p.DayType] = day
+		}
+		for m := 0; m < 24*60; m++ {
+			if !helper.InClockWindow(from, to, m) {
+				continue
+			}
+			if day[m] {
+				return nil, fmt.Errorf("%s band %s-%s overlaps another period", p.DayType, p.StartTime, p.EndTime)
+			}
+			day[m] = true
+		}
+		res = append(res, repo.ServicePeriod{DayType: p.DayType, StartTime: p.StartTime, EndTime: p.EndTime, FrequencyMins: p.FrequencyMins})
+	}
+	return res, nil
+}
+
+func servicePeriodToProto(p repo.ServicePeriod) *pb.ServicePeriod {
+	return &pb.ServicePeriod{DayType: p.DayType, StartTime: p.StartTime, EndTime: p.EndTime, FrequencyMins: p.FrequencyMins}
+}
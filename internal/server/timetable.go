@@ -0,0 +1,95 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+	helper "route-graph-service/util"
+)
+
+func (s *Server) GenerateTimetable(ctx context.Context, req *pb.TimetableRequest) (*pb.TimetableResponse, error) {
+	if req == nil || req.LineId == "" {
+		return nil, fmt.Errorf("line_id required")
+	}
+	date, err := dateOrToday(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	tt, err := s.repo.GenerateTimetable(ctx, req.LineId, req.Direction, req.Pattern, date, req.DwellSecs, req.StopDwellSecs)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.TimetableResponse{
+		LineId:    tt.LineID,
+		Date:      tt.Date,
+		DayType:   tt.DayType,
+		Direction: tt.Direction,
+		Pattern:   tt.Pattern,
+	}
+	for _, st := range tt.Stops {
+		out.Stops = append(out.Stops, &pb.TimetableStop{
+			StopId:     st.StopID,
+			Name:       st.Name,
+			Order:      int32(st.Order),
+			OffsetSecs: st.Offset,
+			DwellSecs:  st.DwellTime,
+		})
+	}
+	for i, dep := range tt.Departures {
+		trip := &pb.TimetableTrip{Departure: helper.ClockString(dep)}
+		for j := range tt.Stops {
+			trip.Times = append(trip.Times, helper.ClockString(tt.ArrivalAt(i, j)))
+		}
+		out.Trips = append(out.Trips, trip)
+	}
+	if req.IncludeCsv {
+		b, err := timetableCSV(tt)
+		if err != nil {
+			return nil, err
+		}
+		out.Csv = string(b)
+	}
+	return out, nil
+}
+
+// timetableCSV writes one row per trip with the arrival at every stop as columns.
+func timetableCSV(tt *repo.Timetable) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{"trip"}
+	for _, st := range tt.Stops {
+		header = append(header, st.StopID)
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	for i := range tt.Departures {
+		row := []string{fmt.Sprint(i + 1)}
+		for j := range tt.Stops {
+			row = append(row, helper.ClockString(tt.ArrivalAt(i, j)))
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// dateOrToday parses a "YYYY-MM-DD" date in local time, empty means today.
+func dateOrToday(date string) (time.Time, error) {
+	if date == "" {
+		now := time.Now()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local), nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return t, nil
+}
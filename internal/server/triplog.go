@@ -0,0 +1,102 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultDriftThreshold = 0.2
+	maxIngestProblems     = 50
+)
+
+/*
+IngestTripLog collects the streamed trips, turns consecutive stop arrivals into NEXT travel
+time observations and recalibrates every touched edge once the client closes the stream.
+*/
+func (s *Server) IngestTripLog(stream grpc.ClientStreamingServer[pb.IngestTripLogRequest, pb.IngestTripLogResponse]) error {
+	out := &pb.IngestTripLogResponse{}
+	var first *pb.IngestTripLogRequest
+	var obs []repo.TravelObservation
+	var problems []string
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			first = req
+		}
+		if req.Trip == nil {
+			continue
+		}
+		trip := tripLogFromProto(req.Trip)
+		o, p := trip.Observations()
+		obs = append(obs, o...)
+		problems = append(problems, p...)
+		out.Trips++
+		out.Arrivals += int32(len(trip.Arrivals))
+	}
+	if first == nil {
+		return stream.SendAndClose(out)
+	}
+	threshold := first.DriftThreshold
+	if threshold <= 0 {
+		threshold = defaultDriftThreshold
+	}
+
+	res, err := s.repo.IngestObservations(stream.Context(), obs, repo.CalibrationOptions{
+		Estimator:  first.Estimator,
+		Window:     time.Duration(first.WindowDays) * 24 * time.Hour,
+		MinSamples: int(first.MinSamples),
+	}, first.BuildProfiles)
+	if err != nil {
+		return err
+	}
+	out.Observations = int32(res.Recorded)
+	out.UnknownEdges = int32(len(res.Unknown))
+	out.EdgesRecalibrated = int32(len(res.Calibrations))
+	out.ProfileEntries = int32(res.Profiles)
+	for _, u := range res.Unknown {
+		problems = append(problems, fmt.Sprintf("no NEXT edge %s -> %s", u.From, u.To))
+	}
+	for _, c := range res.Calibrations {
+		if c.Updated && c.NewTravelTime != c.OldTravelTime {
+			out.EdgesUpdated++
+		}
+		if math.Abs(c.Drift()) < threshold {
+			continue
+		}
+		out.Drifted = append(out.Drifted, &pb.EdgeDrift{
+			FromId:        c.From,
+			ToId:          c.To,
+			OldTravelTime: c.OldTravelTime,
+			NewTravelTime: c.NewTravelTime,
+			Drift:         c.Drift(),
+			Samples:       int32(c.Overall.Samples),
+			Confidence:    c.Overall.Confidence,
+		})
+	}
+	sort.Slice(out.Drifted, func(i, j int) bool { return math.Abs(out.Drifted[i].Drift) > math.Abs(out.Drifted[j].Drift) })
+	out.Problems = problems[:min(len(problems), maxIngestProblems)]
+	return stream.SendAndClose(out)
+}
+
+func tripLogFromProto(in *pb.TripLog) repo.TripLog {
+	t := repo.TripLog{TripID: in.TripId, VehicleUUID: in.VehicleUuid, LineID: in.LineId}
+	for _, a := range in.Arrivals {
+		t.Arrivals = append(t.Arrivals, repo.StopArrival{StopID: a.StopId, At: time.UnixMilli(a.ArrivalTs)})
+	}
+	return t
+}
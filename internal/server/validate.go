@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"route-graph-service/internal/repo"
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) ValidateNetwork(ctx context.Context, req *pb.ValidateNetworkRequest) (*pb.ValidateNetworkResponse, error) {
+	res, err := s.repo.ValidateNetwork(ctx, req.LineId)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.ValidateNetworkResponse{}
+	for _, f := range res {
+		sev := f["severity"].(string)
+		switch sev {
+		case repo.SeverityError:
+			out.Errors++
+		case repo.SeverityWarning:
+			out.Warnings++
+		}
+		out.Findings = append(out.Findings, &pb.ValidationFinding{
+			Severity:  sev,
+			Code:      f["code"].(string),
+			LineId:    f["line_id"].(string),
+			Direction: f["direction"].(string),
+			Pattern:   f["pattern"].(string),
+			StopId:    f["stop_id"].(string),
+			Message:   f["message"].(string),
+		})
+	}
+	sort.SliceStable(out.Findings, func(i, j int) bool {
+		a, b := out.Findings[i], out.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == repo.SeverityError
+		}
+		if a.LineId != b.LineId {
+			return a.LineId < b.LineId
+		}
+		if a.Direction != b.Direction {
+			return a.Direction > b.Direction
+		}
+		if a.Pattern != b.Pattern {
+			return a.Pattern < b.Pattern
+		}
+		return a.StopId < b.StopId
+	})
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+
+	pb "route-graph-service/proto/routegraph"
+)
+
+func (s *Server) GenerateWalkLinks(ctx context.Context, req *pb.GenerateWalkLinksRequest) (*pb.GenerateWalkLinksResponse, error) {
+	stats, err := s.repo.GenerateWalkLinks(ctx, req.RadiusM, req.WalkSpeedMps, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenerateWalkLinksResponse{
+		Created: int32(stats.Created),
+		Updated: int32(stats.Updated),
+		Removed: int32(stats.Removed),
+	}, nil
+}
+
+func (s *Server) TransferHubs(ctx context.Context, req *pb.TransferHubsRequest) (*pb.TransferHubsResponse, error) {
+	hubs, err := s.repo.TransferHubs(ctx, int(req.MinLines), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.TransferHubsResponse{}
+	for _, h := range hubs {
+		out.Hubs = append(out.Hubs, &pb.TransferHub{
+			StopIds:      h.Stops,
+			LineIds:      h.Lines,
+			Lines:        int32(len(h.Lines)),
+			Lat:          h.Lat,
+			Lon:          h.Lon,
+			MaxWalkTime:  h.MaxWalkTime,
+			CentreStopId: h.Centre,
+		})
+	}
+	return out, nil
+}
@@ -152,7 +152,7 @@ echo --- SERVES: Get L1 -> S3 1>&2
 echo.
 
 echo --- SERVES: Update L1 -> S3 (order -> 5) 1>&2
-%G% -plaintext -d "{\"line_id\":\"L1\",\"stop_id\":\"S3\",\"order\":5}" %HOST% routegraph.RouteGraph.UpdateServesEdge
+%G% -plaintext -d "{\"line_id\":\"L1\",\"stop_id\":\"S3\",\"order\":5,\"average_boardings\":32}" %HOST% routegraph.RouteGraph.UpdateServesEdge
 echo.
 
 echo --- SERVES: Get L1 -> S3 after update 1>&2
@@ -230,6 +230,10 @@ echo --- COMPLEX: RecalibrateEdge S1->S2 observed=180 1>&2
 echo.
 %G% -plaintext -d "{\"from_id\":\"S1\",\"to_id\":\"S2\",\"observed_avg\":180}" %HOST% routegraph.RouteGraph.RecalibrateEdge
 echo.
+%G% -plaintext -d "{\"from_id\":\"S1\",\"to_id\":\"S2\",\"observed_avg\":170,\"vehicle_uuid\":\"V1\"}" %HOST% routegraph.RouteGraph.RecalibrateEdge
+echo.
+%G% -plaintext -d "{\"from_id\":\"S1\",\"to_id\":\"S2\",\"observed_avg\":400,\"estimator\":\"MEDIAN\",\"min_samples\":3}" %HOST% routegraph.RouteGraph.RecalibrateEdge
+echo.
 
 echo --- COMPLEX: TopPairs limit=5 1>&2
 %G% -plaintext -d "{\"limit\":5}" %HOST% routegraph.RouteGraph.TopPairs
@@ -243,6 +247,154 @@ echo --- COMPLEX: ShortestPath S1 -> S10 max_hops=10 1>&2
 %G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10}" %HOST% routegraph.RouteGraph.ShortestPath
 echo.
 
+echo --- ROUTE: Insert S50 into L1 at position 2 1>&2
+%G% -plaintext -d "{\"line_id\":\"L1\",\"stop_id\":\"S50\",\"order\":2}" %HOST% routegraph.RouteGraph.InsertStopIntoLine
+echo.
+
+echo --- ROUTE: Remove S50 from L1 1>&2
+%G% -plaintext -d "{\"line_id\":\"L1\",\"stop_id\":\"S50\"}" %HOST% routegraph.RouteGraph.RemoveStopFromLine
+echo.
+
+echo --- COMPLEX: LineFrequency 1>&2
+%G% -plaintext -d "{\"line_id\":\"L1\"}" %HOST% routegraph.RouteGraph.LineFrequency
+echo.
+
+echo --- COMPLEX: GenerateTimetable 1>&2
+%G% -plaintext -d "{\"line_id\":\"L1\",\"dwell_secs\":20}" %HOST% routegraph.RouteGraph.GenerateTimetable
+echo.
+
+echo --- COMPLEX: DepartureBoard 1>&2
+%G% -plaintext -d "{\"stop_id\":\"S5\",\"limit\":2}" %HOST% routegraph.RouteGraph.DepartureBoard
+echo.
+
+echo --- COMPLEX: CreateDisruption (close stop S3) 1>&2
+%G% -plaintext -d "{\"id\":\"DIS1\",\"kind\":\"STOP\",\"stop_id\":\"S3\",\"reason\":\"radovi na putu\"}" %HOST% routegraph.RouteGraph.CreateDisruption
+echo.
+
+echo --- COMPLEX: ListDisruptions 1>&2
+%G% -plaintext -d "{\"active_only\":true}" %HOST% routegraph.RouteGraph.ListDisruptions
+echo.
+
+echo --- COMPLEX: EndDisruption 1>&2
+%G% -plaintext -d "{\"id\":\"DIS1\"}" %HOST% routegraph.RouteGraph.EndDisruption
+echo.
+
+echo --- COMPLEX: CreateScenario (remove S17, re-route L2) 1>&2
+%G% -plaintext -d "{\"id\":\"SC1\",\"name\":\"Bez S17\",\"changes\":[{\"kind\":\"REMOVE_STOP\",\"stop_id\":\"S17\"},{\"kind\":\"SET_FREQUENCY\",\"service\":{\"line_id\":\"L2\",\"frequency_mins\":6}}]}" %HOST% routegraph.RouteGraph.CreateScenario
+echo.
+
+echo --- COMPLEX: EvaluateScenario 1>&2
+%G% -plaintext -d "{\"scenario_id\":\"SC1\",\"start_id\":\"S1\",\"end_id\":\"S10\"}" %HOST% routegraph.RouteGraph.EvaluateScenario
+echo.
+
+echo --- COMPLEX: DeleteScenario 1>&2
+%G% -plaintext -d "{\"id\":\"SC1\"}" %HOST% routegraph.RouteGraph.DeleteScenario
+echo.
+
+echo --- COMPLEX: GenerateWalkLinks 1>&2
+%G% -plaintext -d "{\"radius_m\":200}" %HOST% routegraph.RouteGraph.GenerateWalkLinks
+echo.
+
+echo --- COMPLEX: TransferHubs 1>&2
+%G% -plaintext -d "{\"limit\":5}" %HOST% routegraph.RouteGraph.TransferHubs
+echo.
+
+echo --- COMPLEX: ShortestPath with walking transfers 1>&2
+%G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10,\"allow_walk\":true}" %HOST% routegraph.RouteGraph.ShortestPath
+echo.
+
+echo --- COMPLEX: StopCentrality 1>&2
+%G% -plaintext -d "{\"metric\":\"pagerank\",\"limit\":5}" %HOST% routegraph.RouteGraph.StopCentrality
+echo.
+
+echo --- COMPLEX: CriticalElements 1>&2
+%G% -plaintext -d "{\"sample_pairs\":100,\"limit\":5}" %HOST% routegraph.RouteGraph.CriticalElements
+echo.
+
+echo --- COMPLEX: ConnectivityReport 1>&2
+%G% -plaintext -d "{\"radius_m\":600,\"limit\":10}" %HOST% routegraph.RouteGraph.ConnectivityReport
+echo.
+
+echo --- COMPLEX: LoadDemand 1>&2
+%G% -plaintext -d "{\"csv\":\"origin,destination,trips_per_hour\nS1,S10,120\nS5,S20,80\nS2,S7,45\n\"}" %HOST% routegraph.RouteGraph.LoadDemand
+echo.
+
+echo --- COMPLEX: AssignDemand 1>&2
+%G% -plaintext -d "{\"limit\":10}" %HOST% routegraph.RouteGraph.AssignDemand
+echo.
+
+echo --- COMPLEX: RidershipStats 1>&2
+%G% -plaintext -d "{\"limit\":5}" %HOST% routegraph.RouteGraph.RidershipStats
+echo.
+
+echo --- COMPLEX: SetEdgeProfile S1->S2 1>&2
+%G% -plaintext -d "{\"from_id\":\"S1\",\"to_id\":\"S2\",\"entries\":[{\"day\":\"WEEKDAY\",\"band\":\"NIGHT\",\"travel_time\":90},{\"day\":\"WEEKDAY\",\"band\":\"AM_PEAK\",\"travel_time\":240}]}" %HOST% routegraph.RouteGraph.SetEdgeProfile
+echo.
+
+echo --- COMPLEX: BuildEdgeProfiles 1>&2
+%G% -plaintext -d "{\"min_samples\":2}" %HOST% routegraph.RouteGraph.BuildEdgeProfiles
+echo.
+
+echo --- COMPLEX: ShortestPath departing at a given time 1>&2
+%G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10,\"at_ts\":1792044000000}" %HOST% routegraph.RouteGraph.ShortestPath
+echo.
+
+echo --- COMPLEX: IngestTripLog (client stream, two trips) 1>&2
+%G% -plaintext -d "{\"trip\":{\"trip_id\":\"T1\",\"vehicle_uuid\":\"V1\",\"line_id\":\"L1\",\"arrivals\":[{\"stop_id\":\"S1\",\"arrival_ts\":1792044000000},{\"stop_id\":\"S2\",\"arrival_ts\":1792044200000}]},\"min_samples\":1} {\"trip\":{\"trip_id\":\"T2\",\"vehicle_uuid\":\"V2\",\"line_id\":\"L1\",\"arrivals\":[{\"stop_id\":\"S1\",\"arrival_ts\":1792047600000},{\"stop_id\":\"S2\",\"arrival_ts\":1792047810000}]}}" %HOST% routegraph.RouteGraph.IngestTripLog
+echo.
+
+echo --- COMPLEX: RenderNetworkMap as SVG with the S1-S10 path and shaded zones 1>&2
+%G% -plaintext -d "{\"format\":\"SVG\",\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10,\"shade_zones\":true}" %HOST% routegraph.RouteGraph.RenderNetworkMap
+echo.
+
+echo --- COMPLEX: GenerateReport as HTML, stored in REPORT_OUTPUT_DIR 1>&2
+%G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10,\"format\":\"HTML\",\"store\":true}" %HOST% routegraph.RouteGraph.GenerateReport
+echo.
+
+echo --- COMPLEX: GenerateReport with selected sections, limits and a line filter 1>&2
+%G% -plaintext -d "{\"sections\":[\"fleet\",\"top_pairs\",\"ridership\",\"shortest_path\"],\"limits\":{\"top_pairs\":3},\"lines\":[\"L1\"],\"format\":\"JSON\"}" %HOST% routegraph.RouteGraph.GenerateReport
+echo.
+
+echo --- COMPLEX: GenerateReport in English 1>&2
+%G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10,\"locale\":\"en\",\"format\":\"HTML\"}" %HOST% routegraph.RouteGraph.GenerateReport
+echo.
+
+echo --- COMPLEX: GenerateReportStream PDF chunks 1>&2
+%G% -plaintext -d "{\"start_id\":\"S1\",\"end_id\":\"S10\",\"max_hops\":10}" %HOST% routegraph.RouteGraph.GenerateReportStream
+echo.
+
+echo --- COMPLEX: SubmitReport 1>&2
+%G% -plaintext -d "{\"sections\":[\"fleet\",\"depots\"],\"format\":\"CSV\"}" %HOST% routegraph.RouteGraph.SubmitReport
+echo.
+
+echo --- COMPLEX: ListReportJobs 1>&2
+%G% -plaintext -d "{}" %HOST% routegraph.RouteGraph.ListReportJobs
+echo.
+
+echo --- COMPLEX: CreateReportSchedule daily fleet report at 05:00 1>&2
+%G% -plaintext -d "{\"id\":\"daily-fleet\",\"name\":\"Daily fleet\",\"cron\":\"0 5 * * *\",\"request\":{\"sections\":[\"fleet\"]}}" %HOST% routegraph.RouteGraph.CreateReportSchedule
+echo.
+
+echo --- COMPLEX: ListReportSchedules 1>&2
+%G% -plaintext -d "{}" %HOST% routegraph.RouteGraph.ListReportSchedules
+echo.
+
+echo --- COMPLEX: ReportData datasets with CSV 1>&2
+%G% -plaintext -d "{\"request\":{\"sections\":[\"depots\",\"top_pairs\",\"centrality\"]},\"include_csv\":true}" %HOST% routegraph.RouteGraph.ReportData
+echo.
+
+echo --- COMPLEX: GenerateLineSheet L1 1>&2
+%G% -plaintext -d "{\"line_id\":\"L1\"}" %HOST% routegraph.RouteGraph.GenerateLineSheet
+echo.
+
+echo --- COMPLEX: GenerateLineSheet all active lines 1>&2
+%G% -plaintext -d "{\"all_active\":true,\"locale\":\"en\"}" %HOST% routegraph.RouteGraph.GenerateLineSheet
+echo.
+
+echo --- COMPLEX: ValidateNetwork 1>&2
+%G% -plaintext -d "{}" %HOST% routegraph.RouteGraph.ValidateNetwork
+echo.
+
 echo =====================================================
 echo Demo complete.
 pause
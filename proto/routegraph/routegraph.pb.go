@@ -262,14 +262,18 @@ func (x *Line) GetActive() bool {
 }
 
 type Vehicle struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleUuid   string                 `protobuf:"bytes,1,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	LastSeenTs    int64                  `protobuf:"varint,5,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
-	LastKnownLat  float64                `protobuf:"fixed64,6,opt,name=last_known_lat,json=lastKnownLat,proto3" json:"last_known_lat,omitempty"`
-	LastKnownLon  float64                `protobuf:"fixed64,7,opt,name=last_known_lon,json=lastKnownLon,proto3" json:"last_known_lon,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	VehicleUuid  string                 `protobuf:"bytes,1,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
+	Id           string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity     int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	LastSeenTs   int64                  `protobuf:"varint,5,opt,name=last_seen_ts,json=lastSeenTs,proto3" json:"last_seen_ts,omitempty"`
+	LastKnownLat float64                `protobuf:"fixed64,6,opt,name=last_known_lat,json=lastKnownLat,proto3" json:"last_known_lat,omitempty"`
+	LastKnownLon float64                `protobuf:"fixed64,7,opt,name=last_known_lon,json=lastKnownLon,proto3" json:"last_known_lon,omitempty"`
+	// OUTBOUND or INBOUND trip being driven, empty when unknown; used to place live departures
+	Direction string `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`
+	// degrees clockwise from north at the last position, unset when unknown
+	Heading       *float64 `protobuf:"fixed64,9,opt,name=heading,proto3,oneof" json:"heading,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -353,6 +357,20 @@ func (x *Vehicle) GetLastKnownLon() float64 {
 	return 0
 }
 
+func (x *Vehicle) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Vehicle) GetHeading() float64 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
 type Depot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -499,12 +517,19 @@ func (x *NextEdge) GetDistance() int32 {
 }
 
 type ServesEdge struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
-	StopId        string                 `protobuf:"bytes,2,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
-	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	StopId string                 `protobuf:"bytes,2,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	// position on the route, 1-based; left unchanged on update when 0
+	Order int32 `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	// OUTBOUND (default) or INBOUND; each direction and pattern has its own order sequence.
+	Direction string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	// route pattern (variant) of the line, MAIN by default
+	Pattern string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// average passengers boarding a trip at this stop; left unchanged on update when unset
+	AverageBoardings *int32 `protobuf:"varint,6,opt,name=average_boardings,json=averageBoardings,proto3,oneof" json:"average_boardings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ServesEdge) Reset() {
@@ -558,6 +583,27 @@ func (x *ServesEdge) GetOrder() int32 {
 	return 0
 }
 
+func (x *ServesEdge) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ServesEdge) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ServesEdge) GetAverageBoardings() int32 {
+	if x != nil && x.AverageBoardings != nil {
+		return *x.AverageBoardings
+	}
+	return 0
+}
+
 type AssignedTo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VehicleUuid   string                 `protobuf:"bytes,1,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
@@ -776,10 +822,20 @@ func (x *AssignVehicleResponse) GetLineId() string {
 }
 
 type RecalibrateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromId        string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
-	ToId          string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
-	ObservedAvg   int32                  `protobuf:"varint,3,opt,name=observed_avg,json=observedAvg,proto3" json:"observed_avg,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FromId string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// observed travel time in seconds, stored before recalibrating; 0 recalibrates from history only
+	ObservedAvg int32 `protobuf:"varint,3,opt,name=observed_avg,json=observedAvg,proto3" json:"observed_avg,omitempty"`
+	// unix seconds of the observation, default now
+	ObservedAt  int64  `protobuf:"varint,4,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	VehicleUuid string `protobuf:"bytes,5,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
+	// MEDIAN (default), P85 or EWMA
+	Estimator string `protobuf:"bytes,6,opt,name=estimator,proto3" json:"estimator,omitempty"`
+	// rolling window of observations in days, default 28
+	WindowDays int32 `protobuf:"varint,7,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	// observations needed before travel_time changes, default 3
+	MinSamples    int32 `protobuf:"varint,8,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -835,89 +891,72 @@ func (x *RecalibrateRequest) GetObservedAvg() int32 {
 	return 0
 }
 
-type PathRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartId       string                 `protobuf:"bytes,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
-	EndId         string                 `protobuf:"bytes,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
-	MaxHops       int32                  `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PathRequest) Reset() {
-	*x = PathRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PathRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PathRequest) ProtoMessage() {}
-
-func (x *PathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[13]
+func (x *RecalibrateRequest) GetObservedAt() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ObservedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PathRequest.ProtoReflect.Descriptor instead.
-func (*PathRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{13}
+func (x *RecalibrateRequest) GetVehicleUuid() string {
+	if x != nil {
+		return x.VehicleUuid
+	}
+	return ""
 }
 
-func (x *PathRequest) GetStartId() string {
+func (x *RecalibrateRequest) GetEstimator() string {
 	if x != nil {
-		return x.StartId
+		return x.Estimator
 	}
 	return ""
 }
 
-func (x *PathRequest) GetEndId() string {
+func (x *RecalibrateRequest) GetWindowDays() int32 {
 	if x != nil {
-		return x.EndId
+		return x.WindowDays
 	}
-	return ""
+	return 0
 }
 
-func (x *PathRequest) GetMaxHops() int32 {
+func (x *RecalibrateRequest) GetMinSamples() int32 {
 	if x != nil {
-		return x.MaxHops
+		return x.MinSamples
 	}
 	return 0
 }
 
-type PathResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeIds       []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	Hops          int32                  `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+type TravelTimeEstimate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time-of-day band, ALL for the whole window
+	Band     string  `protobuf:"bytes,1,opt,name=band,proto3" json:"band,omitempty"`
+	Samples  int32   `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	Median   float64 `protobuf:"fixed64,3,opt,name=median,proto3" json:"median,omitempty"`
+	P15      float64 `protobuf:"fixed64,4,opt,name=p15,proto3" json:"p15,omitempty"`
+	P85      float64 `protobuf:"fixed64,5,opt,name=p85,proto3" json:"p85,omitempty"`
+	Ewma     float64 `protobuf:"fixed64,6,opt,name=ewma,proto3" json:"ewma,omitempty"`
+	Estimate int32   `protobuf:"varint,7,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	// 0..1, grows with samples and falls with spread
+	Confidence    float64 `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PathResponse) Reset() {
-	*x = PathResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[14]
+func (x *TravelTimeEstimate) Reset() {
+	*x = TravelTimeEstimate{}
+	mi := &file_proto_routegraph_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PathResponse) String() string {
+func (x *TravelTimeEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PathResponse) ProtoMessage() {}
+func (*TravelTimeEstimate) ProtoMessage() {}
 
-func (x *PathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[14]
+func (x *TravelTimeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,94 +967,98 @@ func (x *PathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PathResponse.ProtoReflect.Descriptor instead.
-func (*PathResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TravelTimeEstimate.ProtoReflect.Descriptor instead.
+func (*TravelTimeEstimate) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PathResponse) GetNodeIds() []string {
+func (x *TravelTimeEstimate) GetBand() string {
 	if x != nil {
-		return x.NodeIds
+		return x.Band
 	}
-	return nil
+	return ""
 }
 
-func (x *PathResponse) GetHops() int32 {
+func (x *TravelTimeEstimate) GetSamples() int32 {
 	if x != nil {
-		return x.Hops
+		return x.Samples
 	}
 	return 0
 }
 
-// Top pairs
-type TopPairsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TravelTimeEstimate) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
 }
 
-func (x *TopPairsRequest) Reset() {
-	*x = TopPairsRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TravelTimeEstimate) GetP15() float64 {
+	if x != nil {
+		return x.P15
+	}
+	return 0
 }
 
-func (x *TopPairsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TravelTimeEstimate) GetP85() float64 {
+	if x != nil {
+		return x.P85
+	}
+	return 0
 }
 
-func (*TopPairsRequest) ProtoMessage() {}
-
-func (x *TopPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[15]
+func (x *TravelTimeEstimate) GetEwma() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ewma
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TopPairsRequest.ProtoReflect.Descriptor instead.
-func (*TopPairsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{15}
+func (x *TravelTimeEstimate) GetEstimate() int32 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
 }
 
-func (x *TopPairsRequest) GetLimit() int32 {
+func (x *TravelTimeEstimate) GetConfidence() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Confidence
 	}
 	return 0
 }
 
-type Pair struct {
+type RecalibrateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Lines         int32                  `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
+	FromId        string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId          string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Estimator     string                 `protobuf:"bytes,3,opt,name=estimator,proto3" json:"estimator,omitempty"`
+	OldTravelTime int32                  `protobuf:"varint,4,opt,name=old_travel_time,json=oldTravelTime,proto3" json:"old_travel_time,omitempty"`
+	NewTravelTime int32                  `protobuf:"varint,5,opt,name=new_travel_time,json=newTravelTime,proto3" json:"new_travel_time,omitempty"`
+	Updated       bool                   `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	Samples       int32                  `protobuf:"varint,7,opt,name=samples,proto3" json:"samples,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Overall       *TravelTimeEstimate    `protobuf:"bytes,9,opt,name=overall,proto3" json:"overall,omitempty"`
+	Bands         []*TravelTimeEstimate  `protobuf:"bytes,10,rep,name=bands,proto3" json:"bands,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pair) Reset() {
-	*x = Pair{}
-	mi := &file_proto_routegraph_proto_msgTypes[16]
+func (x *RecalibrateResponse) Reset() {
+	*x = RecalibrateResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pair) String() string {
+func (x *RecalibrateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pair) ProtoMessage() {}
+func (*RecalibrateResponse) ProtoMessage() {}
 
-func (x *Pair) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[16]
+func (x *RecalibrateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,99 +1069,114 @@ func (x *Pair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
-func (*Pair) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RecalibrateResponse.ProtoReflect.Descriptor instead.
+func (*RecalibrateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Pair) GetFrom() string {
+func (x *RecalibrateResponse) GetFromId() string {
 	if x != nil {
-		return x.From
+		return x.FromId
 	}
 	return ""
 }
 
-func (x *Pair) GetTo() string {
+func (x *RecalibrateResponse) GetToId() string {
 	if x != nil {
-		return x.To
+		return x.ToId
 	}
 	return ""
 }
 
-func (x *Pair) GetLines() int32 {
+func (x *RecalibrateResponse) GetEstimator() string {
 	if x != nil {
-		return x.Lines
+		return x.Estimator
 	}
-	return 0
+	return ""
 }
 
-type TopPairsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairs         []*Pair                `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RecalibrateResponse) GetOldTravelTime() int32 {
+	if x != nil {
+		return x.OldTravelTime
+	}
+	return 0
 }
 
-func (x *TopPairsResponse) Reset() {
-	*x = TopPairsResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RecalibrateResponse) GetNewTravelTime() int32 {
+	if x != nil {
+		return x.NewTravelTime
+	}
+	return 0
 }
 
-func (x *TopPairsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RecalibrateResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
 }
 
-func (*TopPairsResponse) ProtoMessage() {}
+func (x *RecalibrateResponse) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
 
-func (x *TopPairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[17]
+func (x *RecalibrateResponse) GetConfidence() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Confidence
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TopPairsResponse.ProtoReflect.Descriptor instead.
-func (*TopPairsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{17}
+func (x *RecalibrateResponse) GetOverall() *TravelTimeEstimate {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
 }
 
-func (x *TopPairsResponse) GetPairs() []*Pair {
+func (x *RecalibrateResponse) GetBands() []*TravelTimeEstimate {
 	if x != nil {
-		return x.Pairs
+		return x.Bands
 	}
 	return nil
 }
 
-// Depots idle stats
-type DepotsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+type PathRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StartId string                 `protobuf:"bytes,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   string                 `protobuf:"bytes,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	// 0 = no limit
+	MaxHops int32 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// optional: only follow NEXT edges travelled by this line (and direction/pattern)
+	LineId    string `protobuf:"bytes,4,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Direction string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern   string `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// departure in unix ms, 0 = now; selects active disruptions and time-of-day travel times
+	AtTs int64 `protobuf:"varint,7,opt,name=at_ts,json=atTs,proto3" json:"at_ts,omitempty"`
+	// also use WALK links between nearby stops as transfers
+	AllowWalk     bool `protobuf:"varint,8,opt,name=allow_walk,json=allowWalk,proto3" json:"allow_walk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepotsRequest) Reset() {
-	*x = DepotsRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[18]
+func (x *PathRequest) Reset() {
+	*x = PathRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotsRequest) String() string {
+func (x *PathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotsRequest) ProtoMessage() {}
+func (*PathRequest) ProtoMessage() {}
 
-func (x *DepotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[18]
+func (x *PathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,43 +1187,97 @@ func (x *DepotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotsRequest.ProtoReflect.Descriptor instead.
-func (*DepotsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PathRequest.ProtoReflect.Descriptor instead.
+func (*PathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DepotsRequest) GetLimit() int32 {
+func (x *PathRequest) GetStartId() string {
 	if x != nil {
-		return x.Limit
+		return x.StartId
 	}
-	return 0
+	return ""
 }
 
-type DepotStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DepotId       string                 `protobuf:"bytes,1,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	DepotName     string                 `protobuf:"bytes,2,opt,name=depot_name,json=depotName,proto3" json:"depot_name,omitempty"`
-	ParkedCount   int32                  `protobuf:"varint,3,opt,name=parked_count,json=parkedCount,proto3" json:"parked_count,omitempty"`
-	AvgIdleMs     float64                `protobuf:"fixed64,4,opt,name=avg_idle_ms,json=avgIdleMs,proto3" json:"avg_idle_ms,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PathRequest) GetEndId() string {
+	if x != nil {
+		return x.EndId
+	}
+	return ""
 }
 
-func (x *DepotStat) Reset() {
-	*x = DepotStat{}
-	mi := &file_proto_routegraph_proto_msgTypes[19]
+func (x *PathRequest) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *PathRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *PathRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PathRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *PathRequest) GetAtTs() int64 {
+	if x != nil {
+		return x.AtTs
+	}
+	return 0
+}
+
+func (x *PathRequest) GetAllowWalk() bool {
+	if x != nil {
+		return x.AllowWalk
+	}
+	return false
+}
+
+// the path arriving first, with NEXT edges priced by their profile when reached
+type PathResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	NodeIds []string               `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Hops    int32                  `protobuf:"varint,2,opt,name=hops,proto3" json:"hops,omitempty"`
+	// NEXT or WALK for every hop
+	LinkTypes []string `protobuf:"bytes,3,rep,name=link_types,json=linkTypes,proto3" json:"link_types,omitempty"`
+	// NEXT travel times from the edge profiles at the time each hop is reached, plus WALK walk_time
+	TravelTime int32 `protobuf:"varint,4,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	// at_ts plus travel_time, unix ms
+	ArrivalTs     int64 `protobuf:"varint,5,opt,name=arrival_ts,json=arrivalTs,proto3" json:"arrival_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PathResponse) Reset() {
+	*x = PathResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotStat) String() string {
+func (x *PathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotStat) ProtoMessage() {}
+func (*PathResponse) ProtoMessage() {}
 
-func (x *DepotStat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[19]
+func (x *PathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,61 +1288,69 @@ func (x *DepotStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotStat.ProtoReflect.Descriptor instead.
-func (*DepotStat) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PathResponse.ProtoReflect.Descriptor instead.
+func (*PathResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DepotStat) GetDepotId() string {
+func (x *PathResponse) GetNodeIds() []string {
 	if x != nil {
-		return x.DepotId
+		return x.NodeIds
 	}
-	return ""
+	return nil
 }
 
-func (x *DepotStat) GetDepotName() string {
+func (x *PathResponse) GetHops() int32 {
 	if x != nil {
-		return x.DepotName
+		return x.Hops
 	}
-	return ""
+	return 0
 }
 
-func (x *DepotStat) GetParkedCount() int32 {
+func (x *PathResponse) GetLinkTypes() []string {
 	if x != nil {
-		return x.ParkedCount
+		return x.LinkTypes
+	}
+	return nil
+}
+
+func (x *PathResponse) GetTravelTime() int32 {
+	if x != nil {
+		return x.TravelTime
 	}
 	return 0
 }
 
-func (x *DepotStat) GetAvgIdleMs() float64 {
+func (x *PathResponse) GetArrivalTs() int64 {
 	if x != nil {
-		return x.AvgIdleMs
+		return x.ArrivalTs
 	}
 	return 0
 }
 
-type DepotsResponse struct {
+// Top pairs
+type TopPairsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         []*DepotStat           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepotsResponse) Reset() {
-	*x = DepotsResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[20]
+func (x *TopPairsRequest) Reset() {
+	*x = TopPairsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotsResponse) String() string {
+func (x *TopPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotsResponse) ProtoMessage() {}
+func (*TopPairsRequest) ProtoMessage() {}
 
-func (x *DepotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[20]
+func (x *TopPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,40 +1361,42 @@ func (x *DepotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotsResponse.ProtoReflect.Descriptor instead.
-func (*DepotsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TopPairsRequest.ProtoReflect.Descriptor instead.
+func (*TopPairsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DepotsResponse) GetStats() []*DepotStat {
+func (x *TopPairsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Stats
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type NextListRequest struct {
+type Pair struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Lines         int32                  `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NextListRequest) Reset() {
-	*x = NextListRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[21]
+func (x *Pair) Reset() {
+	*x = Pair{}
+	mi := &file_proto_routegraph_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NextListRequest) String() string {
+func (x *Pair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextListRequest) ProtoMessage() {}
+func (*Pair) ProtoMessage() {}
 
-func (x *NextListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[21]
+func (x *Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,40 +1407,54 @@ func (x *NextListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextListRequest.ProtoReflect.Descriptor instead.
-func (*NextListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
+func (*Pair) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *NextListRequest) GetStopId() string {
+func (x *Pair) GetFrom() string {
 	if x != nil {
-		return x.StopId
+		return x.From
 	}
 	return ""
 }
 
-type NextListResponse struct {
+func (x *Pair) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Pair) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+type TopPairsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Edges         []*NextEdge            `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	Pairs         []*Pair                `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NextListResponse) Reset() {
-	*x = NextListResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[22]
+func (x *TopPairsResponse) Reset() {
+	*x = TopPairsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NextListResponse) String() string {
+func (x *TopPairsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextListResponse) ProtoMessage() {}
+func (*TopPairsResponse) ProtoMessage() {}
 
-func (x *NextListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[22]
+func (x *TopPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,40 +1465,41 @@ func (x *NextListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextListResponse.ProtoReflect.Descriptor instead.
-func (*NextListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TopPairsResponse.ProtoReflect.Descriptor instead.
+func (*TopPairsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *NextListResponse) GetEdges() []*NextEdge {
+func (x *TopPairsResponse) GetPairs() []*Pair {
 	if x != nil {
-		return x.Edges
+		return x.Pairs
 	}
 	return nil
 }
 
-type ServesListRequest struct {
+// Depots idle stats
+type DepotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServesListRequest) Reset() {
-	*x = ServesListRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[23]
+func (x *DepotsRequest) Reset() {
+	*x = DepotsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServesListRequest) String() string {
+func (x *DepotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServesListRequest) ProtoMessage() {}
+func (*DepotsRequest) ProtoMessage() {}
 
-func (x *ServesListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[23]
+func (x *DepotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,84 +1510,7028 @@ func (x *ServesListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServesListRequest.ProtoReflect.Descriptor instead.
-func (*ServesListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DepotsRequest.ProtoReflect.Descriptor instead.
+func (*DepotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ServesListRequest) GetLineId() string {
+func (x *DepotsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.LineId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type ServesListResponse struct {
+type DepotStat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Edges         []*ServesEdge          `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	DepotId       string                 `protobuf:"bytes,1,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	DepotName     string                 `protobuf:"bytes,2,opt,name=depot_name,json=depotName,proto3" json:"depot_name,omitempty"`
+	ParkedCount   int32                  `protobuf:"varint,3,opt,name=parked_count,json=parkedCount,proto3" json:"parked_count,omitempty"`
+	AvgIdleMs     float64                `protobuf:"fixed64,4,opt,name=avg_idle_ms,json=avgIdleMs,proto3" json:"avg_idle_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServesListResponse) Reset() {
-	*x = ServesListResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[24]
+func (x *DepotStat) Reset() {
+	*x = DepotStat{}
+	mi := &file_proto_routegraph_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServesListResponse) String() string {
+func (x *DepotStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServesListResponse) ProtoMessage() {}
+func (*DepotStat) ProtoMessage() {}
+
+func (x *DepotStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepotStat.ProtoReflect.Descriptor instead.
+func (*DepotStat) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DepotStat) GetDepotId() string {
+	if x != nil {
+		return x.DepotId
+	}
+	return ""
+}
+
+func (x *DepotStat) GetDepotName() string {
+	if x != nil {
+		return x.DepotName
+	}
+	return ""
+}
+
+func (x *DepotStat) GetParkedCount() int32 {
+	if x != nil {
+		return x.ParkedCount
+	}
+	return 0
+}
+
+func (x *DepotStat) GetAvgIdleMs() float64 {
+	if x != nil {
+		return x.AvgIdleMs
+	}
+	return 0
+}
+
+type DepotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*DepotStat           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepotsResponse) Reset() {
+	*x = DepotsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepotsResponse) ProtoMessage() {}
+
+func (x *DepotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepotsResponse.ProtoReflect.Descriptor instead.
+func (*DepotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DepotsResponse) GetStats() []*DepotStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type NextListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextListRequest) Reset() {
+	*x = NextListRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextListRequest) ProtoMessage() {}
+
+func (x *NextListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextListRequest.ProtoReflect.Descriptor instead.
+func (*NextListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NextListRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+type NextListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*NextEdge            `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextListResponse) Reset() {
+	*x = NextListResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextListResponse) ProtoMessage() {}
+
+func (x *NextListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextListResponse.ProtoReflect.Descriptor instead.
+func (*NextListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NextListResponse) GetEdges() []*NextEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type ServesListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServesListRequest) Reset() {
+	*x = ServesListRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServesListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServesListRequest) ProtoMessage() {}
+
+func (x *ServesListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServesListRequest.ProtoReflect.Descriptor instead.
+func (*ServesListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ServesListRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *ServesListRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ServesListRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type ServesListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         []*ServesEdge          `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServesListResponse) Reset() {
+	*x = ServesListResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServesListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServesListResponse) ProtoMessage() {}
+
+func (x *ServesListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServesListResponse.ProtoReflect.Descriptor instead.
+func (*ServesListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ServesListResponse) GetEdges() []*ServesEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type AssignedListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleUuid   string                 `protobuf:"bytes,1,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignedListRequest) Reset() {
+	*x = AssignedListRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedListRequest) ProtoMessage() {}
+
+func (x *AssignedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedListRequest.ProtoReflect.Descriptor instead.
+func (*AssignedListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssignedListRequest) GetVehicleUuid() string {
+	if x != nil {
+		return x.VehicleUuid
+	}
+	return ""
+}
+
+type AssignedListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*AssignedTo          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignedListResponse) Reset() {
+	*x = AssignedListResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedListResponse) ProtoMessage() {}
+
+func (x *AssignedListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedListResponse.ProtoReflect.Descriptor instead.
+func (*AssignedListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AssignedListResponse) GetAssignments() []*AssignedTo {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ParkedListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepotId       string                 `protobuf:"bytes,1,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParkedListRequest) Reset() {
+	*x = ParkedListRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParkedListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParkedListRequest) ProtoMessage() {}
+
+func (x *ParkedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParkedListRequest.ProtoReflect.Descriptor instead.
+func (*ParkedListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ParkedListRequest) GetDepotId() string {
+	if x != nil {
+		return x.DepotId
+	}
+	return ""
+}
+
+type ParkedListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parked        []*ParkedAt            `protobuf:"bytes,1,rep,name=parked,proto3" json:"parked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParkedListResponse) Reset() {
+	*x = ParkedListResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParkedListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParkedListResponse) ProtoMessage() {}
+
+func (x *ParkedListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParkedListResponse.ProtoReflect.Descriptor instead.
+func (*ParkedListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ParkedListResponse) GetParked() []*ParkedAt {
+	if x != nil {
+		return x.Parked
+	}
+	return nil
+}
+
+// Route editing
+type RouteStop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	TravelTime    int32                  `protobuf:"varint,2,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	Distance      int32                  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteStop) Reset() {
+	*x = RouteStop{}
+	mi := &file_proto_routegraph_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStop) ProtoMessage() {}
+
+func (x *RouteStop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStop.ProtoReflect.Descriptor instead.
+func (*RouteStop) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RouteStop) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *RouteStop) GetTravelTime() int32 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *RouteStop) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type SetLineRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Stops         []*RouteStop           `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	Direction     string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLineRouteRequest) Reset() {
+	*x = SetLineRouteRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLineRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLineRouteRequest) ProtoMessage() {}
+
+func (x *SetLineRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLineRouteRequest.ProtoReflect.Descriptor instead.
+func (*SetLineRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetLineRouteRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *SetLineRouteRequest) GetStops() []*RouteStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *SetLineRouteRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *SetLineRouteRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type InsertStopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	StopId        string                 `protobuf:"bytes,2,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	Order         int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	TravelTimeIn  int32                  `protobuf:"varint,4,opt,name=travel_time_in,json=travelTimeIn,proto3" json:"travel_time_in,omitempty"`
+	DistanceIn    int32                  `protobuf:"varint,5,opt,name=distance_in,json=distanceIn,proto3" json:"distance_in,omitempty"`
+	TravelTimeOut int32                  `protobuf:"varint,6,opt,name=travel_time_out,json=travelTimeOut,proto3" json:"travel_time_out,omitempty"`
+	DistanceOut   int32                  `protobuf:"varint,7,opt,name=distance_out,json=distanceOut,proto3" json:"distance_out,omitempty"`
+	Direction     string                 `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,9,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertStopRequest) Reset() {
+	*x = InsertStopRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertStopRequest) ProtoMessage() {}
+
+func (x *InsertStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertStopRequest.ProtoReflect.Descriptor instead.
+func (*InsertStopRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InsertStopRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *InsertStopRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *InsertStopRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *InsertStopRequest) GetTravelTimeIn() int32 {
+	if x != nil {
+		return x.TravelTimeIn
+	}
+	return 0
+}
+
+func (x *InsertStopRequest) GetDistanceIn() int32 {
+	if x != nil {
+		return x.DistanceIn
+	}
+	return 0
+}
+
+func (x *InsertStopRequest) GetTravelTimeOut() int32 {
+	if x != nil {
+		return x.TravelTimeOut
+	}
+	return 0
+}
+
+func (x *InsertStopRequest) GetDistanceOut() int32 {
+	if x != nil {
+		return x.DistanceOut
+	}
+	return 0
+}
+
+func (x *InsertStopRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *InsertStopRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type RemoveStopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	StopId        string                 `protobuf:"bytes,2,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	TravelTime    int32                  `protobuf:"varint,3,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	Distance      int32                  `protobuf:"varint,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	Direction     string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStopRequest) Reset() {
+	*x = RemoveStopRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStopRequest) ProtoMessage() {}
+
+func (x *RemoveStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStopRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStopRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveStopRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *RemoveStopRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *RemoveStopRequest) GetTravelTime() int32 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *RemoveStopRequest) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *RemoveStopRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *RemoveStopRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type LineRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Stops         []*ServesEdge          `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	CreatedEdges  []*NextEdge            `protobuf:"bytes,3,rep,name=created_edges,json=createdEdges,proto3" json:"created_edges,omitempty"`
+	RemovedEdges  []*NextEdge            `protobuf:"bytes,4,rep,name=removed_edges,json=removedEdges,proto3" json:"removed_edges,omitempty"`
+	Direction     string                 `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineRouteResponse) Reset() {
+	*x = LineRouteResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineRouteResponse) ProtoMessage() {}
+
+func (x *LineRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineRouteResponse.ProtoReflect.Descriptor instead.
+func (*LineRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LineRouteResponse) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineRouteResponse) GetStops() []*ServesEdge {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *LineRouteResponse) GetCreatedEdges() []*NextEdge {
+	if x != nil {
+		return x.CreatedEdges
+	}
+	return nil
+}
+
+func (x *LineRouteResponse) GetRemovedEdges() []*NextEdge {
+	if x != nil {
+		return x.RemovedEdges
+	}
+	return nil
+}
+
+func (x *LineRouteResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *LineRouteResponse) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// Route patterns
+type RoutePattern struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// OUTBOUND when empty; DeletePattern only removes the pattern of this direction
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// optional active window, "HH:MM"; empty means all day
+	StartTime     string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Stops         int32  `protobuf:"varint,7,opt,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutePattern) Reset() {
+	*x = RoutePattern{}
+	mi := &file_proto_routegraph_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePattern) ProtoMessage() {}
+
+func (x *RoutePattern) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutePattern.ProtoReflect.Descriptor instead.
+func (*RoutePattern) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RoutePattern) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoutePattern) GetStops() int32 {
+	if x != nil {
+		return x.Stops
+	}
+	return 0
+}
+
+type ListPatternsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	ActiveAt      string                 `protobuf:"bytes,2,opt,name=active_at,json=activeAt,proto3" json:"active_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatternsRequest) Reset() {
+	*x = ListPatternsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatternsRequest) ProtoMessage() {}
+
+func (x *ListPatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatternsRequest.ProtoReflect.Descriptor instead.
+func (*ListPatternsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPatternsRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *ListPatternsRequest) GetActiveAt() string {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return ""
+}
+
+type ListPatternsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patterns      []*RoutePattern        `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatternsResponse) Reset() {
+	*x = ListPatternsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatternsResponse) ProtoMessage() {}
+
+func (x *ListPatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatternsResponse.ProtoReflect.Descriptor instead.
+func (*ListPatternsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListPatternsResponse) GetPatterns() []*RoutePattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// Service calendar
+type ServicePeriod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WEEKDAY, SATURDAY, SUNDAY or HOLIDAY
+	DayType string `protobuf:"bytes,1,opt,name=day_type,json=dayType,proto3" json:"day_type,omitempty"`
+	// "HH:MM" band, end exclusive
+	StartTime     string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	FrequencyMins int32  `protobuf:"varint,4,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePeriod) Reset() {
+	*x = ServicePeriod{}
+	mi := &file_proto_routegraph_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePeriod) ProtoMessage() {}
+
+func (x *ServicePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePeriod.ProtoReflect.Descriptor instead.
+func (*ServicePeriod) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ServicePeriod) GetDayType() string {
+	if x != nil {
+		return x.DayType
+	}
+	return ""
+}
+
+func (x *ServicePeriod) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ServicePeriod) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ServicePeriod) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+type ServicePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Periods       []*ServicePeriod       `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePeriodsRequest) Reset() {
+	*x = ServicePeriodsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePeriodsRequest) ProtoMessage() {}
+
+func (x *ServicePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ServicePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ServicePeriodsRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *ServicePeriodsRequest) GetPeriods() []*ServicePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type ServicePeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	FrequencyMins int32                  `protobuf:"varint,2,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	Periods       []*ServicePeriod       `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePeriodsResponse) Reset() {
+	*x = ServicePeriodsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePeriodsResponse) ProtoMessage() {}
+
+func (x *ServicePeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ServicePeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ServicePeriodsResponse) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *ServicePeriodsResponse) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+func (x *ServicePeriodsResponse) GetPeriods() []*ServicePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_proto_routegraph_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type HolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HolidaysResponse) Reset() {
+	*x = HolidaysResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidaysResponse) ProtoMessage() {}
+
+func (x *HolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidaysResponse.ProtoReflect.Descriptor instead.
+func (*HolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HolidaysResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type LineFrequencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	At            int64                  `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineFrequencyRequest) Reset() {
+	*x = LineFrequencyRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineFrequencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineFrequencyRequest) ProtoMessage() {}
+
+func (x *LineFrequencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineFrequencyRequest.ProtoReflect.Descriptor instead.
+func (*LineFrequencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LineFrequencyRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineFrequencyRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type LineFrequencyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	At     int64                  `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"`
+	// day type of the periods in use, SUNDAY on a holiday when the line has no HOLIDAY periods
+	DayType       string         `protobuf:"bytes,3,opt,name=day_type,json=dayType,proto3" json:"day_type,omitempty"`
+	FrequencyMins int32          `protobuf:"varint,4,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	Period        *ServicePeriod `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"`
+	InService     bool           `protobuf:"varint,6,opt,name=in_service,json=inService,proto3" json:"in_service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineFrequencyResponse) Reset() {
+	*x = LineFrequencyResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineFrequencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineFrequencyResponse) ProtoMessage() {}
+
+func (x *LineFrequencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineFrequencyResponse.ProtoReflect.Descriptor instead.
+func (*LineFrequencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LineFrequencyResponse) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineFrequencyResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *LineFrequencyResponse) GetDayType() string {
+	if x != nil {
+		return x.DayType
+	}
+	return ""
+}
+
+func (x *LineFrequencyResponse) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+func (x *LineFrequencyResponse) GetPeriod() *ServicePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *LineFrequencyResponse) GetInService() bool {
+	if x != nil {
+		return x.InService
+	}
+	return false
+}
+
+// Network validation
+type ValidateNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateNetworkRequest) Reset() {
+	*x = ValidateNetworkRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateNetworkRequest) ProtoMessage() {}
+
+func (x *ValidateNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateNetworkRequest.ProtoReflect.Descriptor instead.
+func (*ValidateNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ValidateNetworkRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+type ValidationFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	LineId        string                 `protobuf:"bytes,3,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	StopId        string                 `protobuf:"bytes,4,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Direction     string                 `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationFinding) Reset() {
+	*x = ValidationFinding{}
+	mi := &file_proto_routegraph_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationFinding) ProtoMessage() {}
+
+func (x *ValidationFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationFinding.ProtoReflect.Descriptor instead.
+func (*ValidationFinding) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ValidationFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type ValidateNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*ValidationFinding   `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Errors        int32                  `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"`
+	Warnings      int32                  `protobuf:"varint,3,opt,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateNetworkResponse) Reset() {
+	*x = ValidateNetworkResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateNetworkResponse) ProtoMessage() {}
+
+func (x *ValidateNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateNetworkResponse.ProtoReflect.Descriptor instead.
+func (*ValidateNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ValidateNetworkResponse) GetFindings() []*ValidationFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ValidateNetworkResponse) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *ValidateNetworkResponse) GetWarnings() int32 {
+	if x != nil {
+		return x.Warnings
+	}
+	return 0
+}
+
+// Timetable
+type TimetableRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	// YYYY-MM-DD, empty = today
+	Date      string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern   string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// dwell at every intermediate stop in seconds
+	DwellSecs int32 `protobuf:"varint,5,opt,name=dwell_secs,json=dwellSecs,proto3" json:"dwell_secs,omitempty"`
+	// per-stop dwell in seconds, overrides dwell_secs and Stop.dwell_time
+	StopDwellSecs map[string]int32 `protobuf:"bytes,6,rep,name=stop_dwell_secs,json=stopDwellSecs,proto3" json:"stop_dwell_secs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IncludeCsv    bool             `protobuf:"varint,7,opt,name=include_csv,json=includeCsv,proto3" json:"include_csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableRequest) Reset() {
+	*x = TimetableRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableRequest) ProtoMessage() {}
+
+func (x *TimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableRequest.ProtoReflect.Descriptor instead.
+func (*TimetableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TimetableRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *TimetableRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TimetableRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TimetableRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TimetableRequest) GetDwellSecs() int32 {
+	if x != nil {
+		return x.DwellSecs
+	}
+	return 0
+}
+
+func (x *TimetableRequest) GetStopDwellSecs() map[string]int32 {
+	if x != nil {
+		return x.StopDwellSecs
+	}
+	return nil
+}
+
+func (x *TimetableRequest) GetIncludeCsv() bool {
+	if x != nil {
+		return x.IncludeCsv
+	}
+	return false
+}
+
+type TimetableStop struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	StopId string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Order  int32                  `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	// scheduled arrival in seconds after the first-stop departure
+	OffsetSecs    int32 `protobuf:"varint,4,opt,name=offset_secs,json=offsetSecs,proto3" json:"offset_secs,omitempty"`
+	DwellSecs     int32 `protobuf:"varint,5,opt,name=dwell_secs,json=dwellSecs,proto3" json:"dwell_secs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableStop) Reset() {
+	*x = TimetableStop{}
+	mi := &file_proto_routegraph_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableStop) ProtoMessage() {}
+
+func (x *TimetableStop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableStop.ProtoReflect.Descriptor instead.
+func (*TimetableStop) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TimetableStop) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *TimetableStop) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TimetableStop) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *TimetableStop) GetOffsetSecs() int32 {
+	if x != nil {
+		return x.OffsetSecs
+	}
+	return 0
+}
+
+func (x *TimetableStop) GetDwellSecs() int32 {
+	if x != nil {
+		return x.DwellSecs
+	}
+	return 0
+}
+
+type TimetableTrip struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Departure string                 `protobuf:"bytes,1,opt,name=departure,proto3" json:"departure,omitempty"`
+	// arrival "HH:MM" at every stop, in stop order
+	Times         []string `protobuf:"bytes,2,rep,name=times,proto3" json:"times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableTrip) Reset() {
+	*x = TimetableTrip{}
+	mi := &file_proto_routegraph_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableTrip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableTrip) ProtoMessage() {}
+
+func (x *TimetableTrip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableTrip.ProtoReflect.Descriptor instead.
+func (*TimetableTrip) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TimetableTrip) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+func (x *TimetableTrip) GetTimes() []string {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type TimetableResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Date   string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// day type of the periods in use, as in LineFrequencyResponse
+	DayType       string           `protobuf:"bytes,3,opt,name=day_type,json=dayType,proto3" json:"day_type,omitempty"`
+	Direction     string           `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern       string           `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Stops         []*TimetableStop `protobuf:"bytes,6,rep,name=stops,proto3" json:"stops,omitempty"`
+	Trips         []*TimetableTrip `protobuf:"bytes,7,rep,name=trips,proto3" json:"trips,omitempty"`
+	Csv           string           `protobuf:"bytes,8,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableResponse) Reset() {
+	*x = TimetableResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableResponse) ProtoMessage() {}
+
+func (x *TimetableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableResponse.ProtoReflect.Descriptor instead.
+func (*TimetableResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TimetableResponse) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *TimetableResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TimetableResponse) GetDayType() string {
+	if x != nil {
+		return x.DayType
+	}
+	return ""
+}
+
+func (x *TimetableResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TimetableResponse) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TimetableResponse) GetStops() []*TimetableStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *TimetableResponse) GetTrips() []*TimetableTrip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+func (x *TimetableResponse) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+// Departure board
+type DepartureBoardRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	StopId string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	// departures per line, default 3
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// unix seconds, 0 = now
+	At int64 `protobuf:"varint,3,opt,name=at,proto3" json:"at,omitempty"`
+	// refresh period of StreamDepartureBoard, default 10
+	IntervalSecs  int32 `protobuf:"varint,4,opt,name=interval_secs,json=intervalSecs,proto3" json:"interval_secs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartureBoardRequest) Reset() {
+	*x = DepartureBoardRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartureBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartureBoardRequest) ProtoMessage() {}
+
+func (x *DepartureBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartureBoardRequest.ProtoReflect.Descriptor instead.
+func (*DepartureBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DepartureBoardRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *DepartureBoardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DepartureBoardRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *DepartureBoardRequest) GetIntervalSecs() int32 {
+	if x != nil {
+		return x.IntervalSecs
+	}
+	return 0
+}
+
+type Departure struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LineId      string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	LineName    string                 `protobuf:"bytes,2,opt,name=line_name,json=lineName,proto3" json:"line_name,omitempty"`
+	Direction   string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern     string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Destination string                 `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	// "HH:MM", empty for a live vehicle outside the timetable
+	Scheduled     string `protobuf:"bytes,6,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	ExpectedAt    int64  `protobuf:"varint,7,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	Minutes       int32  `protobuf:"varint,8,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Realtime      bool   `protobuf:"varint,9,opt,name=realtime,proto3" json:"realtime,omitempty"`
+	VehicleId     string `protobuf:"bytes,10,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Departure) Reset() {
+	*x = Departure{}
+	mi := &file_proto_routegraph_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Departure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departure) ProtoMessage() {}
+
+func (x *Departure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departure.ProtoReflect.Descriptor instead.
+func (*Departure) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Departure) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *Departure) GetLineName() string {
+	if x != nil {
+		return x.LineName
+	}
+	return ""
+}
+
+func (x *Departure) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Departure) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Departure) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Departure) GetScheduled() string {
+	if x != nil {
+		return x.Scheduled
+	}
+	return ""
+}
+
+func (x *Departure) GetExpectedAt() int64 {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return 0
+}
+
+func (x *Departure) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *Departure) GetRealtime() bool {
+	if x != nil {
+		return x.Realtime
+	}
+	return false
+}
+
+func (x *Departure) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+type DepartureBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	StopName      string                 `protobuf:"bytes,2,opt,name=stop_name,json=stopName,proto3" json:"stop_name,omitempty"`
+	GeneratedAt   int64                  `protobuf:"varint,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Departures    []*Departure           `protobuf:"bytes,4,rep,name=departures,proto3" json:"departures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartureBoardResponse) Reset() {
+	*x = DepartureBoardResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartureBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartureBoardResponse) ProtoMessage() {}
+
+func (x *DepartureBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartureBoardResponse.ProtoReflect.Descriptor instead.
+func (*DepartureBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DepartureBoardResponse) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *DepartureBoardResponse) GetStopName() string {
+	if x != nil {
+		return x.StopName
+	}
+	return ""
+}
+
+func (x *DepartureBoardResponse) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *DepartureBoardResponse) GetDepartures() []*Departure {
+	if x != nil {
+		return x.Departures
+	}
+	return nil
+}
+
+// Disruptions
+type Disruption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// STOP or EDGE
+	Kind   string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	StopId string `protobuf:"bytes,3,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	FromId string `protobuf:"bytes,4,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,5,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix ms; start_ts 0 = now, end_ts 0 = until ended
+	StartTs       int64     `protobuf:"varint,7,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs         int64     `protobuf:"varint,8,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	Active        bool      `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	Detours       []*Detour `protobuf:"bytes,10,rep,name=detours,proto3" json:"detours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Disruption) Reset() {
+	*x = Disruption{}
+	mi := &file_proto_routegraph_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Disruption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disruption) ProtoMessage() {}
+
+func (x *Disruption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disruption.ProtoReflect.Descriptor instead.
+func (*Disruption) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Disruption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Disruption) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Disruption) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *Disruption) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *Disruption) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *Disruption) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Disruption) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *Disruption) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
+func (x *Disruption) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Disruption) GetDetours() []*Detour {
+	if x != nil {
+		return x.Detours
+	}
+	return nil
+}
+
+type Detour struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	LineId    string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Direction string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Pattern   string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// last open stop before and first open stop after the closure
+	FromId        string   `protobuf:"bytes,4,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId          string   `protobuf:"bytes,5,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	SkippedStops  []string `protobuf:"bytes,6,rep,name=skipped_stops,json=skippedStops,proto3" json:"skipped_stops,omitempty"`
+	Path          []string `protobuf:"bytes,7,rep,name=path,proto3" json:"path,omitempty"`
+	OriginalTime  int32    `protobuf:"varint,8,opt,name=original_time,json=originalTime,proto3" json:"original_time,omitempty"`
+	DetourTime    int32    `protobuf:"varint,9,opt,name=detour_time,json=detourTime,proto3" json:"detour_time,omitempty"`
+	AddedTime     int32    `protobuf:"varint,10,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	Found         bool     `protobuf:"varint,11,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Detour) Reset() {
+	*x = Detour{}
+	mi := &file_proto_routegraph_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Detour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Detour) ProtoMessage() {}
+
+func (x *Detour) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Detour.ProtoReflect.Descriptor instead.
+func (*Detour) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Detour) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *Detour) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Detour) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Detour) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *Detour) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *Detour) GetSkippedStops() []string {
+	if x != nil {
+		return x.SkippedStops
+	}
+	return nil
+}
+
+func (x *Detour) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Detour) GetOriginalTime() int32 {
+	if x != nil {
+		return x.OriginalTime
+	}
+	return 0
+}
+
+func (x *Detour) GetDetourTime() int32 {
+	if x != nil {
+		return x.DetourTime
+	}
+	return 0
+}
+
+func (x *Detour) GetAddedTime() int32 {
+	if x != nil {
+		return x.AddedTime
+	}
+	return 0
+}
+
+func (x *Detour) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type ListDisruptionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// unix ms, 0 = now
+	AtTs          int64 `protobuf:"varint,2,opt,name=at_ts,json=atTs,proto3" json:"at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisruptionsRequest) Reset() {
+	*x = ListDisruptionsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisruptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisruptionsRequest) ProtoMessage() {}
+
+func (x *ListDisruptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisruptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDisruptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListDisruptionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListDisruptionsRequest) GetAtTs() int64 {
+	if x != nil {
+		return x.AtTs
+	}
+	return 0
+}
+
+type ListDisruptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disruptions   []*Disruption          `protobuf:"bytes,1,rep,name=disruptions,proto3" json:"disruptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisruptionsResponse) Reset() {
+	*x = ListDisruptionsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisruptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisruptionsResponse) ProtoMessage() {}
+
+func (x *ListDisruptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisruptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDisruptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListDisruptionsResponse) GetDisruptions() []*Disruption {
+	if x != nil {
+		return x.Disruptions
+	}
+	return nil
+}
+
+// Scenarios
+type ScenarioChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ADD_STOP, REMOVE_STOP, ADD_EDGE, REMOVE_EDGE, SET_ROUTE, SET_FREQUENCY
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// ADD_STOP
+	Stop *Stop `protobuf:"bytes,2,opt,name=stop,proto3" json:"stop,omitempty"`
+	// REMOVE_STOP
+	StopId string `protobuf:"bytes,3,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	// ADD_EDGE, REMOVE_EDGE; travel_time/distance 0 are estimated from coordinates
+	Edge *NextEdge `protobuf:"bytes,4,opt,name=edge,proto3" json:"edge,omitempty"`
+	// SET_ROUTE
+	Route *SetLineRouteRequest `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+	// SET_FREQUENCY: frequency_mins > 0 sets the base headway, periods (if any) replace the service periods
+	Service       *ServicePeriodsResponse `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioChange) Reset() {
+	*x = ScenarioChange{}
+	mi := &file_proto_routegraph_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioChange) ProtoMessage() {}
+
+func (x *ScenarioChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioChange.ProtoReflect.Descriptor instead.
+func (*ScenarioChange) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ScenarioChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScenarioChange) GetStop() *Stop {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *ScenarioChange) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *ScenarioChange) GetEdge() *NextEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *ScenarioChange) GetRoute() *SetLineRouteRequest {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *ScenarioChange) GetService() *ServicePeriodsResponse {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type Scenario struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// DRAFT or PROMOTED
+	Status        string            `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedTs     int64             `protobuf:"varint,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	PromotedTs    int64             `protobuf:"varint,6,opt,name=promoted_ts,json=promotedTs,proto3" json:"promoted_ts,omitempty"`
+	Changes       []*ScenarioChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_proto_routegraph_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Scenario) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Scenario) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Scenario) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *Scenario) GetPromotedTs() int64 {
+	if x != nil {
+		return x.PromotedTs
+	}
+	return 0
+}
+
+func (x *Scenario) GetChanges() []*ScenarioChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ScenariosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenarios     []*Scenario            `protobuf:"bytes,1,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenariosResponse) Reset() {
+	*x = ScenariosResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenariosResponse) ProtoMessage() {}
+
+func (x *ScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ScenariosResponse) GetScenarios() []*Scenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+type EvaluateScenarioRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScenarioId string                 `protobuf:"bytes,1,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"`
+	// optional path to compare
+	StartId string `protobuf:"bytes,2,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   string `protobuf:"bytes,3,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	// size of the top pairs / top stops lists, default 5
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// unix seconds used to evaluate service periods, 0 = now
+	At            int64 `protobuf:"varint,5,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateScenarioRequest) Reset() {
+	*x = EvaluateScenarioRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateScenarioRequest) ProtoMessage() {}
+
+func (x *EvaluateScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateScenarioRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *EvaluateScenarioRequest) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+func (x *EvaluateScenarioRequest) GetStartId() string {
+	if x != nil {
+		return x.StartId
+	}
+	return ""
+}
+
+func (x *EvaluateScenarioRequest) GetEndId() string {
+	if x != nil {
+		return x.EndId
+	}
+	return ""
+}
+
+func (x *EvaluateScenarioRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *EvaluateScenarioRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type ConnectedStop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	StopName      string                 `protobuf:"bytes,2,opt,name=stop_name,json=stopName,proto3" json:"stop_name,omitempty"`
+	Degree        int32                  `protobuf:"varint,3,opt,name=degree,proto3" json:"degree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectedStop) Reset() {
+	*x = ConnectedStop{}
+	mi := &file_proto_routegraph_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectedStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectedStop) ProtoMessage() {}
+
+func (x *ConnectedStop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectedStop.ProtoReflect.Descriptor instead.
+func (*ConnectedStop) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ConnectedStop) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *ConnectedStop) GetStopName() string {
+	if x != nil {
+		return x.StopName
+	}
+	return ""
+}
+
+func (x *ConnectedStop) GetDegree() int32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+type LineFleetSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	FrequencyMins int32                  `protobuf:"varint,2,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	CycleSecs     int32                  `protobuf:"varint,3,opt,name=cycle_secs,json=cycleSecs,proto3" json:"cycle_secs,omitempty"`
+	Required      int32                  `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Assigned      int32                  `protobuf:"varint,5,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineFleetSize) Reset() {
+	*x = LineFleetSize{}
+	mi := &file_proto_routegraph_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineFleetSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineFleetSize) ProtoMessage() {}
+
+func (x *LineFleetSize) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineFleetSize.ProtoReflect.Descriptor instead.
+func (*LineFleetSize) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LineFleetSize) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineFleetSize) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+func (x *LineFleetSize) GetCycleSecs() int32 {
+	if x != nil {
+		return x.CycleSecs
+	}
+	return 0
+}
+
+func (x *LineFleetSize) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *LineFleetSize) GetAssigned() int32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+type NetworkMetrics struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Path             *PathResponse          `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PathTravelTime   int32                  `protobuf:"varint,2,opt,name=path_travel_time,json=pathTravelTime,proto3" json:"path_travel_time,omitempty"`
+	TopPairs         []*Pair                `protobuf:"bytes,3,rep,name=top_pairs,json=topPairs,proto3" json:"top_pairs,omitempty"`
+	TopStops         []*ConnectedStop       `protobuf:"bytes,4,rep,name=top_stops,json=topStops,proto3" json:"top_stops,omitempty"`
+	Fleet            []*LineFleetSize       `protobuf:"bytes,5,rep,name=fleet,proto3" json:"fleet,omitempty"`
+	RequiredVehicles int32                  `protobuf:"varint,6,opt,name=required_vehicles,json=requiredVehicles,proto3" json:"required_vehicles,omitempty"`
+	Warnings         []string               `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NetworkMetrics) Reset() {
+	*x = NetworkMetrics{}
+	mi := &file_proto_routegraph_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkMetrics) ProtoMessage() {}
+
+func (x *NetworkMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkMetrics.ProtoReflect.Descriptor instead.
+func (*NetworkMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *NetworkMetrics) GetPath() *PathResponse {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *NetworkMetrics) GetPathTravelTime() int32 {
+	if x != nil {
+		return x.PathTravelTime
+	}
+	return 0
+}
+
+func (x *NetworkMetrics) GetTopPairs() []*Pair {
+	if x != nil {
+		return x.TopPairs
+	}
+	return nil
+}
+
+func (x *NetworkMetrics) GetTopStops() []*ConnectedStop {
+	if x != nil {
+		return x.TopStops
+	}
+	return nil
+}
+
+func (x *NetworkMetrics) GetFleet() []*LineFleetSize {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *NetworkMetrics) GetRequiredVehicles() int32 {
+	if x != nil {
+		return x.RequiredVehicles
+	}
+	return 0
+}
+
+func (x *NetworkMetrics) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type FleetDelta struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LineId          string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	FrequencyBefore int32                  `protobuf:"varint,2,opt,name=frequency_before,json=frequencyBefore,proto3" json:"frequency_before,omitempty"`
+	FrequencyAfter  int32                  `protobuf:"varint,3,opt,name=frequency_after,json=frequencyAfter,proto3" json:"frequency_after,omitempty"`
+	CycleSecsBefore int32                  `protobuf:"varint,4,opt,name=cycle_secs_before,json=cycleSecsBefore,proto3" json:"cycle_secs_before,omitempty"`
+	CycleSecsAfter  int32                  `protobuf:"varint,5,opt,name=cycle_secs_after,json=cycleSecsAfter,proto3" json:"cycle_secs_after,omitempty"`
+	RequiredBefore  int32                  `protobuf:"varint,6,opt,name=required_before,json=requiredBefore,proto3" json:"required_before,omitempty"`
+	RequiredAfter   int32                  `protobuf:"varint,7,opt,name=required_after,json=requiredAfter,proto3" json:"required_after,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FleetDelta) Reset() {
+	*x = FleetDelta{}
+	mi := &file_proto_routegraph_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetDelta) ProtoMessage() {}
+
+func (x *FleetDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetDelta.ProtoReflect.Descriptor instead.
+func (*FleetDelta) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FleetDelta) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *FleetDelta) GetFrequencyBefore() int32 {
+	if x != nil {
+		return x.FrequencyBefore
+	}
+	return 0
+}
+
+func (x *FleetDelta) GetFrequencyAfter() int32 {
+	if x != nil {
+		return x.FrequencyAfter
+	}
+	return 0
+}
+
+func (x *FleetDelta) GetCycleSecsBefore() int32 {
+	if x != nil {
+		return x.CycleSecsBefore
+	}
+	return 0
+}
+
+func (x *FleetDelta) GetCycleSecsAfter() int32 {
+	if x != nil {
+		return x.CycleSecsAfter
+	}
+	return 0
+}
+
+func (x *FleetDelta) GetRequiredBefore() int32 {
+	if x != nil {
+		return x.RequiredBefore
+	}
+	return 0
+}
+
+func (x *FleetDelta) GetRequiredAfter() int32 {
+	if x != nil {
+		return x.RequiredAfter
+	}
+	return 0
+}
+
+type ScenarioDiff struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	StopsAdded   []string               `protobuf:"bytes,1,rep,name=stops_added,json=stopsAdded,proto3" json:"stops_added,omitempty"`
+	StopsRemoved []string               `protobuf:"bytes,2,rep,name=stops_removed,json=stopsRemoved,proto3" json:"stops_removed,omitempty"`
+	// new or changed NEXT edges
+	EdgesAdded            []*NextEdge   `protobuf:"bytes,3,rep,name=edges_added,json=edgesAdded,proto3" json:"edges_added,omitempty"`
+	EdgesRemoved          []*NextEdge   `protobuf:"bytes,4,rep,name=edges_removed,json=edgesRemoved,proto3" json:"edges_removed,omitempty"`
+	RoutesChanged         []string      `protobuf:"bytes,5,rep,name=routes_changed,json=routesChanged,proto3" json:"routes_changed,omitempty"`
+	Fleet                 []*FleetDelta `protobuf:"bytes,6,rep,name=fleet,proto3" json:"fleet,omitempty"`
+	RequiredVehiclesDelta int32         `protobuf:"varint,7,opt,name=required_vehicles_delta,json=requiredVehiclesDelta,proto3" json:"required_vehicles_delta,omitempty"`
+	PathHopsDelta         int32         `protobuf:"varint,8,opt,name=path_hops_delta,json=pathHopsDelta,proto3" json:"path_hops_delta,omitempty"`
+	PathTravelTimeDelta   int32         `protobuf:"varint,9,opt,name=path_travel_time_delta,json=pathTravelTimeDelta,proto3" json:"path_travel_time_delta,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ScenarioDiff) Reset() {
+	*x = ScenarioDiff{}
+	mi := &file_proto_routegraph_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioDiff) ProtoMessage() {}
+
+func (x *ScenarioDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioDiff.ProtoReflect.Descriptor instead.
+func (*ScenarioDiff) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScenarioDiff) GetStopsAdded() []string {
+	if x != nil {
+		return x.StopsAdded
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetStopsRemoved() []string {
+	if x != nil {
+		return x.StopsRemoved
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetEdgesAdded() []*NextEdge {
+	if x != nil {
+		return x.EdgesAdded
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetEdgesRemoved() []*NextEdge {
+	if x != nil {
+		return x.EdgesRemoved
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetRoutesChanged() []string {
+	if x != nil {
+		return x.RoutesChanged
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetFleet() []*FleetDelta {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *ScenarioDiff) GetRequiredVehiclesDelta() int32 {
+	if x != nil {
+		return x.RequiredVehiclesDelta
+	}
+	return 0
+}
+
+func (x *ScenarioDiff) GetPathHopsDelta() int32 {
+	if x != nil {
+		return x.PathHopsDelta
+	}
+	return 0
+}
+
+func (x *ScenarioDiff) GetPathTravelTimeDelta() int32 {
+	if x != nil {
+		return x.PathTravelTimeDelta
+	}
+	return 0
+}
+
+type ScenarioEvaluation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScenarioId    string                 `protobuf:"bytes,1,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"`
+	Baseline      *NetworkMetrics        `protobuf:"bytes,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Scenario      *NetworkMetrics        `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Diff          *ScenarioDiff          `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioEvaluation) Reset() {
+	*x = ScenarioEvaluation{}
+	mi := &file_proto_routegraph_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioEvaluation) ProtoMessage() {}
+
+func (x *ScenarioEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioEvaluation.ProtoReflect.Descriptor instead.
+func (*ScenarioEvaluation) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ScenarioEvaluation) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+func (x *ScenarioEvaluation) GetBaseline() *NetworkMetrics {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *ScenarioEvaluation) GetScenario() *NetworkMetrics {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *ScenarioEvaluation) GetDiff() *ScenarioDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// Resilience
+type CriticalElementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// origin-destination pairs sampled for travel times, default 200
+	SamplePairs int32 `protobuf:"varint,1,opt,name=sample_pairs,json=samplePairs,proto3" json:"sample_pairs,omitempty"`
+	// candidates evaluated, default 10
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriticalElementsRequest) Reset() {
+	*x = CriticalElementsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalElementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalElementsRequest) ProtoMessage() {}
+
+func (x *CriticalElementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalElementsRequest.ProtoReflect.Descriptor instead.
+func (*CriticalElementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CriticalElementsRequest) GetSamplePairs() int32 {
+	if x != nil {
+		return x.SamplePairs
+	}
+	return 0
+}
+
+func (x *CriticalElementsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CriticalElement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// STOP or EDGE (an edge closes both directions between from_id and to_id)
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	StopId string `protobuf:"bytes,2,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	FromId string `protobuf:"bytes,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,4,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// articulation point or bridge
+	Cut               bool  `protobuf:"varint,5,opt,name=cut,proto3" json:"cut,omitempty"`
+	Degree            int32 `protobuf:"varint,6,opt,name=degree,proto3" json:"degree,omitempty"`
+	Pairs             int32 `protobuf:"varint,7,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	DisconnectedPairs int32 `protobuf:"varint,8,opt,name=disconnected_pairs,json=disconnectedPairs,proto3" json:"disconnected_pairs,omitempty"`
+	// average shortest travel time in seconds over pairs that stay connected
+	AvgTravelTimeBefore float64 `protobuf:"fixed64,9,opt,name=avg_travel_time_before,json=avgTravelTimeBefore,proto3" json:"avg_travel_time_before,omitempty"`
+	AvgTravelTimeAfter  float64 `protobuf:"fixed64,10,opt,name=avg_travel_time_after,json=avgTravelTimeAfter,proto3" json:"avg_travel_time_after,omitempty"`
+	AvgIncrease         float64 `protobuf:"fixed64,11,opt,name=avg_increase,json=avgIncrease,proto3" json:"avg_increase,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CriticalElement) Reset() {
+	*x = CriticalElement{}
+	mi := &file_proto_routegraph_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalElement) ProtoMessage() {}
+
+func (x *CriticalElement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalElement.ProtoReflect.Descriptor instead.
+func (*CriticalElement) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CriticalElement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CriticalElement) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *CriticalElement) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *CriticalElement) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *CriticalElement) GetCut() bool {
+	if x != nil {
+		return x.Cut
+	}
+	return false
+}
+
+func (x *CriticalElement) GetDegree() int32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *CriticalElement) GetPairs() int32 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+func (x *CriticalElement) GetDisconnectedPairs() int32 {
+	if x != nil {
+		return x.DisconnectedPairs
+	}
+	return 0
+}
+
+func (x *CriticalElement) GetAvgTravelTimeBefore() float64 {
+	if x != nil {
+		return x.AvgTravelTimeBefore
+	}
+	return 0
+}
+
+func (x *CriticalElement) GetAvgTravelTimeAfter() float64 {
+	if x != nil {
+		return x.AvgTravelTimeAfter
+	}
+	return 0
+}
+
+func (x *CriticalElement) GetAvgIncrease() float64 {
+	if x != nil {
+		return x.AvgIncrease
+	}
+	return 0
+}
+
+type CriticalElementsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ArticulationPoints []string               `protobuf:"bytes,1,rep,name=articulation_points,json=articulationPoints,proto3" json:"articulation_points,omitempty"`
+	Bridges            []*NextEdge            `protobuf:"bytes,2,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	SampledPairs       int32                  `protobuf:"varint,3,opt,name=sampled_pairs,json=sampledPairs,proto3" json:"sampled_pairs,omitempty"`
+	AvgTravelTime      float64                `protobuf:"fixed64,4,opt,name=avg_travel_time,json=avgTravelTime,proto3" json:"avg_travel_time,omitempty"`
+	Ranked             []*CriticalElement     `protobuf:"bytes,5,rep,name=ranked,proto3" json:"ranked,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CriticalElementsResponse) Reset() {
+	*x = CriticalElementsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriticalElementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalElementsResponse) ProtoMessage() {}
+
+func (x *CriticalElementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalElementsResponse.ProtoReflect.Descriptor instead.
+func (*CriticalElementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CriticalElementsResponse) GetArticulationPoints() []string {
+	if x != nil {
+		return x.ArticulationPoints
+	}
+	return nil
+}
+
+func (x *CriticalElementsResponse) GetBridges() []*NextEdge {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+func (x *CriticalElementsResponse) GetSampledPairs() int32 {
+	if x != nil {
+		return x.SampledPairs
+	}
+	return 0
+}
+
+func (x *CriticalElementsResponse) GetAvgTravelTime() float64 {
+	if x != nil {
+		return x.AvgTravelTime
+	}
+	return 0
+}
+
+func (x *CriticalElementsResponse) GetRanked() []*CriticalElement {
+	if x != nil {
+		return x.Ranked
+	}
+	return nil
+}
+
+// Centrality
+type StopCentralityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// degree, betweenness, closeness, pagerank or lines; default betweenness
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// 0 = all stops
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopCentralityRequest) Reset() {
+	*x = StopCentralityRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopCentralityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopCentralityRequest) ProtoMessage() {}
+
+func (x *StopCentralityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopCentralityRequest.ProtoReflect.Descriptor instead.
+func (*StopCentralityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StopCentralityRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *StopCentralityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StopCentralityItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	StopName      string                 `protobuf:"bytes,2,opt,name=stop_name,json=stopName,proto3" json:"stop_name,omitempty"`
+	Degree        int32                  `protobuf:"varint,3,opt,name=degree,proto3" json:"degree,omitempty"`
+	Lines         int32                  `protobuf:"varint,4,opt,name=lines,proto3" json:"lines,omitempty"`
+	Betweenness   float64                `protobuf:"fixed64,5,opt,name=betweenness,proto3" json:"betweenness,omitempty"`
+	Closeness     float64                `protobuf:"fixed64,6,opt,name=closeness,proto3" json:"closeness,omitempty"`
+	Pagerank      float64                `protobuf:"fixed64,7,opt,name=pagerank,proto3" json:"pagerank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopCentralityItem) Reset() {
+	*x = StopCentralityItem{}
+	mi := &file_proto_routegraph_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopCentralityItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopCentralityItem) ProtoMessage() {}
+
+func (x *StopCentralityItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopCentralityItem.ProtoReflect.Descriptor instead.
+func (*StopCentralityItem) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *StopCentralityItem) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *StopCentralityItem) GetStopName() string {
+	if x != nil {
+		return x.StopName
+	}
+	return ""
+}
+
+func (x *StopCentralityItem) GetDegree() int32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *StopCentralityItem) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *StopCentralityItem) GetBetweenness() float64 {
+	if x != nil {
+		return x.Betweenness
+	}
+	return 0
+}
+
+func (x *StopCentralityItem) GetCloseness() float64 {
+	if x != nil {
+		return x.Closeness
+	}
+	return 0
+}
+
+func (x *StopCentralityItem) GetPagerank() float64 {
+	if x != nil {
+		return x.Pagerank
+	}
+	return 0
+}
+
+type StopCentralityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        string                 `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Stops         []*StopCentralityItem  `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopCentralityResponse) Reset() {
+	*x = StopCentralityResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopCentralityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopCentralityResponse) ProtoMessage() {}
+
+func (x *StopCentralityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopCentralityResponse.ProtoReflect.Descriptor instead.
+func (*StopCentralityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *StopCentralityResponse) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *StopCentralityResponse) GetStops() []*StopCentralityItem {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+// Walking transfers
+type GenerateWalkLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// default 150
+	RadiusM float64 `protobuf:"fixed64,1,opt,name=radius_m,json=radiusM,proto3" json:"radius_m,omitempty"`
+	// default 1.3
+	WalkSpeedMps float64 `protobuf:"fixed64,2,opt,name=walk_speed_mps,json=walkSpeedMps,proto3" json:"walk_speed_mps,omitempty"`
+	// drop all existing WALK links first
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateWalkLinksRequest) Reset() {
+	*x = GenerateWalkLinksRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWalkLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWalkLinksRequest) ProtoMessage() {}
+
+func (x *GenerateWalkLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWalkLinksRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWalkLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GenerateWalkLinksRequest) GetRadiusM() float64 {
+	if x != nil {
+		return x.RadiusM
+	}
+	return 0
+}
+
+func (x *GenerateWalkLinksRequest) GetWalkSpeedMps() float64 {
+	if x != nil {
+		return x.WalkSpeedMps
+	}
+	return 0
+}
+
+func (x *GenerateWalkLinksRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type GenerateWalkLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Removed       int32                  `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateWalkLinksResponse) Reset() {
+	*x = GenerateWalkLinksResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWalkLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWalkLinksResponse) ProtoMessage() {}
+
+func (x *GenerateWalkLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWalkLinksResponse.ProtoReflect.Descriptor instead.
+func (*GenerateWalkLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GenerateWalkLinksResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *GenerateWalkLinksResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *GenerateWalkLinksResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type TransferHubsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// default 2
+	MinLines      int32 `protobuf:"varint,2,opt,name=min_lines,json=minLines,proto3" json:"min_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferHubsRequest) Reset() {
+	*x = TransferHubsRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferHubsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferHubsRequest) ProtoMessage() {}
+
+func (x *TransferHubsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferHubsRequest.ProtoReflect.Descriptor instead.
+func (*TransferHubsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TransferHubsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TransferHubsRequest) GetMinLines() int32 {
+	if x != nil {
+		return x.MinLines
+	}
+	return 0
+}
+
+// a centre stop and the stops within walking distance of it
+type TransferHub struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StopIds []string               `protobuf:"bytes,1,rep,name=stop_ids,json=stopIds,proto3" json:"stop_ids,omitempty"`
+	LineIds []string               `protobuf:"bytes,2,rep,name=line_ids,json=lineIds,proto3" json:"line_ids,omitempty"`
+	Lines   int32                  `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
+	// position of the centre stop
+	Lat float64 `protobuf:"fixed64,4,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon float64 `protobuf:"fixed64,5,opt,name=lon,proto3" json:"lon,omitempty"`
+	// longest WALK link from the centre in seconds
+	MaxWalkTime   int32  `protobuf:"varint,6,opt,name=max_walk_time,json=maxWalkTime,proto3" json:"max_walk_time,omitempty"`
+	CentreStopId  string `protobuf:"bytes,7,opt,name=centre_stop_id,json=centreStopId,proto3" json:"centre_stop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferHub) Reset() {
+	*x = TransferHub{}
+	mi := &file_proto_routegraph_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferHub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferHub) ProtoMessage() {}
+
+func (x *TransferHub) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferHub.ProtoReflect.Descriptor instead.
+func (*TransferHub) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TransferHub) GetStopIds() []string {
+	if x != nil {
+		return x.StopIds
+	}
+	return nil
+}
+
+func (x *TransferHub) GetLineIds() []string {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *TransferHub) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *TransferHub) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *TransferHub) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *TransferHub) GetMaxWalkTime() int32 {
+	if x != nil {
+		return x.MaxWalkTime
+	}
+	return 0
+}
+
+func (x *TransferHub) GetCentreStopId() string {
+	if x != nil {
+		return x.CentreStopId
+	}
+	return ""
+}
+
+type TransferHubsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hubs          []*TransferHub         `protobuf:"bytes,1,rep,name=hubs,proto3" json:"hubs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferHubsResponse) Reset() {
+	*x = TransferHubsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferHubsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferHubsResponse) ProtoMessage() {}
+
+func (x *TransferHubsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferHubsResponse.ProtoReflect.Descriptor instead.
+func (*TransferHubsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TransferHubsResponse) GetHubs() []*TransferHub {
+	if x != nil {
+		return x.Hubs
+	}
+	return nil
+}
+
+// Connectivity
+type ConnectivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max distance between stops proposed for a new link, default 500
+	RadiusM float64 `protobuf:"fixed64,1,opt,name=radius_m,json=radiusM,proto3" json:"radius_m,omitempty"`
+	// link candidates returned, default 20
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectivityRequest) Reset() {
+	*x = ConnectivityRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectivityRequest) ProtoMessage() {}
+
+func (x *ConnectivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectivityRequest.ProtoReflect.Descriptor instead.
+func (*ConnectivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ConnectivityRequest) GetRadiusM() float64 {
+	if x != nil {
+		return x.RadiusM
+	}
+	return 0
+}
+
+func (x *ConnectivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StopComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index in the component list, 0 = largest
+	Id            int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size          int32    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	StopIds       []string `protobuf:"bytes,3,rep,name=stop_ids,json=stopIds,proto3" json:"stop_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopComponent) Reset() {
+	*x = StopComponent{}
+	mi := &file_proto_routegraph_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopComponent) ProtoMessage() {}
+
+func (x *StopComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopComponent.ProtoReflect.Descriptor instead.
+func (*StopComponent) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *StopComponent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StopComponent) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *StopComponent) GetStopIds() []string {
+	if x != nil {
+		return x.StopIds
+	}
+	return nil
+}
+
+type LinkCandidate struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FromId string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// strongly connected component indexes
+	FromComponent int32   `protobuf:"varint,3,opt,name=from_component,json=fromComponent,proto3" json:"from_component,omitempty"`
+	ToComponent   int32   `protobuf:"varint,4,opt,name=to_component,json=toComponent,proto3" json:"to_component,omitempty"`
+	DistanceM     float64 `protobuf:"fixed64,5,opt,name=distance_m,json=distanceM,proto3" json:"distance_m,omitempty"`
+	// the stops are not connected even ignoring edge direction
+	JoinsWeak     bool `protobuf:"varint,6,opt,name=joins_weak,json=joinsWeak,proto3" json:"joins_weak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkCandidate) Reset() {
+	*x = LinkCandidate{}
+	mi := &file_proto_routegraph_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkCandidate) ProtoMessage() {}
+
+func (x *LinkCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkCandidate.ProtoReflect.Descriptor instead.
+func (*LinkCandidate) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *LinkCandidate) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *LinkCandidate) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *LinkCandidate) GetFromComponent() int32 {
+	if x != nil {
+		return x.FromComponent
+	}
+	return 0
+}
+
+func (x *LinkCandidate) GetToComponent() int32 {
+	if x != nil {
+		return x.ToComponent
+	}
+	return 0
+}
+
+func (x *LinkCandidate) GetDistanceM() float64 {
+	if x != nil {
+		return x.DistanceM
+	}
+	return 0
+}
+
+func (x *LinkCandidate) GetJoinsWeak() bool {
+	if x != nil {
+		return x.JoinsWeak
+	}
+	return false
+}
+
+type ConnectivityResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StronglyConnected bool                   `protobuf:"varint,1,opt,name=strongly_connected,json=stronglyConnected,proto3" json:"strongly_connected,omitempty"`
+	Strong            []*StopComponent       `protobuf:"bytes,2,rep,name=strong,proto3" json:"strong,omitempty"`
+	Weak              []*StopComponent       `protobuf:"bytes,3,rep,name=weak,proto3" json:"weak,omitempty"`
+	// stops without any NEXT edge
+	IsolatedStopIds []string         `protobuf:"bytes,4,rep,name=isolated_stop_ids,json=isolatedStopIds,proto3" json:"isolated_stop_ids,omitempty"`
+	Candidates      []*LinkCandidate `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConnectivityResponse) Reset() {
+	*x = ConnectivityResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectivityResponse) ProtoMessage() {}
+
+func (x *ConnectivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectivityResponse.ProtoReflect.Descriptor instead.
+func (*ConnectivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ConnectivityResponse) GetStronglyConnected() bool {
+	if x != nil {
+		return x.StronglyConnected
+	}
+	return false
+}
+
+func (x *ConnectivityResponse) GetStrong() []*StopComponent {
+	if x != nil {
+		return x.Strong
+	}
+	return nil
+}
+
+func (x *ConnectivityResponse) GetWeak() []*StopComponent {
+	if x != nil {
+		return x.Weak
+	}
+	return nil
+}
+
+func (x *ConnectivityResponse) GetIsolatedStopIds() []string {
+	if x != nil {
+		return x.IsolatedStopIds
+	}
+	return nil
+}
+
+func (x *ConnectivityResponse) GetCandidates() []*LinkCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Demand
+type LoadDemandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// origin_stop_id,destination_stop_id,trips_per_hour rows; a header line is optional
+	Csv string `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	// drop the stored matrix before loading
+	Replace       bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadDemandRequest) Reset() {
+	*x = LoadDemandRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadDemandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDemandRequest) ProtoMessage() {}
+
+func (x *LoadDemandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDemandRequest.ProtoReflect.Descriptor instead.
+func (*LoadDemandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *LoadDemandRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *LoadDemandRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type LoadDemandResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Loaded       int32                  `protobuf:"varint,1,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	TripsPerHour float64                `protobuf:"fixed64,2,opt,name=trips_per_hour,json=tripsPerHour,proto3" json:"trips_per_hour,omitempty"`
+	// rows that were rejected, with the reason
+	Errors        []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadDemandResponse) Reset() {
+	*x = LoadDemandResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadDemandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDemandResponse) ProtoMessage() {}
+
+func (x *LoadDemandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDemandResponse.ProtoReflect.Descriptor instead.
+func (*LoadDemandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *LoadDemandResponse) GetLoaded() int32 {
+	if x != nil {
+		return x.Loaded
+	}
+	return 0
+}
+
+func (x *LoadDemandResponse) GetTripsPerHour() float64 {
+	if x != nil {
+		return x.TripsPerHour
+	}
+	return 0
+}
+
+func (x *LoadDemandResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AssignDemandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix seconds selecting the service period, default now
+	At int64 `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"`
+	// edges returned, 0 = all
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// return only overloaded edges
+	OverloadedOnly bool `protobuf:"varint,3,opt,name=overloaded_only,json=overloadedOnly,proto3" json:"overloaded_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignDemandRequest) Reset() {
+	*x = AssignDemandRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDemandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDemandRequest) ProtoMessage() {}
+
+func (x *AssignDemandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDemandRequest.ProtoReflect.Descriptor instead.
+func (*AssignDemandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AssignDemandRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *AssignDemandRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AssignDemandRequest) GetOverloadedOnly() bool {
+	if x != nil {
+		return x.OverloadedOnly
+	}
+	return false
+}
+
+type EdgeLoad struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FromId  string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId    string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	LineIds []string               `protobuf:"bytes,3,rep,name=line_ids,json=lineIds,proto3" json:"line_ids,omitempty"`
+	// passengers per hour
+	Load          float64 `protobuf:"fixed64,4,opt,name=load,proto3" json:"load,omitempty"`
+	Capacity      float64 `protobuf:"fixed64,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Utilization   float64 `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Overloaded    bool    `protobuf:"varint,7,opt,name=overloaded,proto3" json:"overloaded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeLoad) Reset() {
+	*x = EdgeLoad{}
+	mi := &file_proto_routegraph_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeLoad) ProtoMessage() {}
+
+func (x *EdgeLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeLoad.ProtoReflect.Descriptor instead.
+func (*EdgeLoad) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *EdgeLoad) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *EdgeLoad) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *EdgeLoad) GetLineIds() []string {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *EdgeLoad) GetLoad() float64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *EdgeLoad) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *EdgeLoad) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *EdgeLoad) GetOverloaded() bool {
+	if x != nil {
+		return x.Overloaded
+	}
+	return false
+}
+
+type LineLoad struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LineId          string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	FrequencyMins   int32                  `protobuf:"varint,2,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	VehicleCapacity float64                `protobuf:"fixed64,3,opt,name=vehicle_capacity,json=vehicleCapacity,proto3" json:"vehicle_capacity,omitempty"`
+	// passengers per hour
+	Capacity       float64 `protobuf:"fixed64,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	PeakLoad       float64 `protobuf:"fixed64,5,opt,name=peak_load,json=peakLoad,proto3" json:"peak_load,omitempty"`
+	PeakFromId     string  `protobuf:"bytes,6,opt,name=peak_from_id,json=peakFromId,proto3" json:"peak_from_id,omitempty"`
+	PeakToId       string  `protobuf:"bytes,7,opt,name=peak_to_id,json=peakToId,proto3" json:"peak_to_id,omitempty"`
+	Utilization    float64 `protobuf:"fixed64,8,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Overloaded     bool    `protobuf:"varint,9,opt,name=overloaded,proto3" json:"overloaded,omitempty"`
+	PassengerHours float64 `protobuf:"fixed64,10,opt,name=passenger_hours,json=passengerHours,proto3" json:"passenger_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LineLoad) Reset() {
+	*x = LineLoad{}
+	mi := &file_proto_routegraph_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineLoad) ProtoMessage() {}
+
+func (x *LineLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineLoad.ProtoReflect.Descriptor instead.
+func (*LineLoad) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LineLoad) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineLoad) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+func (x *LineLoad) GetVehicleCapacity() float64 {
+	if x != nil {
+		return x.VehicleCapacity
+	}
+	return 0
+}
+
+func (x *LineLoad) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *LineLoad) GetPeakLoad() float64 {
+	if x != nil {
+		return x.PeakLoad
+	}
+	return 0
+}
+
+func (x *LineLoad) GetPeakFromId() string {
+	if x != nil {
+		return x.PeakFromId
+	}
+	return ""
+}
+
+func (x *LineLoad) GetPeakToId() string {
+	if x != nil {
+		return x.PeakToId
+	}
+	return ""
+}
+
+func (x *LineLoad) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *LineLoad) GetOverloaded() bool {
+	if x != nil {
+		return x.Overloaded
+	}
+	return false
+}
+
+func (x *LineLoad) GetPassengerHours() float64 {
+	if x != nil {
+		return x.PassengerHours
+	}
+	return 0
+}
+
+type AssignDemandResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	At              int64                  `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"`
+	Pairs           int32                  `protobuf:"varint,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	UnassignedPairs int32                  `protobuf:"varint,3,opt,name=unassigned_pairs,json=unassignedPairs,proto3" json:"unassigned_pairs,omitempty"`
+	AssignedTrips   float64                `protobuf:"fixed64,4,opt,name=assigned_trips,json=assignedTrips,proto3" json:"assigned_trips,omitempty"`
+	UnassignedTrips float64                `protobuf:"fixed64,5,opt,name=unassigned_trips,json=unassignedTrips,proto3" json:"unassigned_trips,omitempty"`
+	OverloadedEdges int32                  `protobuf:"varint,6,opt,name=overloaded_edges,json=overloadedEdges,proto3" json:"overloaded_edges,omitempty"`
+	Edges           []*EdgeLoad            `protobuf:"bytes,7,rep,name=edges,proto3" json:"edges,omitempty"`
+	Lines           []*LineLoad            `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignDemandResponse) Reset() {
+	*x = AssignDemandResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDemandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDemandResponse) ProtoMessage() {}
+
+func (x *AssignDemandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDemandResponse.ProtoReflect.Descriptor instead.
+func (*AssignDemandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AssignDemandResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetPairs() int32 {
+	if x != nil {
+		return x.Pairs
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetUnassignedPairs() int32 {
+	if x != nil {
+		return x.UnassignedPairs
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetAssignedTrips() float64 {
+	if x != nil {
+		return x.AssignedTrips
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetUnassignedTrips() float64 {
+	if x != nil {
+		return x.UnassignedTrips
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetOverloadedEdges() int32 {
+	if x != nil {
+		return x.OverloadedEdges
+	}
+	return 0
+}
+
+func (x *AssignDemandResponse) GetEdges() []*EdgeLoad {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *AssignDemandResponse) GetLines() []*LineLoad {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Ridership
+type RidershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix seconds selecting the service period for hourly figures, default now
+	At int64 `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"`
+	// busiest stops and lines returned, default 10
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RidershipRequest) Reset() {
+	*x = RidershipRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RidershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RidershipRequest) ProtoMessage() {}
+
+func (x *RidershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RidershipRequest.ProtoReflect.Descriptor instead.
+func (*RidershipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RidershipRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *RidershipRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StopRidership struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StopId  string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Zone    string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	LineIds []string               `protobuf:"bytes,4,rep,name=line_ids,json=lineIds,proto3" json:"line_ids,omitempty"`
+	// summed average boardings per trip over all lines
+	Boardings        float64 `protobuf:"fixed64,5,opt,name=boardings,proto3" json:"boardings,omitempty"`
+	BoardingsPerHour float64 `protobuf:"fixed64,6,opt,name=boardings_per_hour,json=boardingsPerHour,proto3" json:"boardings_per_hour,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StopRidership) Reset() {
+	*x = StopRidership{}
+	mi := &file_proto_routegraph_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRidership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRidership) ProtoMessage() {}
+
+func (x *StopRidership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRidership.ProtoReflect.Descriptor instead.
+func (*StopRidership) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *StopRidership) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *StopRidership) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopRidership) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *StopRidership) GetLineIds() []string {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *StopRidership) GetBoardings() float64 {
+	if x != nil {
+		return x.Boardings
+	}
+	return 0
+}
+
+func (x *StopRidership) GetBoardingsPerHour() float64 {
+	if x != nil {
+		return x.BoardingsPerHour
+	}
+	return 0
+}
+
+type LineRidership struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LineId           string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FrequencyMins    int32                  `protobuf:"varint,3,opt,name=frequency_mins,json=frequencyMins,proto3" json:"frequency_mins,omitempty"`
+	Boardings        float64                `protobuf:"fixed64,4,opt,name=boardings,proto3" json:"boardings,omitempty"`
+	BoardingsPerHour float64                `protobuf:"fixed64,5,opt,name=boardings_per_hour,json=boardingsPerHour,proto3" json:"boardings_per_hour,omitempty"`
+	// average capacity of assigned vehicles
+	VehicleCapacity          float64 `protobuf:"fixed64,6,opt,name=vehicle_capacity,json=vehicleCapacity,proto3" json:"vehicle_capacity,omitempty"`
+	BoardingsPerCapacityHour float64 `protobuf:"fixed64,7,opt,name=boardings_per_capacity_hour,json=boardingsPerCapacityHour,proto3" json:"boardings_per_capacity_hour,omitempty"`
+	// passengers per hour as in LineLoad.capacity, summed over the directions the MAIN pattern runs
+	Capacity      float64 `protobuf:"fixed64,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineRidership) Reset() {
+	*x = LineRidership{}
+	mi := &file_proto_routegraph_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineRidership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineRidership) ProtoMessage() {}
+
+func (x *LineRidership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineRidership.ProtoReflect.Descriptor instead.
+func (*LineRidership) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LineRidership) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineRidership) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LineRidership) GetFrequencyMins() int32 {
+	if x != nil {
+		return x.FrequencyMins
+	}
+	return 0
+}
+
+func (x *LineRidership) GetBoardings() float64 {
+	if x != nil {
+		return x.Boardings
+	}
+	return 0
+}
+
+func (x *LineRidership) GetBoardingsPerHour() float64 {
+	if x != nil {
+		return x.BoardingsPerHour
+	}
+	return 0
+}
+
+func (x *LineRidership) GetVehicleCapacity() float64 {
+	if x != nil {
+		return x.VehicleCapacity
+	}
+	return 0
+}
+
+func (x *LineRidership) GetBoardingsPerCapacityHour() float64 {
+	if x != nil {
+		return x.BoardingsPerCapacityHour
+	}
+	return 0
+}
+
+func (x *LineRidership) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type ZoneRidership struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Zone             string                 `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Stops            int32                  `protobuf:"varint,2,opt,name=stops,proto3" json:"stops,omitempty"`
+	Boardings        float64                `protobuf:"fixed64,3,opt,name=boardings,proto3" json:"boardings,omitempty"`
+	BoardingsPerHour float64                `protobuf:"fixed64,4,opt,name=boardings_per_hour,json=boardingsPerHour,proto3" json:"boardings_per_hour,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ZoneRidership) Reset() {
+	*x = ZoneRidership{}
+	mi := &file_proto_routegraph_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneRidership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneRidership) ProtoMessage() {}
+
+func (x *ZoneRidership) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneRidership.ProtoReflect.Descriptor instead.
+func (*ZoneRidership) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ZoneRidership) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ZoneRidership) GetStops() int32 {
+	if x != nil {
+		return x.Stops
+	}
+	return 0
+}
+
+func (x *ZoneRidership) GetBoardings() float64 {
+	if x != nil {
+		return x.Boardings
+	}
+	return 0
+}
+
+func (x *ZoneRidership) GetBoardingsPerHour() float64 {
+	if x != nil {
+		return x.BoardingsPerHour
+	}
+	return 0
+}
+
+type RidershipResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	At               int64                  `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"`
+	BusiestStops     []*StopRidership       `protobuf:"bytes,2,rep,name=busiest_stops,json=busiestStops,proto3" json:"busiest_stops,omitempty"`
+	BusiestLines     []*LineRidership       `protobuf:"bytes,3,rep,name=busiest_lines,json=busiestLines,proto3" json:"busiest_lines,omitempty"`
+	Zones            []*ZoneRidership       `protobuf:"bytes,4,rep,name=zones,proto3" json:"zones,omitempty"`
+	BoardingsPerHour float64                `protobuf:"fixed64,5,opt,name=boardings_per_hour,json=boardingsPerHour,proto3" json:"boardings_per_hour,omitempty"`
+	// passengers per hour over all lines
+	Capacity                 float64 `protobuf:"fixed64,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	BoardingsPerCapacityHour float64 `protobuf:"fixed64,7,opt,name=boardings_per_capacity_hour,json=boardingsPerCapacityHour,proto3" json:"boardings_per_capacity_hour,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RidershipResponse) Reset() {
+	*x = RidershipResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RidershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RidershipResponse) ProtoMessage() {}
+
+func (x *RidershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RidershipResponse.ProtoReflect.Descriptor instead.
+func (*RidershipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RidershipResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *RidershipResponse) GetBusiestStops() []*StopRidership {
+	if x != nil {
+		return x.BusiestStops
+	}
+	return nil
+}
+
+func (x *RidershipResponse) GetBusiestLines() []*LineRidership {
+	if x != nil {
+		return x.BusiestLines
+	}
+	return nil
+}
+
+func (x *RidershipResponse) GetZones() []*ZoneRidership {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *RidershipResponse) GetBoardingsPerHour() float64 {
+	if x != nil {
+		return x.BoardingsPerHour
+	}
+	return 0
+}
+
+func (x *RidershipResponse) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RidershipResponse) GetBoardingsPerCapacityHour() float64 {
+	if x != nil {
+		return x.BoardingsPerCapacityHour
+	}
+	return 0
+}
+
+// Travel time profiles
+type ProfileEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WEEKDAY or WEEKEND (weekends and holidays)
+	Day string `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// NIGHT, EARLY, AM_PEAK, MIDDAY, PM_PEAK or EVENING
+	Band       string `protobuf:"bytes,2,opt,name=band,proto3" json:"band,omitempty"`
+	TravelTime int32  `protobuf:"varint,3,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	// observations behind the value, 0 when imported
+	Samples       int32 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileEntry) Reset() {
+	*x = ProfileEntry{}
+	mi := &file_proto_routegraph_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileEntry) ProtoMessage() {}
+
+func (x *ProfileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileEntry.ProtoReflect.Descriptor instead.
+func (*ProfileEntry) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ProfileEntry) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *ProfileEntry) GetBand() string {
+	if x != nil {
+		return x.Band
+	}
+	return ""
+}
+
+func (x *ProfileEntry) GetTravelTime() int32 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *ProfileEntry) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type EdgeProfile struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FromId string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// fallback for bands without a profile value
+	TravelTime int32           `protobuf:"varint,3,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	Entries    []*ProfileEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	// on SetEdgeProfile: drop the bands not listed
+	Replace       bool `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeProfile) Reset() {
+	*x = EdgeProfile{}
+	mi := &file_proto_routegraph_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeProfile) ProtoMessage() {}
+
+func (x *EdgeProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeProfile.ProtoReflect.Descriptor instead.
+func (*EdgeProfile) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EdgeProfile) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *EdgeProfile) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *EdgeProfile) GetTravelTime() int32 {
+	if x != nil {
+		return x.TravelTime
+	}
+	return 0
+}
+
+func (x *EdgeProfile) GetEntries() []*ProfileEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *EdgeProfile) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type BuildEdgeProfilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty builds every edge with observations
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// MEDIAN (default), P85 or EWMA
+	Estimator  string `protobuf:"bytes,3,opt,name=estimator,proto3" json:"estimator,omitempty"`
+	WindowDays int32  `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	// observations per day and band needed for a value, default 3
+	MinSamples    int32 `protobuf:"varint,5,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEdgeProfilesRequest) Reset() {
+	*x = BuildEdgeProfilesRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEdgeProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEdgeProfilesRequest) ProtoMessage() {}
+
+func (x *BuildEdgeProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEdgeProfilesRequest.ProtoReflect.Descriptor instead.
+func (*BuildEdgeProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *BuildEdgeProfilesRequest) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *BuildEdgeProfilesRequest) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *BuildEdgeProfilesRequest) GetEstimator() string {
+	if x != nil {
+		return x.Estimator
+	}
+	return ""
+}
+
+func (x *BuildEdgeProfilesRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *BuildEdgeProfilesRequest) GetMinSamples() int32 {
+	if x != nil {
+		return x.MinSamples
+	}
+	return 0
+}
+
+type BuildEdgeProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edges         int32                  `protobuf:"varint,1,opt,name=edges,proto3" json:"edges,omitempty"`
+	Entries       int32                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	Profiles      []*EdgeProfile         `protobuf:"bytes,3,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildEdgeProfilesResponse) Reset() {
+	*x = BuildEdgeProfilesResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildEdgeProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEdgeProfilesResponse) ProtoMessage() {}
+
+func (x *BuildEdgeProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEdgeProfilesResponse.ProtoReflect.Descriptor instead.
+func (*BuildEdgeProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *BuildEdgeProfilesResponse) GetEdges() int32 {
+	if x != nil {
+		return x.Edges
+	}
+	return 0
+}
+
+func (x *BuildEdgeProfilesResponse) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *BuildEdgeProfilesResponse) GetProfiles() []*EdgeProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// Trip logs
+type TripStopArrival struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	StopId string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	// unix ms
+	ArrivalTs     int64 `protobuf:"varint,2,opt,name=arrival_ts,json=arrivalTs,proto3" json:"arrival_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripStopArrival) Reset() {
+	*x = TripStopArrival{}
+	mi := &file_proto_routegraph_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripStopArrival) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripStopArrival) ProtoMessage() {}
+
+func (x *TripStopArrival) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripStopArrival.ProtoReflect.Descriptor instead.
+func (*TripStopArrival) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TripStopArrival) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *TripStopArrival) GetArrivalTs() int64 {
+	if x != nil {
+		return x.ArrivalTs
+	}
+	return 0
+}
+
+type TripLog struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TripId      string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	VehicleUuid string                 `protobuf:"bytes,2,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
+	LineId      string                 `protobuf:"bytes,3,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	// in the order the stops were served
+	Arrivals      []*TripStopArrival `protobuf:"bytes,4,rep,name=arrivals,proto3" json:"arrivals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripLog) Reset() {
+	*x = TripLog{}
+	mi := &file_proto_routegraph_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripLog) ProtoMessage() {}
+
+func (x *TripLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripLog.ProtoReflect.Descriptor instead.
+func (*TripLog) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TripLog) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *TripLog) GetVehicleUuid() string {
+	if x != nil {
+		return x.VehicleUuid
+	}
+	return ""
+}
+
+func (x *TripLog) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *TripLog) GetArrivals() []*TripStopArrival {
+	if x != nil {
+		return x.Arrivals
+	}
+	return nil
+}
+
+type IngestTripLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Trip  *TripLog               `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	// options are taken from the first message of the stream
+	// MEDIAN (default), P85 or EWMA
+	Estimator  string `protobuf:"bytes,2,opt,name=estimator,proto3" json:"estimator,omitempty"`
+	WindowDays int32  `protobuf:"varint,3,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	MinSamples int32  `protobuf:"varint,4,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
+	// relative travel time change reported as drift, default 0.2
+	DriftThreshold float64 `protobuf:"fixed64,5,opt,name=drift_threshold,json=driftThreshold,proto3" json:"drift_threshold,omitempty"`
+	// also rebuild the time-of-day profiles of the touched edges
+	BuildProfiles bool `protobuf:"varint,6,opt,name=build_profiles,json=buildProfiles,proto3" json:"build_profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestTripLogRequest) Reset() {
+	*x = IngestTripLogRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestTripLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestTripLogRequest) ProtoMessage() {}
+
+func (x *IngestTripLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestTripLogRequest.ProtoReflect.Descriptor instead.
+func (*IngestTripLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *IngestTripLogRequest) GetTrip() *TripLog {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *IngestTripLogRequest) GetEstimator() string {
+	if x != nil {
+		return x.Estimator
+	}
+	return ""
+}
+
+func (x *IngestTripLogRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *IngestTripLogRequest) GetMinSamples() int32 {
+	if x != nil {
+		return x.MinSamples
+	}
+	return 0
+}
+
+func (x *IngestTripLogRequest) GetDriftThreshold() float64 {
+	if x != nil {
+		return x.DriftThreshold
+	}
+	return 0
+}
+
+func (x *IngestTripLogRequest) GetBuildProfiles() bool {
+	if x != nil {
+		return x.BuildProfiles
+	}
+	return false
+}
+
+type EdgeDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromId        string                 `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId          string                 `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	OldTravelTime int32                  `protobuf:"varint,3,opt,name=old_travel_time,json=oldTravelTime,proto3" json:"old_travel_time,omitempty"`
+	NewTravelTime int32                  `protobuf:"varint,4,opt,name=new_travel_time,json=newTravelTime,proto3" json:"new_travel_time,omitempty"`
+	// (new - old) / old
+	Drift         float64 `protobuf:"fixed64,5,opt,name=drift,proto3" json:"drift,omitempty"`
+	Samples       int32   `protobuf:"varint,6,opt,name=samples,proto3" json:"samples,omitempty"`
+	Confidence    float64 `protobuf:"fixed64,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EdgeDrift) Reset() {
+	*x = EdgeDrift{}
+	mi := &file_proto_routegraph_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeDrift) ProtoMessage() {}
+
+func (x *EdgeDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeDrift.ProtoReflect.Descriptor instead.
+func (*EdgeDrift) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *EdgeDrift) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+func (x *EdgeDrift) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+func (x *EdgeDrift) GetOldTravelTime() int32 {
+	if x != nil {
+		return x.OldTravelTime
+	}
+	return 0
+}
+
+func (x *EdgeDrift) GetNewTravelTime() int32 {
+	if x != nil {
+		return x.NewTravelTime
+	}
+	return 0
+}
+
+func (x *EdgeDrift) GetDrift() float64 {
+	if x != nil {
+		return x.Drift
+	}
+	return 0
+}
+
+func (x *EdgeDrift) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *EdgeDrift) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type IngestTripLogResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Trips        int32                  `protobuf:"varint,1,opt,name=trips,proto3" json:"trips,omitempty"`
+	Arrivals     int32                  `protobuf:"varint,2,opt,name=arrivals,proto3" json:"arrivals,omitempty"`
+	Observations int32                  `protobuf:"varint,3,opt,name=observations,proto3" json:"observations,omitempty"`
+	// observations between stops without a NEXT edge
+	UnknownEdges      int32        `protobuf:"varint,4,opt,name=unknown_edges,json=unknownEdges,proto3" json:"unknown_edges,omitempty"`
+	EdgesRecalibrated int32        `protobuf:"varint,5,opt,name=edges_recalibrated,json=edgesRecalibrated,proto3" json:"edges_recalibrated,omitempty"`
+	EdgesUpdated      int32        `protobuf:"varint,6,opt,name=edges_updated,json=edgesUpdated,proto3" json:"edges_updated,omitempty"`
+	ProfileEntries    int32        `protobuf:"varint,7,opt,name=profile_entries,json=profileEntries,proto3" json:"profile_entries,omitempty"`
+	Drifted           []*EdgeDrift `protobuf:"bytes,8,rep,name=drifted,proto3" json:"drifted,omitempty"`
+	// rejected arrival pairs and unknown edges, capped
+	Problems      []string `protobuf:"bytes,9,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestTripLogResponse) Reset() {
+	*x = IngestTripLogResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestTripLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestTripLogResponse) ProtoMessage() {}
+
+func (x *IngestTripLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestTripLogResponse.ProtoReflect.Descriptor instead.
+func (*IngestTripLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *IngestTripLogResponse) GetTrips() int32 {
+	if x != nil {
+		return x.Trips
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetArrivals() int32 {
+	if x != nil {
+		return x.Arrivals
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetObservations() int32 {
+	if x != nil {
+		return x.Observations
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetUnknownEdges() int32 {
+	if x != nil {
+		return x.UnknownEdges
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetEdgesRecalibrated() int32 {
+	if x != nil {
+		return x.EdgesRecalibrated
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetEdgesUpdated() int32 {
+	if x != nil {
+		return x.EdgesUpdated
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetProfileEntries() int32 {
+	if x != nil {
+		return x.ProfileEntries
+	}
+	return 0
+}
+
+func (x *IngestTripLogResponse) GetDrifted() []*EdgeDrift {
+	if x != nil {
+		return x.Drifted
+	}
+	return nil
+}
+
+func (x *IngestTripLogResponse) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// Network map
+type NetworkMapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SVG (default) or PNG
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// image size in pixels, default 1000 x 1000
+	Width  int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// highlight the shortest path between these stops when both are set
+	StartId string `protobuf:"bytes,4,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   string `protobuf:"bytes,5,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	MaxHops int32  `protobuf:"varint,6,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// colour only these lines, empty = all
+	Lines      []string `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	ShadeZones bool     `protobuf:"varint,8,opt,name=shade_zones,json=shadeZones,proto3" json:"shade_zones,omitempty"`
+	HideLabels bool     `protobuf:"varint,9,opt,name=hide_labels,json=hideLabels,proto3" json:"hide_labels,omitempty"`
+	// legend language: sr-Latn (default) or en
+	Locale        string `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkMapRequest) Reset() {
+	*x = NetworkMapRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkMapRequest) ProtoMessage() {}
+
+func (x *NetworkMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkMapRequest.ProtoReflect.Descriptor instead.
+func (*NetworkMapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *NetworkMapRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *NetworkMapRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *NetworkMapRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NetworkMapRequest) GetStartId() string {
+	if x != nil {
+		return x.StartId
+	}
+	return ""
+}
+
+func (x *NetworkMapRequest) GetEndId() string {
+	if x != nil {
+		return x.EndId
+	}
+	return ""
+}
+
+func (x *NetworkMapRequest) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *NetworkMapRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *NetworkMapRequest) GetShadeZones() bool {
+	if x != nil {
+		return x.ShadeZones
+	}
+	return false
+}
+
+func (x *NetworkMapRequest) GetHideLabels() bool {
+	if x != nil {
+		return x.HideLabels
+	}
+	return false
+}
+
+func (x *NetworkMapRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type NetworkMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkMapResponse) Reset() {
+	*x = NetworkMapResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkMapResponse) ProtoMessage() {}
+
+func (x *NetworkMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkMapResponse.ProtoReflect.Descriptor instead.
+func (*NetworkMapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *NetworkMapResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *NetworkMapResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *NetworkMapResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *NetworkMapResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *NetworkMapResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Report data
+type ReportDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// same options as GenerateReport, so equal requests give the numbers of the PDF;
+	// sections: vehicles, stops, top_pairs, depots, centrality, empty = all five
+	Request *GenerateReportRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// also return the datasets as a zip of CSV files, one per section
+	IncludeCsv    bool `protobuf:"varint,2,opt,name=include_csv,json=includeCsv,proto3" json:"include_csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDataRequest) Reset() {
+	*x = ReportDataRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDataRequest) ProtoMessage() {}
+
+func (x *ReportDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDataRequest.ProtoReflect.Descriptor instead.
+func (*ReportDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ReportDataRequest) GetRequest() *GenerateReportRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReportDataRequest) GetIncludeCsv() bool {
+	if x != nil {
+		return x.IncludeCsv
+	}
+	return false
+}
+
+type DepotVehicles struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Depot         string                 `protobuf:"bytes,1,opt,name=depot,proto3" json:"depot,omitempty"`
+	Vehicles      []*Vehicle             `protobuf:"bytes,2,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepotVehicles) Reset() {
+	*x = DepotVehicles{}
+	mi := &file_proto_routegraph_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepotVehicles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepotVehicles) ProtoMessage() {}
+
+func (x *DepotVehicles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepotVehicles.ProtoReflect.Descriptor instead.
+func (*DepotVehicles) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DepotVehicles) GetDepot() string {
+	if x != nil {
+		return x.Depot
+	}
+	return ""
+}
+
+func (x *DepotVehicles) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+type ZoneStops struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          string                 `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Stops         []*Stop                `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneStops) Reset() {
+	*x = ZoneStops{}
+	mi := &file_proto_routegraph_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneStops) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneStops) ProtoMessage() {}
+
+func (x *ZoneStops) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneStops.ProtoReflect.Descriptor instead.
+func (*ZoneStops) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ZoneStops) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ZoneStops) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type ReportDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unix ms when the data was read
+	GeneratedTs int64 `protobuf:"varint,1,opt,name=generated_ts,json=generatedTs,proto3" json:"generated_ts,omitempty"`
+	// unix seconds the report was evaluated for
+	At              int64                   `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"`
+	Sections        []string                `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	VehiclesByDepot []*DepotVehicles        `protobuf:"bytes,4,rep,name=vehicles_by_depot,json=vehiclesByDepot,proto3" json:"vehicles_by_depot,omitempty"`
+	StopsByZone     []*ZoneStops            `protobuf:"bytes,5,rep,name=stops_by_zone,json=stopsByZone,proto3" json:"stops_by_zone,omitempty"`
+	TopPairs        []*Pair                 `protobuf:"bytes,6,rep,name=top_pairs,json=topPairs,proto3" json:"top_pairs,omitempty"`
+	DepotStats      []*DepotStat            `protobuf:"bytes,7,rep,name=depot_stats,json=depotStats,proto3" json:"depot_stats,omitempty"`
+	TopStops        *StopCentralityResponse `protobuf:"bytes,8,opt,name=top_stops,json=topStops,proto3" json:"top_stops,omitempty"`
+	// sections left without data and why
+	Notices map[string]string `protobuf:"bytes,9,rep,name=notices,proto3" json:"notices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// zip of CSV files, set when include_csv is
+	Csv           []byte `protobuf:"bytes,10,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportDataResponse) Reset() {
+	*x = ReportDataResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportDataResponse) ProtoMessage() {}
+
+func (x *ReportDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportDataResponse.ProtoReflect.Descriptor instead.
+func (*ReportDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ReportDataResponse) GetGeneratedTs() int64 {
+	if x != nil {
+		return x.GeneratedTs
+	}
+	return 0
+}
+
+func (x *ReportDataResponse) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *ReportDataResponse) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetVehiclesByDepot() []*DepotVehicles {
+	if x != nil {
+		return x.VehiclesByDepot
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetStopsByZone() []*ZoneStops {
+	if x != nil {
+		return x.StopsByZone
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetTopPairs() []*Pair {
+	if x != nil {
+		return x.TopPairs
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetDepotStats() []*DepotStat {
+	if x != nil {
+		return x.DepotStats
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetTopStops() *StopCentralityResponse {
+	if x != nil {
+		return x.TopStops
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetNotices() map[string]string {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+func (x *ReportDataResponse) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+// Line sheet
+type LineSheetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line to print, required unless all_active is set
+	LineId string `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	// one PDF with a sheet for every active line
+	AllActive bool `protobuf:"varint,2,opt,name=all_active,json=allActive,proto3" json:"all_active,omitempty"`
+	// sr-Latn (default) or en
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	// also save the PDF in the server's output directory
+	Store         bool `protobuf:"varint,4,opt,name=store,proto3" json:"store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineSheetRequest) Reset() {
+	*x = LineSheetRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineSheetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineSheetRequest) ProtoMessage() {}
+
+func (x *LineSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineSheetRequest.ProtoReflect.Descriptor instead.
+func (*LineSheetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LineSheetRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *LineSheetRequest) GetAllActive() bool {
+	if x != nil {
+		return x.AllActive
+	}
+	return false
+}
+
+func (x *LineSheetRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LineSheetRequest) GetStore() bool {
+	if x != nil {
+		return x.Store
+	}
+	return false
+}
+
+type GenerateReportRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	StartId string                 `protobuf:"bytes,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   string                 `protobuf:"bytes,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	MaxHops int32                  `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// unix seconds used to evaluate service periods, 0 = now
+	At int64 `protobuf:"varint,4,opt,name=at,proto3" json:"at,omitempty"`
+	// line whose timetable page is added, empty = first line
+	TimetableLineId string `protobuf:"bytes,5,opt,name=timetable_line_id,json=timetableLineId,proto3" json:"timetable_line_id,omitempty"`
+	// optional scenario compared against the live network
+	ScenarioId string `protobuf:"bytes,6,opt,name=scenario_id,json=scenarioId,proto3" json:"scenario_id,omitempty"`
+	// metric of the stop chart, see StopCentralityRequest; default betweenness
+	CentralityMetric string `protobuf:"bytes,7,opt,name=centrality_metric,json=centralityMetric,proto3" json:"centrality_metric,omitempty"`
+	// PDF (default), CSV (zip of CSV files), HTML or JSON
+	Format string `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	// also save the report in the server's output directory under a unique name
+	Store bool `protobuf:"varint,9,opt,name=store,proto3" json:"store,omitempty"`
+	// sections to include, empty = all: vehicles, stops, line_patterns, fleet, top_pairs, depots,
+	// centrality, critical_elements, connectivity, ridership, demand, shortest_path, timetable, scenario
+	Sections []string `protobuf:"bytes,10,rep,name=sections,proto3" json:"sections,omitempty"`
+	// rows shown per section, overriding the defaults (top_pairs 5, depots 5, centrality 10,
+	// critical_elements 5, connectivity 5, ridership 8, demand 10, others all)
+	Limits map[string]int32 `protobuf:"bytes,11,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// restrict sections whose rows carry a zone, depot or line; empty = no filter
+	Zones  []string `protobuf:"bytes,12,rep,name=zones,proto3" json:"zones,omitempty"`
+	Depots []string `protobuf:"bytes,13,rep,name=depots,proto3" json:"depots,omitempty"`
+	Lines  []string `protobuf:"bytes,14,rep,name=lines,proto3" json:"lines,omitempty"`
+	// language of the report: sr-Latn (default) or en
+	Locale string `protobuf:"bytes,15,opt,name=locale,proto3" json:"locale,omitempty"`
+	// shade zones on the network map page
+	MapZones      bool `protobuf:"varint,16,opt,name=map_zones,json=mapZones,proto3" json:"map_zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportRequest) Reset() {
+	*x = GenerateReportRequest{}
+	mi := &file_proto_routegraph_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportRequest) ProtoMessage() {}
+
+func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GenerateReportRequest) GetStartId() string {
+	if x != nil {
+		return x.StartId
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetEndId() string {
+	if x != nil {
+		return x.EndId
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *GenerateReportRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *GenerateReportRequest) GetTimetableLineId() string {
+	if x != nil {
+		return x.TimetableLineId
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetScenarioId() string {
+	if x != nil {
+		return x.ScenarioId
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetCentralityMetric() string {
+	if x != nil {
+		return x.CentralityMetric
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetStore() bool {
+	if x != nil {
+		return x.Store
+	}
+	return false
+}
+
+func (x *GenerateReportRequest) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetLimits() map[string]int32 {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetDepots() []string {
+	if x != nil {
+		return x.Depots
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GenerateReportRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetMapZones() bool {
+	if x != nil {
+		return x.MapZones
+	}
+	return false
+}
+
+type GenerateReportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Created bool                   `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	// name in the output directory, set only when stored
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Format        string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType   string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportResponse) Reset() {
+	*x = GenerateReportResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportResponse) ProtoMessage() {}
+
+func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GenerateReportResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *GenerateReportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *GenerateReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GenerateReportResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GenerateReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GenerateReportResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReportChunk struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Data   []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Offset int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Last   bool                   `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+	// metadata, set on the first chunk only
+	Format        string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	ContentType   string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
+	TotalSize     int64  `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportChunk) Reset() {
+	*x = ReportChunk{}
+	mi := &file_proto_routegraph_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChunk) ProtoMessage() {}
+
+func (x *ReportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChunk.ProtoReflect.Descriptor instead.
+func (*ReportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ReportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReportChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReportChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *ReportChunk) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
 
-func (x *ServesListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[24]
+func (x *ReportChunk) GetContentType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ContentType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ServesListResponse.ProtoReflect.Descriptor instead.
-func (*ServesListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{24}
+func (x *ReportChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x *ServesListResponse) GetEdges() []*ServesEdge {
+func (x *ReportChunk) GetTotalSize() int64 {
 	if x != nil {
-		return x.Edges
+		return x.TotalSize
 	}
-	return nil
+	return 0
 }
 
-type AssignedListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleUuid   string                 `protobuf:"bytes,1,opt,name=vehicle_uuid,json=vehicleUuid,proto3" json:"vehicle_uuid,omitempty"`
+// Report jobs
+type ReportJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// QUEUED, RUNNING, DONE or FAILED
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 0-100
+	Progress int32 `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	// section or step being worked on
+	Stage string `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	// set when the job was queued by a schedule
+	ScheduleId string `protobuf:"bytes,5,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	CreatedTs  int64  `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	StartedTs  int64  `protobuf:"varint,7,opt,name=started_ts,json=startedTs,proto3" json:"started_ts,omitempty"`
+	FinishedTs int64  `protobuf:"varint,8,opt,name=finished_ts,json=finishedTs,proto3" json:"finished_ts,omitempty"`
+	Error      string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Format     string `protobuf:"bytes,10,opt,name=format,proto3" json:"format,omitempty"`
+	// name in the output directory when the report was stored
+	Filename      string `protobuf:"bytes,11,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size          int64  `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignedListRequest) Reset() {
-	*x = AssignedListRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[25]
+func (x *ReportJob) Reset() {
+	*x = ReportJob{}
+	mi := &file_proto_routegraph_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignedListRequest) String() string {
+func (x *ReportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignedListRequest) ProtoMessage() {}
+func (*ReportJob) ProtoMessage() {}
 
-func (x *AssignedListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[25]
+func (x *ReportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,128 +8542,117 @@ func (x *AssignedListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignedListRequest.ProtoReflect.Descriptor instead.
-func (*AssignedListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReportJob.ProtoReflect.Descriptor instead.
+func (*ReportJob) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *AssignedListRequest) GetVehicleUuid() string {
+func (x *ReportJob) GetId() string {
 	if x != nil {
-		return x.VehicleUuid
+		return x.Id
 	}
 	return ""
 }
 
-type AssignedListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assignments   []*AssignedTo          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AssignedListResponse) Reset() {
-	*x = AssignedListResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *AssignedListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReportJob) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
 }
 
-func (*AssignedListResponse) ProtoMessage() {}
-
-func (x *AssignedListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[26]
+func (x *ReportJob) GetStage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Stage
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AssignedListResponse.ProtoReflect.Descriptor instead.
-func (*AssignedListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{26}
+func (x *ReportJob) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
 }
 
-func (x *AssignedListResponse) GetAssignments() []*AssignedTo {
+func (x *ReportJob) GetCreatedTs() int64 {
 	if x != nil {
-		return x.Assignments
+		return x.CreatedTs
 	}
-	return nil
+	return 0
 }
 
-type ParkedListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DepotId       string                 `protobuf:"bytes,1,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReportJob) GetStartedTs() int64 {
+	if x != nil {
+		return x.StartedTs
+	}
+	return 0
 }
 
-func (x *ParkedListRequest) Reset() {
-	*x = ParkedListRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReportJob) GetFinishedTs() int64 {
+	if x != nil {
+		return x.FinishedTs
+	}
+	return 0
 }
 
-func (x *ParkedListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (*ParkedListRequest) ProtoMessage() {}
-
-func (x *ParkedListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[27]
+func (x *ReportJob) GetFormat() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Format
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ParkedListRequest.ProtoReflect.Descriptor instead.
-func (*ParkedListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{27}
+func (x *ReportJob) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x *ParkedListRequest) GetDepotId() string {
+func (x *ReportJob) GetSize() int64 {
 	if x != nil {
-		return x.DepotId
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type ParkedListResponse struct {
+type ReportJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parked        []*ParkedAt            `protobuf:"bytes,1,rep,name=parked,proto3" json:"parked,omitempty"`
+	Jobs          []*ReportJob           `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ParkedListResponse) Reset() {
-	*x = ParkedListResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[28]
+func (x *ReportJobsResponse) Reset() {
+	*x = ReportJobsResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParkedListResponse) String() string {
+func (x *ReportJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParkedListResponse) ProtoMessage() {}
+func (*ReportJobsResponse) ProtoMessage() {}
 
-func (x *ParkedListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[28]
+func (x *ReportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,42 +8663,49 @@ func (x *ParkedListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParkedListResponse.ProtoReflect.Descriptor instead.
-func (*ParkedListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ReportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ReportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ParkedListResponse) GetParked() []*ParkedAt {
+func (x *ReportJobsResponse) GetJobs() []*ReportJob {
 	if x != nil {
-		return x.Parked
+		return x.Jobs
 	}
 	return nil
 }
 
-type GenerateReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartId       string                 `protobuf:"bytes,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
-	EndId         string                 `protobuf:"bytes,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
-	MaxHops       int32                  `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+type ReportSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// five-field cron expression in server local time, e.g. "0 5 * * *", or @daily, @weekly, ...
+	Cron string `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	// report to generate; stored in the output directory when one is configured
+	Request       *GenerateReportRequest `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	CreatedTs     int64                  `protobuf:"varint,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	LastRunTs     int64                  `protobuf:"varint,6,opt,name=last_run_ts,json=lastRunTs,proto3" json:"last_run_ts,omitempty"`
+	LastJobId     string                 `protobuf:"bytes,7,opt,name=last_job_id,json=lastJobId,proto3" json:"last_job_id,omitempty"`
+	NextRunTs     int64                  `protobuf:"varint,8,opt,name=next_run_ts,json=nextRunTs,proto3" json:"next_run_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateReportRequest) Reset() {
-	*x = GenerateReportRequest{}
-	mi := &file_proto_routegraph_proto_msgTypes[29]
+func (x *ReportSchedule) Reset() {
+	*x = ReportSchedule{}
+	mi := &file_proto_routegraph_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateReportRequest) String() string {
+func (x *ReportSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateReportRequest) ProtoMessage() {}
+func (*ReportSchedule) ProtoMessage() {}
 
-func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[29]
+func (x *ReportSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,55 +8716,89 @@ func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
-func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ReportSchedule.ProtoReflect.Descriptor instead.
+func (*ReportSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GenerateReportRequest) GetStartId() string {
+func (x *ReportSchedule) GetId() string {
 	if x != nil {
-		return x.StartId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GenerateReportRequest) GetEndId() string {
+func (x *ReportSchedule) GetName() string {
 	if x != nil {
-		return x.EndId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateReportRequest) GetMaxHops() int32 {
+func (x *ReportSchedule) GetCron() string {
 	if x != nil {
-		return x.MaxHops
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *ReportSchedule) GetRequest() *GenerateReportRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReportSchedule) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
 	}
 	return 0
 }
 
-type GenerateReportResponse struct {
+func (x *ReportSchedule) GetLastRunTs() int64 {
+	if x != nil {
+		return x.LastRunTs
+	}
+	return 0
+}
+
+func (x *ReportSchedule) GetLastJobId() string {
+	if x != nil {
+		return x.LastJobId
+	}
+	return ""
+}
+
+func (x *ReportSchedule) GetNextRunTs() int64 {
+	if x != nil {
+		return x.NextRunTs
+	}
+	return 0
+}
+
+type ReportSchedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Created       bool                   `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Schedules     []*ReportSchedule      `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateReportResponse) Reset() {
-	*x = GenerateReportResponse{}
-	mi := &file_proto_routegraph_proto_msgTypes[30]
+func (x *ReportSchedulesResponse) Reset() {
+	*x = ReportSchedulesResponse{}
+	mi := &file_proto_routegraph_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateReportResponse) String() string {
+func (x *ReportSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateReportResponse) ProtoMessage() {}
+func (*ReportSchedulesResponse) ProtoMessage() {}
 
-func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_routegraph_proto_msgTypes[30]
+func (x *ReportSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_routegraph_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,23 +8809,16 @@ func (x *GenerateReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateReportResponse.ProtoReflect.Descriptor instead.
-func (*GenerateReportResponse) Descriptor() ([]byte, []int) {
-	return file_proto_routegraph_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *GenerateReportResponse) GetCreated() bool {
-	if x != nil {
-		return x.Created
-	}
-	return false
+// Deprecated: Use ReportSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ReportSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_routegraph_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GenerateReportResponse) GetFilename() string {
+func (x *ReportSchedulesResponse) GetSchedules() []*ReportSchedule {
 	if x != nil {
-		return x.Filename
+		return x.Schedules
 	}
-	return ""
+	return nil
 }
 
 var File_proto_routegraph_proto protoreflect.FileDescriptor
@@ -1738,7 +8842,7 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12%\n" +
 	"\x0efrequency_mins\x18\x04 \x01(\x05R\rfrequencyMins\x12\x16\n" +
-	"\x06active\x18\x05 \x01(\bR\x06active\"\xde\x01\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\xa7\x02\n" +
 	"\aVehicle\x12!\n" +
 	"\fvehicle_uuid\x18\x01 \x01(\tR\vvehicleUuid\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
@@ -1747,7 +8851,11 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\flast_seen_ts\x18\x05 \x01(\x03R\n" +
 	"lastSeenTs\x12$\n" +
 	"\x0elast_known_lat\x18\x06 \x01(\x01R\flastKnownLat\x12$\n" +
-	"\x0elast_known_lon\x18\a \x01(\x01R\flastKnownLon\"k\n" +
+	"\x0elast_known_lon\x18\a \x01(\x01R\flastKnownLon\x12\x1c\n" +
+	"\tdirection\x18\b \x01(\tR\tdirection\x12\x1d\n" +
+	"\aheading\x18\t \x01(\x01H\x00R\aheading\x88\x01\x01B\n" +
+	"\n" +
+	"\b_heading\"k\n" +
 	"\x05Depot\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -1759,12 +8867,16 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x1f\n" +
 	"\vtravel_time\x18\x03 \x01(\x05R\n" +
 	"travelTime\x12\x1a\n" +
-	"\bdistance\x18\x04 \x01(\x05R\bdistance\"T\n" +
+	"\bdistance\x18\x04 \x01(\x05R\bdistance\"\xd4\x01\n" +
 	"\n" +
 	"ServesEdge\x12\x17\n" +
 	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x17\n" +
 	"\astop_id\x18\x02 \x01(\tR\x06stopId\x12\x14\n" +
-	"\x05order\x18\x03 \x01(\x05R\x05order\"^\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x05 \x01(\tR\apattern\x120\n" +
+	"\x11average_boardings\x18\x06 \x01(\x05H\x00R\x10averageBoardings\x88\x01\x01B\x14\n" +
+	"\x12_average_boardings\"^\n" +
 	"\n" +
 	"AssignedTo\x12!\n" +
 	"\fvehicle_uuid\x18\x01 \x01(\tR\vvehicleUuid\x12\x17\n" +
@@ -1778,18 +8890,63 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\aline_id\x18\x01 \x01(\tR\x06lineId\"_\n" +
 	"\x15AssignVehicleResponse\x12-\n" +
 	"\avehicle\x18\x01 \x01(\v2\x13.routegraph.VehicleR\avehicle\x12\x17\n" +
-	"\aline_id\x18\x02 \x01(\tR\x06lineId\"e\n" +
+	"\aline_id\x18\x02 \x01(\tR\x06lineId\"\x89\x02\n" +
 	"\x12RecalibrateRequest\x12\x17\n" +
 	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
 	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12!\n" +
-	"\fobserved_avg\x18\x03 \x01(\x05R\vobservedAvg\"Z\n" +
+	"\fobserved_avg\x18\x03 \x01(\x05R\vobservedAvg\x12\x1f\n" +
+	"\vobserved_at\x18\x04 \x01(\x03R\n" +
+	"observedAt\x12!\n" +
+	"\fvehicle_uuid\x18\x05 \x01(\tR\vvehicleUuid\x12\x1c\n" +
+	"\testimator\x18\x06 \x01(\tR\testimator\x12\x1f\n" +
+	"\vwindow_days\x18\a \x01(\x05R\n" +
+	"windowDays\x12\x1f\n" +
+	"\vmin_samples\x18\b \x01(\x05R\n" +
+	"minSamples\"\xce\x01\n" +
+	"\x12TravelTimeEstimate\x12\x12\n" +
+	"\x04band\x18\x01 \x01(\tR\x04band\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\x05R\asamples\x12\x16\n" +
+	"\x06median\x18\x03 \x01(\x01R\x06median\x12\x10\n" +
+	"\x03p15\x18\x04 \x01(\x01R\x03p15\x12\x10\n" +
+	"\x03p85\x18\x05 \x01(\x01R\x03p85\x12\x12\n" +
+	"\x04ewma\x18\x06 \x01(\x01R\x04ewma\x12\x1a\n" +
+	"\bestimate\x18\a \x01(\x05R\bestimate\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\"\xf5\x02\n" +
+	"\x13RecalibrateResponse\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x1c\n" +
+	"\testimator\x18\x03 \x01(\tR\testimator\x12&\n" +
+	"\x0fold_travel_time\x18\x04 \x01(\x05R\roldTravelTime\x12&\n" +
+	"\x0fnew_travel_time\x18\x05 \x01(\x05R\rnewTravelTime\x12\x18\n" +
+	"\aupdated\x18\x06 \x01(\bR\aupdated\x12\x18\n" +
+	"\asamples\x18\a \x01(\x05R\asamples\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\x128\n" +
+	"\aoverall\x18\t \x01(\v2\x1e.routegraph.TravelTimeEstimateR\aoverall\x124\n" +
+	"\x05bands\x18\n" +
+	" \x03(\v2\x1e.routegraph.TravelTimeEstimateR\x05bands\"\xdf\x01\n" +
 	"\vPathRequest\x12\x19\n" +
 	"\bstart_id\x18\x01 \x01(\tR\astartId\x12\x15\n" +
 	"\x06end_id\x18\x02 \x01(\tR\x05endId\x12\x19\n" +
-	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\"=\n" +
+	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\x12\x17\n" +
+	"\aline_id\x18\x04 \x01(\tR\x06lineId\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12\x13\n" +
+	"\x05at_ts\x18\a \x01(\x03R\x04atTs\x12\x1d\n" +
+	"\n" +
+	"allow_walk\x18\b \x01(\bR\tallowWalk\"\x9c\x01\n" +
 	"\fPathResponse\x12\x19\n" +
 	"\bnode_ids\x18\x01 \x03(\tR\anodeIds\x12\x12\n" +
-	"\x04hops\x18\x02 \x01(\x05R\x04hops\"'\n" +
+	"\x04hops\x18\x02 \x01(\x05R\x04hops\x12\x1d\n" +
+	"\n" +
+	"link_types\x18\x03 \x03(\tR\tlinkTypes\x12\x1f\n" +
+	"\vtravel_time\x18\x04 \x01(\x05R\n" +
+	"travelTime\x12\x1d\n" +
+	"\n" +
+	"arrival_ts\x18\x05 \x01(\x03R\tarrivalTs\"'\n" +
 	"\x0fTopPairsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"@\n" +
 	"\x04Pair\x12\x12\n" +
@@ -1811,9 +8968,11 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\x0fNextListRequest\x12\x17\n" +
 	"\astop_id\x18\x01 \x01(\tR\x06stopId\">\n" +
 	"\x10NextListResponse\x12*\n" +
-	"\x05edges\x18\x01 \x03(\v2\x14.routegraph.NextEdgeR\x05edges\",\n" +
+	"\x05edges\x18\x01 \x03(\v2\x14.routegraph.NextEdgeR\x05edges\"d\n" +
 	"\x11ServesListRequest\x12\x17\n" +
-	"\aline_id\x18\x01 \x01(\tR\x06lineId\"B\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\"B\n" +
 	"\x12ServesListResponse\x12,\n" +
 	"\x05edges\x18\x01 \x03(\v2\x16.routegraph.ServesEdgeR\x05edges\"8\n" +
 	"\x13AssignedListRequest\x12!\n" +
@@ -1823,14 +8982,609 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\x11ParkedListRequest\x12\x19\n" +
 	"\bdepot_id\x18\x01 \x01(\tR\adepotId\"B\n" +
 	"\x12ParkedListResponse\x12,\n" +
-	"\x06parked\x18\x01 \x03(\v2\x14.routegraph.ParkedAtR\x06parked\"d\n" +
+	"\x06parked\x18\x01 \x03(\v2\x14.routegraph.ParkedAtR\x06parked\"a\n" +
+	"\tRouteStop\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x1f\n" +
+	"\vtravel_time\x18\x02 \x01(\x05R\n" +
+	"travelTime\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\"\x93\x01\n" +
+	"\x13SetLineRouteRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12+\n" +
+	"\x05stops\x18\x02 \x03(\v2\x15.routegraph.RouteStopR\x05stops\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\"\xa5\x02\n" +
+	"\x11InsertStopRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x17\n" +
+	"\astop_id\x18\x02 \x01(\tR\x06stopId\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12$\n" +
+	"\x0etravel_time_in\x18\x04 \x01(\x05R\ftravelTimeIn\x12\x1f\n" +
+	"\vdistance_in\x18\x05 \x01(\x05R\n" +
+	"distanceIn\x12&\n" +
+	"\x0ftravel_time_out\x18\x06 \x01(\x05R\rtravelTimeOut\x12!\n" +
+	"\fdistance_out\x18\a \x01(\x05R\vdistanceOut\x12\x1c\n" +
+	"\tdirection\x18\b \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\t \x01(\tR\apattern\"\xba\x01\n" +
+	"\x11RemoveStopRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x17\n" +
+	"\astop_id\x18\x02 \x01(\tR\x06stopId\x12\x1f\n" +
+	"\vtravel_time\x18\x03 \x01(\x05R\n" +
+	"travelTime\x12\x1a\n" +
+	"\bdistance\x18\x04 \x01(\x05R\bdistance\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\"\x88\x02\n" +
+	"\x11LineRouteResponse\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12,\n" +
+	"\x05stops\x18\x02 \x03(\v2\x16.routegraph.ServesEdgeR\x05stops\x129\n" +
+	"\rcreated_edges\x18\x03 \x03(\v2\x14.routegraph.NextEdgeR\fcreatedEdges\x129\n" +
+	"\rremoved_edges\x18\x04 \x03(\v2\x14.routegraph.NextEdgeR\fremovedEdges\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\"\xcb\x01\n" +
+	"\fRoutePattern\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05stops\x18\a \x01(\x05R\x05stops\"K\n" +
+	"\x13ListPatternsRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1b\n" +
+	"\tactive_at\x18\x02 \x01(\tR\bactiveAt\"L\n" +
+	"\x14ListPatternsResponse\x124\n" +
+	"\bpatterns\x18\x01 \x03(\v2\x18.routegraph.RoutePatternR\bpatterns\"\x8b\x01\n" +
+	"\rServicePeriod\x12\x19\n" +
+	"\bday_type\x18\x01 \x01(\tR\adayType\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x12%\n" +
+	"\x0efrequency_mins\x18\x04 \x01(\x05R\rfrequencyMins\"e\n" +
+	"\x15ServicePeriodsRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x123\n" +
+	"\aperiods\x18\x02 \x03(\v2\x19.routegraph.ServicePeriodR\aperiods\"\x8d\x01\n" +
+	"\x16ServicePeriodsResponse\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12%\n" +
+	"\x0efrequency_mins\x18\x02 \x01(\x05R\rfrequencyMins\x123\n" +
+	"\aperiods\x18\x03 \x03(\v2\x19.routegraph.ServicePeriodR\aperiods\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"C\n" +
+	"\x10HolidaysResponse\x12/\n" +
+	"\bholidays\x18\x01 \x03(\v2\x13.routegraph.HolidayR\bholidays\"?\n" +
+	"\x14LineFrequencyRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\x03R\x02at\"\xd4\x01\n" +
+	"\x15LineFrequencyResponse\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\x03R\x02at\x12\x19\n" +
+	"\bday_type\x18\x03 \x01(\tR\adayType\x12%\n" +
+	"\x0efrequency_mins\x18\x04 \x01(\x05R\rfrequencyMins\x121\n" +
+	"\x06period\x18\x05 \x01(\v2\x19.routegraph.ServicePeriodR\x06period\x12\x1d\n" +
+	"\n" +
+	"in_service\x18\x06 \x01(\bR\tinService\"1\n" +
+	"\x16ValidateNetworkRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\"\xc7\x01\n" +
+	"\x11ValidationFinding\x12\x1a\n" +
+	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x17\n" +
+	"\aline_id\x18\x03 \x01(\tR\x06lineId\x12\x17\n" +
+	"\astop_id\x18\x04 \x01(\tR\x06stopId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1c\n" +
+	"\tdirection\x18\x06 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\a \x01(\tR\apattern\"\x88\x01\n" +
+	"\x17ValidateNetworkResponse\x129\n" +
+	"\bfindings\x18\x01 \x03(\v2\x1d.routegraph.ValidationFindingR\bfindings\x12\x16\n" +
+	"\x06errors\x18\x02 \x01(\x05R\x06errors\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x01(\x05R\bwarnings\"\xd2\x02\n" +
+	"\x10TimetableRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x1d\n" +
+	"\n" +
+	"dwell_secs\x18\x05 \x01(\x05R\tdwellSecs\x12W\n" +
+	"\x0fstop_dwell_secs\x18\x06 \x03(\v2/.routegraph.TimetableRequest.StopDwellSecsEntryR\rstopDwellSecs\x12\x1f\n" +
+	"\vinclude_csv\x18\a \x01(\bR\n" +
+	"includeCsv\x1a@\n" +
+	"\x12StopDwellSecsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x92\x01\n" +
+	"\rTimetableStop\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05order\x18\x03 \x01(\x05R\x05order\x12\x1f\n" +
+	"\voffset_secs\x18\x04 \x01(\x05R\n" +
+	"offsetSecs\x12\x1d\n" +
+	"\n" +
+	"dwell_secs\x18\x05 \x01(\x05R\tdwellSecs\"C\n" +
+	"\rTimetableTrip\x12\x1c\n" +
+	"\tdeparture\x18\x01 \x01(\tR\tdeparture\x12\x14\n" +
+	"\x05times\x18\x02 \x03(\tR\x05times\"\x87\x02\n" +
+	"\x11TimetableResponse\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x19\n" +
+	"\bday_type\x18\x03 \x01(\tR\adayType\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x05 \x01(\tR\apattern\x12/\n" +
+	"\x05stops\x18\x06 \x03(\v2\x19.routegraph.TimetableStopR\x05stops\x12/\n" +
+	"\x05trips\x18\a \x03(\v2\x19.routegraph.TimetableTripR\x05trips\x12\x10\n" +
+	"\x03csv\x18\b \x01(\tR\x03csv\"{\n" +
+	"\x15DepartureBoardRequest\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\x03R\x02at\x12#\n" +
+	"\rinterval_secs\x18\x04 \x01(\x05R\fintervalSecs\"\xaf\x02\n" +
+	"\tDeparture\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1b\n" +
+	"\tline_name\x18\x02 \x01(\tR\blineName\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12 \n" +
+	"\vdestination\x18\x05 \x01(\tR\vdestination\x12\x1c\n" +
+	"\tscheduled\x18\x06 \x01(\tR\tscheduled\x12\x1f\n" +
+	"\vexpected_at\x18\a \x01(\x03R\n" +
+	"expectedAt\x12\x18\n" +
+	"\aminutes\x18\b \x01(\x05R\aminutes\x12\x1a\n" +
+	"\brealtime\x18\t \x01(\bR\brealtime\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\n" +
+	" \x01(\tR\tvehicleId\"\xa8\x01\n" +
+	"\x16DepartureBoardResponse\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x1b\n" +
+	"\tstop_name\x18\x02 \x01(\tR\bstopName\x12!\n" +
+	"\fgenerated_at\x18\x03 \x01(\x03R\vgeneratedAt\x125\n" +
+	"\n" +
+	"departures\x18\x04 \x03(\v2\x15.routegraph.DepartureR\n" +
+	"departures\"\x87\x02\n" +
+	"\n" +
+	"Disruption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\astop_id\x18\x03 \x01(\tR\x06stopId\x12\x17\n" +
+	"\afrom_id\x18\x04 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x05 \x01(\tR\x04toId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x19\n" +
+	"\bstart_ts\x18\a \x01(\x03R\astartTs\x12\x15\n" +
+	"\x06end_ts\x18\b \x01(\x03R\x05endTs\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x12,\n" +
+	"\adetours\x18\n" +
+	" \x03(\v2\x12.routegraph.DetourR\adetours\"\xbb\x02\n" +
+	"\x06Detour\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x17\n" +
+	"\afrom_id\x18\x04 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x05 \x01(\tR\x04toId\x12#\n" +
+	"\rskipped_stops\x18\x06 \x03(\tR\fskippedStops\x12\x12\n" +
+	"\x04path\x18\a \x03(\tR\x04path\x12#\n" +
+	"\roriginal_time\x18\b \x01(\x05R\foriginalTime\x12\x1f\n" +
+	"\vdetour_time\x18\t \x01(\x05R\n" +
+	"detourTime\x12\x1d\n" +
+	"\n" +
+	"added_time\x18\n" +
+	" \x01(\x05R\taddedTime\x12\x14\n" +
+	"\x05found\x18\v \x01(\bR\x05found\"N\n" +
+	"\x16ListDisruptionsRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\x12\x13\n" +
+	"\x05at_ts\x18\x02 \x01(\x03R\x04atTs\"S\n" +
+	"\x17ListDisruptionsResponse\x128\n" +
+	"\vdisruptions\x18\x01 \x03(\v2\x16.routegraph.DisruptionR\vdisruptions\"\x82\x02\n" +
+	"\x0eScenarioChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12$\n" +
+	"\x04stop\x18\x02 \x01(\v2\x10.routegraph.StopR\x04stop\x12\x17\n" +
+	"\astop_id\x18\x03 \x01(\tR\x06stopId\x12(\n" +
+	"\x04edge\x18\x04 \x01(\v2\x14.routegraph.NextEdgeR\x04edge\x125\n" +
+	"\x05route\x18\x05 \x01(\v2\x1f.routegraph.SetLineRouteRequestR\x05route\x12<\n" +
+	"\aservice\x18\x06 \x01(\v2\".routegraph.ServicePeriodsResponseR\aservice\"\xde\x01\n" +
+	"\bScenario\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x05 \x01(\x03R\tcreatedTs\x12\x1f\n" +
+	"\vpromoted_ts\x18\x06 \x01(\x03R\n" +
+	"promotedTs\x124\n" +
+	"\achanges\x18\a \x03(\v2\x1a.routegraph.ScenarioChangeR\achanges\"G\n" +
+	"\x11ScenariosResponse\x122\n" +
+	"\tscenarios\x18\x01 \x03(\v2\x14.routegraph.ScenarioR\tscenarios\"\x92\x01\n" +
+	"\x17EvaluateScenarioRequest\x12\x1f\n" +
+	"\vscenario_id\x18\x01 \x01(\tR\n" +
+	"scenarioId\x12\x19\n" +
+	"\bstart_id\x18\x02 \x01(\tR\astartId\x12\x15\n" +
+	"\x06end_id\x18\x03 \x01(\tR\x05endId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x0e\n" +
+	"\x02at\x18\x05 \x01(\x03R\x02at\"]\n" +
+	"\rConnectedStop\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x1b\n" +
+	"\tstop_name\x18\x02 \x01(\tR\bstopName\x12\x16\n" +
+	"\x06degree\x18\x03 \x01(\x05R\x06degree\"\xa6\x01\n" +
+	"\rLineFleetSize\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12%\n" +
+	"\x0efrequency_mins\x18\x02 \x01(\x05R\rfrequencyMins\x12\x1d\n" +
+	"\n" +
+	"cycle_secs\x18\x03 \x01(\x05R\tcycleSecs\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\x05R\brequired\x12\x1a\n" +
+	"\bassigned\x18\x05 \x01(\x05R\bassigned\"\xc9\x02\n" +
+	"\x0eNetworkMetrics\x12,\n" +
+	"\x04path\x18\x01 \x01(\v2\x18.routegraph.PathResponseR\x04path\x12(\n" +
+	"\x10path_travel_time\x18\x02 \x01(\x05R\x0epathTravelTime\x12-\n" +
+	"\ttop_pairs\x18\x03 \x03(\v2\x10.routegraph.PairR\btopPairs\x126\n" +
+	"\ttop_stops\x18\x04 \x03(\v2\x19.routegraph.ConnectedStopR\btopStops\x12/\n" +
+	"\x05fleet\x18\x05 \x03(\v2\x19.routegraph.LineFleetSizeR\x05fleet\x12+\n" +
+	"\x11required_vehicles\x18\x06 \x01(\x05R\x10requiredVehicles\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings\"\x9f\x02\n" +
+	"\n" +
+	"FleetDelta\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12)\n" +
+	"\x10frequency_before\x18\x02 \x01(\x05R\x0ffrequencyBefore\x12'\n" +
+	"\x0ffrequency_after\x18\x03 \x01(\x05R\x0efrequencyAfter\x12*\n" +
+	"\x11cycle_secs_before\x18\x04 \x01(\x05R\x0fcycleSecsBefore\x12(\n" +
+	"\x10cycle_secs_after\x18\x05 \x01(\x05R\x0ecycleSecsAfter\x12'\n" +
+	"\x0frequired_before\x18\x06 \x01(\x05R\x0erequiredBefore\x12%\n" +
+	"\x0erequired_after\x18\a \x01(\x05R\rrequiredAfter\"\xb0\x03\n" +
+	"\fScenarioDiff\x12\x1f\n" +
+	"\vstops_added\x18\x01 \x03(\tR\n" +
+	"stopsAdded\x12#\n" +
+	"\rstops_removed\x18\x02 \x03(\tR\fstopsRemoved\x125\n" +
+	"\vedges_added\x18\x03 \x03(\v2\x14.routegraph.NextEdgeR\n" +
+	"edgesAdded\x129\n" +
+	"\redges_removed\x18\x04 \x03(\v2\x14.routegraph.NextEdgeR\fedgesRemoved\x12%\n" +
+	"\x0eroutes_changed\x18\x05 \x03(\tR\rroutesChanged\x12,\n" +
+	"\x05fleet\x18\x06 \x03(\v2\x16.routegraph.FleetDeltaR\x05fleet\x126\n" +
+	"\x17required_vehicles_delta\x18\a \x01(\x05R\x15requiredVehiclesDelta\x12&\n" +
+	"\x0fpath_hops_delta\x18\b \x01(\x05R\rpathHopsDelta\x123\n" +
+	"\x16path_travel_time_delta\x18\t \x01(\x05R\x13pathTravelTimeDelta\"\xd3\x01\n" +
+	"\x12ScenarioEvaluation\x12\x1f\n" +
+	"\vscenario_id\x18\x01 \x01(\tR\n" +
+	"scenarioId\x126\n" +
+	"\bbaseline\x18\x02 \x01(\v2\x1a.routegraph.NetworkMetricsR\bbaseline\x126\n" +
+	"\bscenario\x18\x03 \x01(\v2\x1a.routegraph.NetworkMetricsR\bscenario\x12,\n" +
+	"\x04diff\x18\x04 \x01(\v2\x18.routegraph.ScenarioDiffR\x04diff\"R\n" +
+	"\x17CriticalElementsRequest\x12!\n" +
+	"\fsample_pairs\x18\x01 \x01(\x05R\vsamplePairs\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xe6\x02\n" +
+	"\x0fCriticalElement\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\astop_id\x18\x02 \x01(\tR\x06stopId\x12\x17\n" +
+	"\afrom_id\x18\x03 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x04 \x01(\tR\x04toId\x12\x10\n" +
+	"\x03cut\x18\x05 \x01(\bR\x03cut\x12\x16\n" +
+	"\x06degree\x18\x06 \x01(\x05R\x06degree\x12\x14\n" +
+	"\x05pairs\x18\a \x01(\x05R\x05pairs\x12-\n" +
+	"\x12disconnected_pairs\x18\b \x01(\x05R\x11disconnectedPairs\x123\n" +
+	"\x16avg_travel_time_before\x18\t \x01(\x01R\x13avgTravelTimeBefore\x121\n" +
+	"\x15avg_travel_time_after\x18\n" +
+	" \x01(\x01R\x12avgTravelTimeAfter\x12!\n" +
+	"\favg_increase\x18\v \x01(\x01R\vavgIncrease\"\xfd\x01\n" +
+	"\x18CriticalElementsResponse\x12/\n" +
+	"\x13articulation_points\x18\x01 \x03(\tR\x12articulationPoints\x12.\n" +
+	"\abridges\x18\x02 \x03(\v2\x14.routegraph.NextEdgeR\abridges\x12#\n" +
+	"\rsampled_pairs\x18\x03 \x01(\x05R\fsampledPairs\x12&\n" +
+	"\x0favg_travel_time\x18\x04 \x01(\x01R\ravgTravelTime\x123\n" +
+	"\x06ranked\x18\x05 \x03(\v2\x1b.routegraph.CriticalElementR\x06ranked\"E\n" +
+	"\x15StopCentralityRequest\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xd4\x01\n" +
+	"\x12StopCentralityItem\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x1b\n" +
+	"\tstop_name\x18\x02 \x01(\tR\bstopName\x12\x16\n" +
+	"\x06degree\x18\x03 \x01(\x05R\x06degree\x12\x14\n" +
+	"\x05lines\x18\x04 \x01(\x05R\x05lines\x12 \n" +
+	"\vbetweenness\x18\x05 \x01(\x01R\vbetweenness\x12\x1c\n" +
+	"\tcloseness\x18\x06 \x01(\x01R\tcloseness\x12\x1a\n" +
+	"\bpagerank\x18\a \x01(\x01R\bpagerank\"f\n" +
+	"\x16StopCentralityResponse\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x124\n" +
+	"\x05stops\x18\x02 \x03(\v2\x1e.routegraph.StopCentralityItemR\x05stops\"u\n" +
+	"\x18GenerateWalkLinksRequest\x12\x19\n" +
+	"\bradius_m\x18\x01 \x01(\x01R\aradiusM\x12$\n" +
+	"\x0ewalk_speed_mps\x18\x02 \x01(\x01R\fwalkSpeedMps\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"i\n" +
+	"\x19GenerateWalkLinksResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x18\n" +
+	"\aremoved\x18\x03 \x01(\x05R\aremoved\"H\n" +
+	"\x13TransferHubsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tmin_lines\x18\x02 \x01(\x05R\bminLines\"\xc7\x01\n" +
+	"\vTransferHub\x12\x19\n" +
+	"\bstop_ids\x18\x01 \x03(\tR\astopIds\x12\x19\n" +
+	"\bline_ids\x18\x02 \x03(\tR\alineIds\x12\x14\n" +
+	"\x05lines\x18\x03 \x01(\x05R\x05lines\x12\x10\n" +
+	"\x03lat\x18\x04 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x05 \x01(\x01R\x03lon\x12\"\n" +
+	"\rmax_walk_time\x18\x06 \x01(\x05R\vmaxWalkTime\x12$\n" +
+	"\x0ecentre_stop_id\x18\a \x01(\tR\fcentreStopId\"C\n" +
+	"\x14TransferHubsResponse\x12+\n" +
+	"\x04hubs\x18\x01 \x03(\v2\x17.routegraph.TransferHubR\x04hubs\"F\n" +
+	"\x13ConnectivityRequest\x12\x19\n" +
+	"\bradius_m\x18\x01 \x01(\x01R\aradiusM\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"N\n" +
+	"\rStopComponent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x19\n" +
+	"\bstop_ids\x18\x03 \x03(\tR\astopIds\"\xc5\x01\n" +
+	"\rLinkCandidate\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12%\n" +
+	"\x0efrom_component\x18\x03 \x01(\x05R\rfromComponent\x12!\n" +
+	"\fto_component\x18\x04 \x01(\x05R\vtoComponent\x12\x1d\n" +
+	"\n" +
+	"distance_m\x18\x05 \x01(\x01R\tdistanceM\x12\x1d\n" +
+	"\n" +
+	"joins_weak\x18\x06 \x01(\bR\tjoinsWeak\"\x8e\x02\n" +
+	"\x14ConnectivityResponse\x12-\n" +
+	"\x12strongly_connected\x18\x01 \x01(\bR\x11stronglyConnected\x121\n" +
+	"\x06strong\x18\x02 \x03(\v2\x19.routegraph.StopComponentR\x06strong\x12-\n" +
+	"\x04weak\x18\x03 \x03(\v2\x19.routegraph.StopComponentR\x04weak\x12*\n" +
+	"\x11isolated_stop_ids\x18\x04 \x03(\tR\x0fisolatedStopIds\x129\n" +
+	"\n" +
+	"candidates\x18\x05 \x03(\v2\x19.routegraph.LinkCandidateR\n" +
+	"candidates\"?\n" +
+	"\x11LoadDemandRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\tR\x03csv\x12\x18\n" +
+	"\areplace\x18\x02 \x01(\bR\areplace\"j\n" +
+	"\x12LoadDemandResponse\x12\x16\n" +
+	"\x06loaded\x18\x01 \x01(\x05R\x06loaded\x12$\n" +
+	"\x0etrips_per_hour\x18\x02 \x01(\x01R\ftripsPerHour\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"d\n" +
+	"\x13AssignDemandRequest\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12'\n" +
+	"\x0foverloaded_only\x18\x03 \x01(\bR\x0eoverloadedOnly\"\xc5\x01\n" +
+	"\bEdgeLoad\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x19\n" +
+	"\bline_ids\x18\x03 \x03(\tR\alineIds\x12\x12\n" +
+	"\x04load\x18\x04 \x01(\x01R\x04load\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x01R\bcapacity\x12 \n" +
+	"\vutilization\x18\x06 \x01(\x01R\vutilization\x12\x1e\n" +
+	"\n" +
+	"overloaded\x18\a \x01(\bR\n" +
+	"overloaded\"\xd9\x02\n" +
+	"\bLineLoad\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12%\n" +
+	"\x0efrequency_mins\x18\x02 \x01(\x05R\rfrequencyMins\x12)\n" +
+	"\x10vehicle_capacity\x18\x03 \x01(\x01R\x0fvehicleCapacity\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x01R\bcapacity\x12\x1b\n" +
+	"\tpeak_load\x18\x05 \x01(\x01R\bpeakLoad\x12 \n" +
+	"\fpeak_from_id\x18\x06 \x01(\tR\n" +
+	"peakFromId\x12\x1c\n" +
+	"\n" +
+	"peak_to_id\x18\a \x01(\tR\bpeakToId\x12 \n" +
+	"\vutilization\x18\b \x01(\x01R\vutilization\x12\x1e\n" +
+	"\n" +
+	"overloaded\x18\t \x01(\bR\n" +
+	"overloaded\x12'\n" +
+	"\x0fpassenger_hours\x18\n" +
+	" \x01(\x01R\x0epassengerHours\"\xbc\x02\n" +
+	"\x14AssignDemandResponse\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12\x14\n" +
+	"\x05pairs\x18\x02 \x01(\x05R\x05pairs\x12)\n" +
+	"\x10unassigned_pairs\x18\x03 \x01(\x05R\x0funassignedPairs\x12%\n" +
+	"\x0eassigned_trips\x18\x04 \x01(\x01R\rassignedTrips\x12)\n" +
+	"\x10unassigned_trips\x18\x05 \x01(\x01R\x0funassignedTrips\x12)\n" +
+	"\x10overloaded_edges\x18\x06 \x01(\x05R\x0foverloadedEdges\x12*\n" +
+	"\x05edges\x18\a \x03(\v2\x14.routegraph.EdgeLoadR\x05edges\x12*\n" +
+	"\x05lines\x18\b \x03(\v2\x14.routegraph.LineLoadR\x05lines\"8\n" +
+	"\x10RidershipRequest\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xb7\x01\n" +
+	"\rStopRidership\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\x12\x19\n" +
+	"\bline_ids\x18\x04 \x03(\tR\alineIds\x12\x1c\n" +
+	"\tboardings\x18\x05 \x01(\x01R\tboardings\x12,\n" +
+	"\x12boardings_per_hour\x18\x06 \x01(\x01R\x10boardingsPerHour\"\xb5\x02\n" +
+	"\rLineRidership\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0efrequency_mins\x18\x03 \x01(\x05R\rfrequencyMins\x12\x1c\n" +
+	"\tboardings\x18\x04 \x01(\x01R\tboardings\x12,\n" +
+	"\x12boardings_per_hour\x18\x05 \x01(\x01R\x10boardingsPerHour\x12)\n" +
+	"\x10vehicle_capacity\x18\x06 \x01(\x01R\x0fvehicleCapacity\x12=\n" +
+	"\x1bboardings_per_capacity_hour\x18\a \x01(\x01R\x18boardingsPerCapacityHour\x12\x1a\n" +
+	"\bcapacity\x18\b \x01(\x01R\bcapacity\"\x85\x01\n" +
+	"\rZoneRidership\x12\x12\n" +
+	"\x04zone\x18\x01 \x01(\tR\x04zone\x12\x14\n" +
+	"\x05stops\x18\x02 \x01(\x05R\x05stops\x12\x1c\n" +
+	"\tboardings\x18\x03 \x01(\x01R\tboardings\x12,\n" +
+	"\x12boardings_per_hour\x18\x04 \x01(\x01R\x10boardingsPerHour\"\xdd\x02\n" +
+	"\x11RidershipResponse\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12>\n" +
+	"\rbusiest_stops\x18\x02 \x03(\v2\x19.routegraph.StopRidershipR\fbusiestStops\x12>\n" +
+	"\rbusiest_lines\x18\x03 \x03(\v2\x19.routegraph.LineRidershipR\fbusiestLines\x12/\n" +
+	"\x05zones\x18\x04 \x03(\v2\x19.routegraph.ZoneRidershipR\x05zones\x12,\n" +
+	"\x12boardings_per_hour\x18\x05 \x01(\x01R\x10boardingsPerHour\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x01R\bcapacity\x12=\n" +
+	"\x1bboardings_per_capacity_hour\x18\a \x01(\x01R\x18boardingsPerCapacityHour\"o\n" +
+	"\fProfileEntry\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x12\n" +
+	"\x04band\x18\x02 \x01(\tR\x04band\x12\x1f\n" +
+	"\vtravel_time\x18\x03 \x01(\x05R\n" +
+	"travelTime\x12\x18\n" +
+	"\asamples\x18\x04 \x01(\x05R\asamples\"\xaa\x01\n" +
+	"\vEdgeProfile\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x1f\n" +
+	"\vtravel_time\x18\x03 \x01(\x05R\n" +
+	"travelTime\x122\n" +
+	"\aentries\x18\x04 \x03(\v2\x18.routegraph.ProfileEntryR\aentries\x12\x18\n" +
+	"\areplace\x18\x05 \x01(\bR\areplace\"\xa8\x01\n" +
+	"\x18BuildEdgeProfilesRequest\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12\x1c\n" +
+	"\testimator\x18\x03 \x01(\tR\testimator\x12\x1f\n" +
+	"\vwindow_days\x18\x04 \x01(\x05R\n" +
+	"windowDays\x12\x1f\n" +
+	"\vmin_samples\x18\x05 \x01(\x05R\n" +
+	"minSamples\"\x80\x01\n" +
+	"\x19BuildEdgeProfilesResponse\x12\x14\n" +
+	"\x05edges\x18\x01 \x01(\x05R\x05edges\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x05R\aentries\x123\n" +
+	"\bprofiles\x18\x03 \x03(\v2\x17.routegraph.EdgeProfileR\bprofiles\"I\n" +
+	"\x0fTripStopArrival\x12\x17\n" +
+	"\astop_id\x18\x01 \x01(\tR\x06stopId\x12\x1d\n" +
+	"\n" +
+	"arrival_ts\x18\x02 \x01(\x03R\tarrivalTs\"\x97\x01\n" +
+	"\aTripLog\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12!\n" +
+	"\fvehicle_uuid\x18\x02 \x01(\tR\vvehicleUuid\x12\x17\n" +
+	"\aline_id\x18\x03 \x01(\tR\x06lineId\x127\n" +
+	"\barrivals\x18\x04 \x03(\v2\x1b.routegraph.TripStopArrivalR\barrivals\"\xef\x01\n" +
+	"\x14IngestTripLogRequest\x12'\n" +
+	"\x04trip\x18\x01 \x01(\v2\x13.routegraph.TripLogR\x04trip\x12\x1c\n" +
+	"\testimator\x18\x02 \x01(\tR\testimator\x12\x1f\n" +
+	"\vwindow_days\x18\x03 \x01(\x05R\n" +
+	"windowDays\x12\x1f\n" +
+	"\vmin_samples\x18\x04 \x01(\x05R\n" +
+	"minSamples\x12'\n" +
+	"\x0fdrift_threshold\x18\x05 \x01(\x01R\x0edriftThreshold\x12%\n" +
+	"\x0ebuild_profiles\x18\x06 \x01(\bR\rbuildProfiles\"\xd9\x01\n" +
+	"\tEdgeDrift\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\x12&\n" +
+	"\x0fold_travel_time\x18\x03 \x01(\x05R\roldTravelTime\x12&\n" +
+	"\x0fnew_travel_time\x18\x04 \x01(\x05R\rnewTravelTime\x12\x14\n" +
+	"\x05drift\x18\x05 \x01(\x01R\x05drift\x12\x18\n" +
+	"\asamples\x18\x06 \x01(\x05R\asamples\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\a \x01(\x01R\n" +
+	"confidence\"\xdc\x02\n" +
+	"\x15IngestTripLogResponse\x12\x14\n" +
+	"\x05trips\x18\x01 \x01(\x05R\x05trips\x12\x1a\n" +
+	"\barrivals\x18\x02 \x01(\x05R\barrivals\x12\"\n" +
+	"\fobservations\x18\x03 \x01(\x05R\fobservations\x12#\n" +
+	"\runknown_edges\x18\x04 \x01(\x05R\funknownEdges\x12-\n" +
+	"\x12edges_recalibrated\x18\x05 \x01(\x05R\x11edgesRecalibrated\x12#\n" +
+	"\redges_updated\x18\x06 \x01(\x05R\fedgesUpdated\x12'\n" +
+	"\x0fprofile_entries\x18\a \x01(\x05R\x0eprofileEntries\x12/\n" +
+	"\adrifted\x18\b \x03(\v2\x15.routegraph.EdgeDriftR\adrifted\x12\x1a\n" +
+	"\bproblems\x18\t \x03(\tR\bproblems\"\x96\x02\n" +
+	"\x11NetworkMapRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12\x19\n" +
+	"\bstart_id\x18\x04 \x01(\tR\astartId\x12\x15\n" +
+	"\x06end_id\x18\x05 \x01(\tR\x05endId\x12\x19\n" +
+	"\bmax_hops\x18\x06 \x01(\x05R\amaxHops\x12\x14\n" +
+	"\x05lines\x18\a \x03(\tR\x05lines\x12\x1f\n" +
+	"\vshade_zones\x18\b \x01(\bR\n" +
+	"shadeZones\x12\x1f\n" +
+	"\vhide_labels\x18\t \x01(\bR\n" +
+	"hideLabels\x12\x16\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tR\x06locale\"\x97\x01\n" +
+	"\x12NetworkMapResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\"q\n" +
+	"\x11ReportDataRequest\x12;\n" +
+	"\arequest\x18\x01 \x01(\v2!.routegraph.GenerateReportRequestR\arequest\x12\x1f\n" +
+	"\vinclude_csv\x18\x02 \x01(\bR\n" +
+	"includeCsv\"V\n" +
+	"\rDepotVehicles\x12\x14\n" +
+	"\x05depot\x18\x01 \x01(\tR\x05depot\x12/\n" +
+	"\bvehicles\x18\x02 \x03(\v2\x13.routegraph.VehicleR\bvehicles\"G\n" +
+	"\tZoneStops\x12\x12\n" +
+	"\x04zone\x18\x01 \x01(\tR\x04zone\x12&\n" +
+	"\x05stops\x18\x02 \x03(\v2\x10.routegraph.StopR\x05stops\"\xa2\x04\n" +
+	"\x12ReportDataResponse\x12!\n" +
+	"\fgenerated_ts\x18\x01 \x01(\x03R\vgeneratedTs\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\x03R\x02at\x12\x1a\n" +
+	"\bsections\x18\x03 \x03(\tR\bsections\x12E\n" +
+	"\x11vehicles_by_depot\x18\x04 \x03(\v2\x19.routegraph.DepotVehiclesR\x0fvehiclesByDepot\x129\n" +
+	"\rstops_by_zone\x18\x05 \x03(\v2\x15.routegraph.ZoneStopsR\vstopsByZone\x12-\n" +
+	"\ttop_pairs\x18\x06 \x03(\v2\x10.routegraph.PairR\btopPairs\x126\n" +
+	"\vdepot_stats\x18\a \x03(\v2\x15.routegraph.DepotStatR\n" +
+	"depotStats\x12?\n" +
+	"\ttop_stops\x18\b \x01(\v2\".routegraph.StopCentralityResponseR\btopStops\x12E\n" +
+	"\anotices\x18\t \x03(\v2+.routegraph.ReportDataResponse.NoticesEntryR\anotices\x12\x10\n" +
+	"\x03csv\x18\n" +
+	" \x01(\fR\x03csv\x1a:\n" +
+	"\fNoticesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"x\n" +
+	"\x10LineSheetRequest\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12\x1d\n" +
+	"\n" +
+	"all_active\x18\x02 \x01(\bR\tallActive\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x14\n" +
+	"\x05store\x18\x04 \x01(\bR\x05store\"\xb3\x04\n" +
 	"\x15GenerateReportRequest\x12\x19\n" +
 	"\bstart_id\x18\x01 \x01(\tR\astartId\x12\x15\n" +
 	"\x06end_id\x18\x02 \x01(\tR\x05endId\x12\x19\n" +
-	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\"N\n" +
+	"\bmax_hops\x18\x03 \x01(\x05R\amaxHops\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\x03R\x02at\x12*\n" +
+	"\x11timetable_line_id\x18\x05 \x01(\tR\x0ftimetableLineId\x12\x1f\n" +
+	"\vscenario_id\x18\x06 \x01(\tR\n" +
+	"scenarioId\x12+\n" +
+	"\x11centrality_metric\x18\a \x01(\tR\x10centralityMetric\x12\x16\n" +
+	"\x06format\x18\b \x01(\tR\x06format\x12\x14\n" +
+	"\x05store\x18\t \x01(\bR\x05store\x12\x1a\n" +
+	"\bsections\x18\n" +
+	" \x03(\tR\bsections\x12E\n" +
+	"\x06limits\x18\v \x03(\v2-.routegraph.GenerateReportRequest.LimitsEntryR\x06limits\x12\x14\n" +
+	"\x05zones\x18\f \x03(\tR\x05zones\x12\x16\n" +
+	"\x06depots\x18\r \x03(\tR\x06depots\x12\x14\n" +
+	"\x05lines\x18\x0e \x03(\tR\x05lines\x12\x16\n" +
+	"\x06locale\x18\x0f \x01(\tR\x06locale\x12\x1b\n" +
+	"\tmap_zones\x18\x10 \x01(\bR\bmapZones\x1a9\n" +
+	"\vLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb7\x01\n" +
 	"\x16GenerateReportResponse\x12\x18\n" +
 	"\acreated\x18\x01 \x01(\bR\acreated\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename2\xc5\x12\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"\xc3\x01\n" +
+	"\vReportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04last\x18\x03 \x01(\bR\x04last\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x06 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\a \x01(\x03R\ttotalSize\"\xc3\x02\n" +
+	"\tReportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x05R\bprogress\x12\x14\n" +
+	"\x05stage\x18\x04 \x01(\tR\x05stage\x12\x1f\n" +
+	"\vschedule_id\x18\x05 \x01(\tR\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"started_ts\x18\a \x01(\x03R\tstartedTs\x12\x1f\n" +
+	"\vfinished_ts\x18\b \x01(\x03R\n" +
+	"finishedTs\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x16\n" +
+	"\x06format\x18\n" +
+	" \x01(\tR\x06format\x12\x1a\n" +
+	"\bfilename\x18\v \x01(\tR\bfilename\x12\x12\n" +
+	"\x04size\x18\f \x01(\x03R\x04size\"?\n" +
+	"\x12ReportJobsResponse\x12)\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x15.routegraph.ReportJobR\x04jobs\"\x84\x02\n" +
+	"\x0eReportSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12;\n" +
+	"\arequest\x18\x04 \x01(\v2!.routegraph.GenerateReportRequestR\arequest\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x05 \x01(\x03R\tcreatedTs\x12\x1e\n" +
+	"\vlast_run_ts\x18\x06 \x01(\x03R\tlastRunTs\x12\x1e\n" +
+	"\vlast_job_id\x18\a \x01(\tR\tlastJobId\x12\x1e\n" +
+	"\vnext_run_ts\x18\b \x01(\x03R\tnextRunTs\"S\n" +
+	"\x17ReportSchedulesResponse\x128\n" +
+	"\tschedules\x18\x01 \x03(\v2\x1a.routegraph.ReportScheduleR\tschedules2\xe9/\n" +
 	"\n" +
 	"RouteGraph\x120\n" +
 	"\n" +
@@ -1874,12 +9628,63 @@ const file_proto_routegraph_proto_rawDesc = "" +
 	"\x0eCreateParkedAt\x12\x14.routegraph.ParkedAt\x1a\x14.routegraph.ParkedAt\x12<\n" +
 	"\x0eUpdateParkedAt\x12\x14.routegraph.ParkedAt\x1a\x14.routegraph.ParkedAt\x129\n" +
 	"\x0eDeleteParkedAt\x12\x14.routegraph.ParkedAt\x1a\x11.routegraph.Empty\x12T\n" +
-	"\rAssignVehicle\x12 .routegraph.AssignVehicleRequest\x1a!.routegraph.AssignVehicleResponse\x12G\n" +
-	"\x0fRecalibrateEdge\x12\x1e.routegraph.RecalibrateRequest\x1a\x14.routegraph.NextEdge\x12A\n" +
+	"\rAssignVehicle\x12 .routegraph.AssignVehicleRequest\x1a!.routegraph.AssignVehicleResponse\x12R\n" +
+	"\x0fRecalibrateEdge\x12\x1e.routegraph.RecalibrateRequest\x1a\x1f.routegraph.RecalibrateResponse\x12A\n" +
 	"\fShortestPath\x12\x17.routegraph.PathRequest\x1a\x18.routegraph.PathResponse\x12E\n" +
 	"\bTopPairs\x12\x1b.routegraph.TopPairsRequest\x1a\x1c.routegraph.TopPairsResponse\x12H\n" +
-	"\x0fDepotsIdleStats\x12\x19.routegraph.DepotsRequest\x1a\x1a.routegraph.DepotsResponse\x12W\n" +
-	"\x0eGenerateReport\x12!.routegraph.GenerateReportRequest\x1a\".routegraph.GenerateReportResponseB\x12Z\x10proto/routegraphb\x06proto3"
+	"\x0fDepotsIdleStats\x12\x19.routegraph.DepotsRequest\x1a\x1a.routegraph.DepotsResponse\x12N\n" +
+	"\fSetLineRoute\x12\x1f.routegraph.SetLineRouteRequest\x1a\x1d.routegraph.LineRouteResponse\x12R\n" +
+	"\x12InsertStopIntoLine\x12\x1d.routegraph.InsertStopRequest\x1a\x1d.routegraph.LineRouteResponse\x12R\n" +
+	"\x12RemoveStopFromLine\x12\x1d.routegraph.RemoveStopRequest\x1a\x1d.routegraph.LineRouteResponse\x12C\n" +
+	"\rUpsertPattern\x12\x18.routegraph.RoutePattern\x1a\x18.routegraph.RoutePattern\x12Q\n" +
+	"\fListPatterns\x12\x1f.routegraph.ListPatternsRequest\x1a .routegraph.ListPatternsResponse\x12<\n" +
+	"\rDeletePattern\x12\x18.routegraph.RoutePattern\x1a\x11.routegraph.Empty\x12Z\n" +
+	"\x11SetServicePeriods\x12!.routegraph.ServicePeriodsRequest\x1a\".routegraph.ServicePeriodsResponse\x12H\n" +
+	"\x12ListServicePeriods\x12\x0e.routegraph.ID\x1a\".routegraph.ServicePeriodsResponse\x129\n" +
+	"\rCreateHoliday\x12\x13.routegraph.Holiday\x1a\x13.routegraph.Holiday\x127\n" +
+	"\rDeleteHoliday\x12\x13.routegraph.Holiday\x1a\x11.routegraph.Empty\x12?\n" +
+	"\fListHolidays\x12\x11.routegraph.Empty\x1a\x1c.routegraph.HolidaysResponse\x12T\n" +
+	"\rLineFrequency\x12 .routegraph.LineFrequencyRequest\x1a!.routegraph.LineFrequencyResponse\x12P\n" +
+	"\x11GenerateTimetable\x12\x1c.routegraph.TimetableRequest\x1a\x1d.routegraph.TimetableResponse\x12W\n" +
+	"\x0eDepartureBoard\x12!.routegraph.DepartureBoardRequest\x1a\".routegraph.DepartureBoardResponse\x12_\n" +
+	"\x14StreamDepartureBoard\x12!.routegraph.DepartureBoardRequest\x1a\".routegraph.DepartureBoardResponse0\x01\x12B\n" +
+	"\x10CreateDisruption\x12\x16.routegraph.Disruption\x1a\x16.routegraph.Disruption\x12Z\n" +
+	"\x0fListDisruptions\x12\".routegraph.ListDisruptionsRequest\x1a#.routegraph.ListDisruptionsResponse\x127\n" +
+	"\rEndDisruption\x12\x0e.routegraph.ID\x1a\x16.routegraph.Disruption\x12<\n" +
+	"\x0eCreateScenario\x12\x14.routegraph.Scenario\x1a\x14.routegraph.Scenario\x123\n" +
+	"\vGetScenario\x12\x0e.routegraph.ID\x1a\x14.routegraph.Scenario\x12A\n" +
+	"\rListScenarios\x12\x11.routegraph.Empty\x1a\x1d.routegraph.ScenariosResponse\x12<\n" +
+	"\x0eUpdateScenario\x12\x14.routegraph.Scenario\x1a\x14.routegraph.Scenario\x123\n" +
+	"\x0eDeleteScenario\x12\x0e.routegraph.ID\x1a\x11.routegraph.Empty\x12W\n" +
+	"\x10EvaluateScenario\x12#.routegraph.EvaluateScenarioRequest\x1a\x1e.routegraph.ScenarioEvaluation\x127\n" +
+	"\x0fPromoteScenario\x12\x0e.routegraph.ID\x1a\x14.routegraph.Scenario\x12`\n" +
+	"\x11GenerateWalkLinks\x12$.routegraph.GenerateWalkLinksRequest\x1a%.routegraph.GenerateWalkLinksResponse\x12Q\n" +
+	"\fTransferHubs\x12\x1f.routegraph.TransferHubsRequest\x1a .routegraph.TransferHubsResponse\x12W\n" +
+	"\x0eStopCentrality\x12!.routegraph.StopCentralityRequest\x1a\".routegraph.StopCentralityResponse\x12]\n" +
+	"\x10CriticalElements\x12#.routegraph.CriticalElementsRequest\x1a$.routegraph.CriticalElementsResponse\x12W\n" +
+	"\x12ConnectivityReport\x12\x1f.routegraph.ConnectivityRequest\x1a .routegraph.ConnectivityResponse\x12K\n" +
+	"\n" +
+	"LoadDemand\x12\x1d.routegraph.LoadDemandRequest\x1a\x1e.routegraph.LoadDemandResponse\x12Q\n" +
+	"\fAssignDemand\x12\x1f.routegraph.AssignDemandRequest\x1a .routegraph.AssignDemandResponse\x12M\n" +
+	"\x0eRidershipStats\x12\x1c.routegraph.RidershipRequest\x1a\x1d.routegraph.RidershipResponse\x12?\n" +
+	"\x0eGetEdgeProfile\x12\x14.routegraph.NextEdge\x1a\x17.routegraph.EdgeProfile\x12B\n" +
+	"\x0eSetEdgeProfile\x12\x17.routegraph.EdgeProfile\x1a\x17.routegraph.EdgeProfile\x12`\n" +
+	"\x11BuildEdgeProfiles\x12$.routegraph.BuildEdgeProfilesRequest\x1a%.routegraph.BuildEdgeProfilesResponse\x12V\n" +
+	"\rIngestTripLog\x12 .routegraph.IngestTripLogRequest\x1a!.routegraph.IngestTripLogResponse(\x01\x12Z\n" +
+	"\x0fValidateNetwork\x12\".routegraph.ValidateNetworkRequest\x1a#.routegraph.ValidateNetworkResponse\x12Q\n" +
+	"\x10RenderNetworkMap\x12\x1d.routegraph.NetworkMapRequest\x1a\x1e.routegraph.NetworkMapResponse\x12K\n" +
+	"\n" +
+	"ReportData\x12\x1d.routegraph.ReportDataRequest\x1a\x1e.routegraph.ReportDataResponse\x12U\n" +
+	"\x11GenerateLineSheet\x12\x1c.routegraph.LineSheetRequest\x1a\".routegraph.GenerateReportResponse\x12W\n" +
+	"\x0eGenerateReport\x12!.routegraph.GenerateReportRequest\x1a\".routegraph.GenerateReportResponse\x12T\n" +
+	"\x14GenerateReportStream\x12!.routegraph.GenerateReportRequest\x1a\x17.routegraph.ReportChunk0\x01\x12H\n" +
+	"\fSubmitReport\x12!.routegraph.GenerateReportRequest\x1a\x15.routegraph.ReportJob\x125\n" +
+	"\fGetReportJob\x12\x0e.routegraph.ID\x1a\x15.routegraph.ReportJob\x12C\n" +
+	"\x0eListReportJobs\x12\x11.routegraph.Empty\x1a\x1e.routegraph.ReportJobsResponse\x12;\n" +
+	"\x0eDownloadReport\x12\x0e.routegraph.ID\x1a\x17.routegraph.ReportChunk0\x01\x12N\n" +
+	"\x14CreateReportSchedule\x12\x1a.routegraph.ReportSchedule\x1a\x1a.routegraph.ReportSchedule\x12M\n" +
+	"\x13ListReportSchedules\x12\x11.routegraph.Empty\x1a#.routegraph.ReportSchedulesResponse\x129\n" +
+	"\x14DeleteReportSchedule\x12\x0e.routegraph.ID\x1a\x11.routegraph.EmptyB\x12Z\x10proto/routegraphb\x06proto3"
 
 var (
 	file_proto_routegraph_proto_rawDescOnce sync.Once
@@ -1893,131 +9698,384 @@ func file_proto_routegraph_proto_rawDescGZIP() []byte {
 	return file_proto_routegraph_proto_rawDescData
 }
 
-var file_proto_routegraph_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_proto_routegraph_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_proto_routegraph_proto_goTypes = []any{
-	(*ID)(nil),                     // 0: routegraph.ID
-	(*Empty)(nil),                  // 1: routegraph.Empty
-	(*Stop)(nil),                   // 2: routegraph.Stop
-	(*Line)(nil),                   // 3: routegraph.Line
-	(*Vehicle)(nil),                // 4: routegraph.Vehicle
-	(*Depot)(nil),                  // 5: routegraph.Depot
-	(*NextEdge)(nil),               // 6: routegraph.NextEdge
-	(*ServesEdge)(nil),             // 7: routegraph.ServesEdge
-	(*AssignedTo)(nil),             // 8: routegraph.AssignedTo
-	(*ParkedAt)(nil),               // 9: routegraph.ParkedAt
-	(*AssignVehicleRequest)(nil),   // 10: routegraph.AssignVehicleRequest
-	(*AssignVehicleResponse)(nil),  // 11: routegraph.AssignVehicleResponse
-	(*RecalibrateRequest)(nil),     // 12: routegraph.RecalibrateRequest
-	(*PathRequest)(nil),            // 13: routegraph.PathRequest
-	(*PathResponse)(nil),           // 14: routegraph.PathResponse
-	(*TopPairsRequest)(nil),        // 15: routegraph.TopPairsRequest
-	(*Pair)(nil),                   // 16: routegraph.Pair
-	(*TopPairsResponse)(nil),       // 17: routegraph.TopPairsResponse
-	(*DepotsRequest)(nil),          // 18: routegraph.DepotsRequest
-	(*DepotStat)(nil),              // 19: routegraph.DepotStat
-	(*DepotsResponse)(nil),         // 20: routegraph.DepotsResponse
-	(*NextListRequest)(nil),        // 21: routegraph.NextListRequest
-	(*NextListResponse)(nil),       // 22: routegraph.NextListResponse
-	(*ServesListRequest)(nil),      // 23: routegraph.ServesListRequest
-	(*ServesListResponse)(nil),     // 24: routegraph.ServesListResponse
-	(*AssignedListRequest)(nil),    // 25: routegraph.AssignedListRequest
-	(*AssignedListResponse)(nil),   // 26: routegraph.AssignedListResponse
-	(*ParkedListRequest)(nil),      // 27: routegraph.ParkedListRequest
-	(*ParkedListResponse)(nil),     // 28: routegraph.ParkedListResponse
-	(*GenerateReportRequest)(nil),  // 29: routegraph.GenerateReportRequest
-	(*GenerateReportResponse)(nil), // 30: routegraph.GenerateReportResponse
+	(*ID)(nil),                        // 0: routegraph.ID
+	(*Empty)(nil),                     // 1: routegraph.Empty
+	(*Stop)(nil),                      // 2: routegraph.Stop
+	(*Line)(nil),                      // 3: routegraph.Line
+	(*Vehicle)(nil),                   // 4: routegraph.Vehicle
+	(*Depot)(nil),                     // 5: routegraph.Depot
+	(*NextEdge)(nil),                  // 6: routegraph.NextEdge
+	(*ServesEdge)(nil),                // 7: routegraph.ServesEdge
+	(*AssignedTo)(nil),                // 8: routegraph.AssignedTo
+	(*ParkedAt)(nil),                  // 9: routegraph.ParkedAt
+	(*AssignVehicleRequest)(nil),      // 10: routegraph.AssignVehicleRequest
+	(*AssignVehicleResponse)(nil),     // 11: routegraph.AssignVehicleResponse
+	(*RecalibrateRequest)(nil),        // 12: routegraph.RecalibrateRequest
+	(*TravelTimeEstimate)(nil),        // 13: routegraph.TravelTimeEstimate
+	(*RecalibrateResponse)(nil),       // 14: routegraph.RecalibrateResponse
+	(*PathRequest)(nil),               // 15: routegraph.PathRequest
+	(*PathResponse)(nil),              // 16: routegraph.PathResponse
+	(*TopPairsRequest)(nil),           // 17: routegraph.TopPairsRequest
+	(*Pair)(nil),                      // 18: routegraph.Pair
+	(*TopPairsResponse)(nil),          // 19: routegraph.TopPairsResponse
+	(*DepotsRequest)(nil),             // 20: routegraph.DepotsRequest
+	(*DepotStat)(nil),                 // 21: routegraph.DepotStat
+	(*DepotsResponse)(nil),            // 22: routegraph.DepotsResponse
+	(*NextListRequest)(nil),           // 23: routegraph.NextListRequest
+	(*NextListResponse)(nil),          // 24: routegraph.NextListResponse
+	(*ServesListRequest)(nil),         // 25: routegraph.ServesListRequest
+	(*ServesListResponse)(nil),        // 26: routegraph.ServesListResponse
+	(*AssignedListRequest)(nil),       // 27: routegraph.AssignedListRequest
+	(*AssignedListResponse)(nil),      // 28: routegraph.AssignedListResponse
+	(*ParkedListRequest)(nil),         // 29: routegraph.ParkedListRequest
+	(*ParkedListResponse)(nil),        // 30: routegraph.ParkedListResponse
+	(*RouteStop)(nil),                 // 31: routegraph.RouteStop
+	(*SetLineRouteRequest)(nil),       // 32: routegraph.SetLineRouteRequest
+	(*InsertStopRequest)(nil),         // 33: routegraph.InsertStopRequest
+	(*RemoveStopRequest)(nil),         // 34: routegraph.RemoveStopRequest
+	(*LineRouteResponse)(nil),         // 35: routegraph.LineRouteResponse
+	(*RoutePattern)(nil),              // 36: routegraph.RoutePattern
+	(*ListPatternsRequest)(nil),       // 37: routegraph.ListPatternsRequest
+	(*ListPatternsResponse)(nil),      // 38: routegraph.ListPatternsResponse
+	(*ServicePeriod)(nil),             // 39: routegraph.ServicePeriod
+	(*ServicePeriodsRequest)(nil),     // 40: routegraph.ServicePeriodsRequest
+	(*ServicePeriodsResponse)(nil),    // 41: routegraph.ServicePeriodsResponse
+	(*Holiday)(nil),                   // 42: routegraph.Holiday
+	(*HolidaysResponse)(nil),          // 43: routegraph.HolidaysResponse
+	(*LineFrequencyRequest)(nil),      // 44: routegraph.LineFrequencyRequest
+	(*LineFrequencyResponse)(nil),     // 45: routegraph.LineFrequencyResponse
+	(*ValidateNetworkRequest)(nil),    // 46: routegraph.ValidateNetworkRequest
+	(*ValidationFinding)(nil),         // 47: routegraph.ValidationFinding
+	(*ValidateNetworkResponse)(nil),   // 48: routegraph.ValidateNetworkResponse
+	(*TimetableRequest)(nil),          // 49: routegraph.TimetableRequest
+	(*TimetableStop)(nil),             // 50: routegraph.TimetableStop
+	(*TimetableTrip)(nil),             // 51: routegraph.TimetableTrip
+	(*TimetableResponse)(nil),         // 52: routegraph.TimetableResponse
+	(*DepartureBoardRequest)(nil),     // 53: routegraph.DepartureBoardRequest
+	(*Departure)(nil),                 // 54: routegraph.Departure
+	(*DepartureBoardResponse)(nil),    // 55: routegraph.DepartureBoardResponse
+	(*Disruption)(nil),                // 56: routegraph.Disruption
+	(*Detour)(nil),                    // 57: routegraph.Detour
+	(*ListDisruptionsRequest)(nil),    // 58: routegraph.ListDisruptionsRequest
+	(*ListDisruptionsResponse)(nil),   // 59: routegraph.ListDisruptionsResponse
+	(*ScenarioChange)(nil),            // 60: routegraph.ScenarioChange
+	(*Scenario)(nil),                  // 61: routegraph.Scenario
+	(*ScenariosResponse)(nil),         // 62: routegraph.ScenariosResponse
+	(*EvaluateScenarioRequest)(nil),   // 63: routegraph.EvaluateScenarioRequest
+	(*ConnectedStop)(nil),             // 64: routegraph.ConnectedStop
+	(*LineFleetSize)(nil),             // 65: routegraph.LineFleetSize
+	(*NetworkMetrics)(nil),            // 66: routegraph.NetworkMetrics
+	(*FleetDelta)(nil),                // 67: routegraph.FleetDelta
+	(*ScenarioDiff)(nil),              // 68: routegraph.ScenarioDiff
+	(*ScenarioEvaluation)(nil),        // 69: routegraph.ScenarioEvaluation
+	(*CriticalElementsRequest)(nil),   // 70: routegraph.CriticalElementsRequest
+	(*CriticalElement)(nil),           // 71: routegraph.CriticalElement
+	(*CriticalElementsResponse)(nil),  // 72: routegraph.CriticalElementsResponse
+	(*StopCentralityRequest)(nil),     // 73: routegraph.StopCentralityRequest
+	(*StopCentralityItem)(nil),        // 74: routegraph.StopCentralityItem
+	(*StopCentralityResponse)(nil),    // 75: routegraph.StopCentralityResponse
+	(*GenerateWalkLinksRequest)(nil),  // 76: routegraph.GenerateWalkLinksRequest
+	(*GenerateWalkLinksResponse)(nil), // 77: routegraph.GenerateWalkLinksResponse
+	(*TransferHubsRequest)(nil),       // 78: routegraph.TransferHubsRequest
+	(*TransferHub)(nil),               // 79: routegraph.TransferHub
+	(*TransferHubsResponse)(nil),      // 80: routegraph.TransferHubsResponse
+	(*ConnectivityRequest)(nil),       // 81: routegraph.ConnectivityRequest
+	(*StopComponent)(nil),             // 82: routegraph.StopComponent
+	(*LinkCandidate)(nil),             // 83: routegraph.LinkCandidate
+	(*ConnectivityResponse)(nil),      // 84: routegraph.ConnectivityResponse
+	(*LoadDemandRequest)(nil),         // 85: routegraph.LoadDemandRequest
+	(*LoadDemandResponse)(nil),        // 86: routegraph.LoadDemandResponse
+	(*AssignDemandRequest)(nil),       // 87: routegraph.AssignDemandRequest
+	(*EdgeLoad)(nil),                  // 88: routegraph.EdgeLoad
+	(*LineLoad)(nil),                  // 89: routegraph.LineLoad
+	(*AssignDemandResponse)(nil),      // 90: routegraph.AssignDemandResponse
+	(*RidershipRequest)(nil),          // 91: routegraph.RidershipRequest
+	(*StopRidership)(nil),             // 92: routegraph.StopRidership
+	(*LineRidership)(nil),             // 93: routegraph.LineRidership
+	(*ZoneRidership)(nil),             // 94: routegraph.ZoneRidership
+	(*RidershipResponse)(nil),         // 95: routegraph.RidershipResponse
+	(*ProfileEntry)(nil),              // 96: routegraph.ProfileEntry
+	(*EdgeProfile)(nil),               // 97: routegraph.EdgeProfile
+	(*BuildEdgeProfilesRequest)(nil),  // 98: routegraph.BuildEdgeProfilesRequest
+	(*BuildEdgeProfilesResponse)(nil), // 99: routegraph.BuildEdgeProfilesResponse
+	(*TripStopArrival)(nil),           // 100: routegraph.TripStopArrival
+	(*TripLog)(nil),                   // 101: routegraph.TripLog
+	(*IngestTripLogRequest)(nil),      // 102: routegraph.IngestTripLogRequest
+	(*EdgeDrift)(nil),                 // 103: routegraph.EdgeDrift
+	(*IngestTripLogResponse)(nil),     // 104: routegraph.IngestTripLogResponse
+	(*NetworkMapRequest)(nil),         // 105: routegraph.NetworkMapRequest
+	(*NetworkMapResponse)(nil),        // 106: routegraph.NetworkMapResponse
+	(*ReportDataRequest)(nil),         // 107: routegraph.ReportDataRequest
+	(*DepotVehicles)(nil),             // 108: routegraph.DepotVehicles
+	(*ZoneStops)(nil),                 // 109: routegraph.ZoneStops
+	(*ReportDataResponse)(nil),        // 110: routegraph.ReportDataResponse
+	(*LineSheetRequest)(nil),          // 111: routegraph.LineSheetRequest
+	(*GenerateReportRequest)(nil),     // 112: routegraph.GenerateReportRequest
+	(*GenerateReportResponse)(nil),    // 113: routegraph.GenerateReportResponse
+	(*ReportChunk)(nil),               // 114: routegraph.ReportChunk
+	(*ReportJob)(nil),                 // 115: routegraph.ReportJob
+	(*ReportJobsResponse)(nil),        // 116: routegraph.ReportJobsResponse
+	(*ReportSchedule)(nil),            // 117: routegraph.ReportSchedule
+	(*ReportSchedulesResponse)(nil),   // 118: routegraph.ReportSchedulesResponse
+	nil,                               // 119: routegraph.TimetableRequest.StopDwellSecsEntry
+	nil,                               // 120: routegraph.ReportDataResponse.NoticesEntry
+	nil,                               // 121: routegraph.GenerateReportRequest.LimitsEntry
 }
 var file_proto_routegraph_proto_depIdxs = []int32{
-	4,  // 0: routegraph.AssignVehicleResponse.vehicle:type_name -> routegraph.Vehicle
-	16, // 1: routegraph.TopPairsResponse.pairs:type_name -> routegraph.Pair
-	19, // 2: routegraph.DepotsResponse.stats:type_name -> routegraph.DepotStat
-	6,  // 3: routegraph.NextListResponse.edges:type_name -> routegraph.NextEdge
-	7,  // 4: routegraph.ServesListResponse.edges:type_name -> routegraph.ServesEdge
-	8,  // 5: routegraph.AssignedListResponse.assignments:type_name -> routegraph.AssignedTo
-	9,  // 6: routegraph.ParkedListResponse.parked:type_name -> routegraph.ParkedAt
-	2,  // 7: routegraph.RouteGraph.CreateStop:input_type -> routegraph.Stop
-	0,  // 8: routegraph.RouteGraph.GetStop:input_type -> routegraph.ID
-	2,  // 9: routegraph.RouteGraph.UpdateStop:input_type -> routegraph.Stop
-	0,  // 10: routegraph.RouteGraph.DeleteStop:input_type -> routegraph.ID
-	3,  // 11: routegraph.RouteGraph.CreateLine:input_type -> routegraph.Line
-	0,  // 12: routegraph.RouteGraph.GetLine:input_type -> routegraph.ID
-	3,  // 13: routegraph.RouteGraph.UpdateLine:input_type -> routegraph.Line
-	0,  // 14: routegraph.RouteGraph.DeleteLine:input_type -> routegraph.ID
-	4,  // 15: routegraph.RouteGraph.CreateVehicle:input_type -> routegraph.Vehicle
-	0,  // 16: routegraph.RouteGraph.GetVehicle:input_type -> routegraph.ID
-	4,  // 17: routegraph.RouteGraph.UpdateVehicle:input_type -> routegraph.Vehicle
-	0,  // 18: routegraph.RouteGraph.DeleteVehicle:input_type -> routegraph.ID
-	5,  // 19: routegraph.RouteGraph.CreateDepot:input_type -> routegraph.Depot
-	0,  // 20: routegraph.RouteGraph.GetDepot:input_type -> routegraph.ID
-	5,  // 21: routegraph.RouteGraph.UpdateDepot:input_type -> routegraph.Depot
-	0,  // 22: routegraph.RouteGraph.DeleteDepot:input_type -> routegraph.ID
-	6,  // 23: routegraph.RouteGraph.GetNextEdge:input_type -> routegraph.NextEdge
-	6,  // 24: routegraph.RouteGraph.CreateNextEdge:input_type -> routegraph.NextEdge
-	6,  // 25: routegraph.RouteGraph.UpdateNextEdge:input_type -> routegraph.NextEdge
-	6,  // 26: routegraph.RouteGraph.DeleteNextEdge:input_type -> routegraph.NextEdge
-	7,  // 27: routegraph.RouteGraph.GetServesEdge:input_type -> routegraph.ServesEdge
-	23, // 28: routegraph.RouteGraph.ServesList:input_type -> routegraph.ServesListRequest
-	7,  // 29: routegraph.RouteGraph.CreateServesEdge:input_type -> routegraph.ServesEdge
-	7,  // 30: routegraph.RouteGraph.UpdateServesEdge:input_type -> routegraph.ServesEdge
-	7,  // 31: routegraph.RouteGraph.DeleteServesEdge:input_type -> routegraph.ServesEdge
-	8,  // 32: routegraph.RouteGraph.GetAssignedTo:input_type -> routegraph.AssignedTo
-	8,  // 33: routegraph.RouteGraph.CreateAssignedTo:input_type -> routegraph.AssignedTo
-	8,  // 34: routegraph.RouteGraph.UpdateAssignedTo:input_type -> routegraph.AssignedTo
-	8,  // 35: routegraph.RouteGraph.DeleteAssignedTo:input_type -> routegraph.AssignedTo
-	9,  // 36: routegraph.RouteGraph.GetParkedAt:input_type -> routegraph.ParkedAt
-	9,  // 37: routegraph.RouteGraph.CreateParkedAt:input_type -> routegraph.ParkedAt
-	9,  // 38: routegraph.RouteGraph.UpdateParkedAt:input_type -> routegraph.ParkedAt
-	9,  // 39: routegraph.RouteGraph.DeleteParkedAt:input_type -> routegraph.ParkedAt
-	10, // 40: routegraph.RouteGraph.AssignVehicle:input_type -> routegraph.AssignVehicleRequest
-	12, // 41: routegraph.RouteGraph.RecalibrateEdge:input_type -> routegraph.RecalibrateRequest
-	13, // 42: routegraph.RouteGraph.ShortestPath:input_type -> routegraph.PathRequest
-	15, // 43: routegraph.RouteGraph.TopPairs:input_type -> routegraph.TopPairsRequest
-	18, // 44: routegraph.RouteGraph.DepotsIdleStats:input_type -> routegraph.DepotsRequest
-	29, // 45: routegraph.RouteGraph.GenerateReport:input_type -> routegraph.GenerateReportRequest
-	2,  // 46: routegraph.RouteGraph.CreateStop:output_type -> routegraph.Stop
-	2,  // 47: routegraph.RouteGraph.GetStop:output_type -> routegraph.Stop
-	2,  // 48: routegraph.RouteGraph.UpdateStop:output_type -> routegraph.Stop
-	1,  // 49: routegraph.RouteGraph.DeleteStop:output_type -> routegraph.Empty
-	3,  // 50: routegraph.RouteGraph.CreateLine:output_type -> routegraph.Line
-	3,  // 51: routegraph.RouteGraph.GetLine:output_type -> routegraph.Line
-	3,  // 52: routegraph.RouteGraph.UpdateLine:output_type -> routegraph.Line
-	1,  // 53: routegraph.RouteGraph.DeleteLine:output_type -> routegraph.Empty
-	4,  // 54: routegraph.RouteGraph.CreateVehicle:output_type -> routegraph.Vehicle
-	4,  // 55: routegraph.RouteGraph.GetVehicle:output_type -> routegraph.Vehicle
-	4,  // 56: routegraph.RouteGraph.UpdateVehicle:output_type -> routegraph.Vehicle
-	1,  // 57: routegraph.RouteGraph.DeleteVehicle:output_type -> routegraph.Empty
-	5,  // 58: routegraph.RouteGraph.CreateDepot:output_type -> routegraph.Depot
-	5,  // 59: routegraph.RouteGraph.GetDepot:output_type -> routegraph.Depot
-	5,  // 60: routegraph.RouteGraph.UpdateDepot:output_type -> routegraph.Depot
-	1,  // 61: routegraph.RouteGraph.DeleteDepot:output_type -> routegraph.Empty
-	6,  // 62: routegraph.RouteGraph.GetNextEdge:output_type -> routegraph.NextEdge
-	6,  // 63: routegraph.RouteGraph.CreateNextEdge:output_type -> routegraph.NextEdge
-	6,  // 64: routegraph.RouteGraph.UpdateNextEdge:output_type -> routegraph.NextEdge
-	1,  // 65: routegraph.RouteGraph.DeleteNextEdge:output_type -> routegraph.Empty
-	7,  // 66: routegraph.RouteGraph.GetServesEdge:output_type -> routegraph.ServesEdge
-	24, // 67: routegraph.RouteGraph.ServesList:output_type -> routegraph.ServesListResponse
-	7,  // 68: routegraph.RouteGraph.CreateServesEdge:output_type -> routegraph.ServesEdge
-	7,  // 69: routegraph.RouteGraph.UpdateServesEdge:output_type -> routegraph.ServesEdge
-	1,  // 70: routegraph.RouteGraph.DeleteServesEdge:output_type -> routegraph.Empty
-	8,  // 71: routegraph.RouteGraph.GetAssignedTo:output_type -> routegraph.AssignedTo
-	8,  // 72: routegraph.RouteGraph.CreateAssignedTo:output_type -> routegraph.AssignedTo
-	8,  // 73: routegraph.RouteGraph.UpdateAssignedTo:output_type -> routegraph.AssignedTo
-	1,  // 74: routegraph.RouteGraph.DeleteAssignedTo:output_type -> routegraph.Empty
-	9,  // 75: routegraph.RouteGraph.GetParkedAt:output_type -> routegraph.ParkedAt
-	9,  // 76: routegraph.RouteGraph.CreateParkedAt:output_type -> routegraph.ParkedAt
-	9,  // 77: routegraph.RouteGraph.UpdateParkedAt:output_type -> routegraph.ParkedAt
-	1,  // 78: routegraph.RouteGraph.DeleteParkedAt:output_type -> routegraph.Empty
-	11, // 79: routegraph.RouteGraph.AssignVehicle:output_type -> routegraph.AssignVehicleResponse
-	6,  // 80: routegraph.RouteGraph.RecalibrateEdge:output_type -> routegraph.NextEdge
-	14, // 81: routegraph.RouteGraph.ShortestPath:output_type -> routegraph.PathResponse
-	17, // 82: routegraph.RouteGraph.TopPairs:output_type -> routegraph.TopPairsResponse
-	20, // 83: routegraph.RouteGraph.DepotsIdleStats:output_type -> routegraph.DepotsResponse
-	30, // 84: routegraph.RouteGraph.GenerateReport:output_type -> routegraph.GenerateReportResponse
-	46, // [46:85] is the sub-list for method output_type
-	7,  // [7:46] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	4,   // 0: routegraph.AssignVehicleResponse.vehicle:type_name -> routegraph.Vehicle
+	13,  // 1: routegraph.RecalibrateResponse.overall:type_name -> routegraph.TravelTimeEstimate
+	13,  // 2: routegraph.RecalibrateResponse.bands:type_name -> routegraph.TravelTimeEstimate
+	18,  // 3: routegraph.TopPairsResponse.pairs:type_name -> routegraph.Pair
+	21,  // 4: routegraph.DepotsResponse.stats:type_name -> routegraph.DepotStat
+	6,   // 5: routegraph.NextListResponse.edges:type_name -> routegraph.NextEdge
+	7,   // 6: routegraph.ServesListResponse.edges:type_name -> routegraph.ServesEdge
+	8,   // 7: routegraph.AssignedListResponse.assignments:type_name -> routegraph.AssignedTo
+	9,   // 8: routegraph.ParkedListResponse.parked:type_name -> routegraph.ParkedAt
+	31,  // 9: routegraph.SetLineRouteRequest.stops:type_name -> routegraph.RouteStop
+	7,   // 10: routegraph.LineRouteResponse.stops:type_name -> routegraph.ServesEdge
+	6,   // 11: routegraph.LineRouteResponse.created_edges:type_name -> routegraph.NextEdge
+	6,   // 12: routegraph.LineRouteResponse.removed_edges:type_name -> routegraph.NextEdge
+	36,  // 13: routegraph.ListPatternsResponse.patterns:type_name -> routegraph.RoutePattern
+	39,  // 14: routegraph.ServicePeriodsRequest.periods:type_name -> routegraph.ServicePeriod
+	39,  // 15: routegraph.ServicePeriodsResponse.periods:type_name -> routegraph.ServicePeriod
+	42,  // 16: routegraph.HolidaysResponse.holidays:type_name -> routegraph.Holiday
+	39,  // 17: routegraph.LineFrequencyResponse.period:type_name -> routegraph.ServicePeriod
+	47,  // 18: routegraph.ValidateNetworkResponse.findings:type_name -> routegraph.ValidationFinding
+	119, // 19: routegraph.TimetableRequest.stop_dwell_secs:type_name -> routegraph.TimetableRequest.StopDwellSecsEntry
+	50,  // 20: routegraph.TimetableResponse.stops:type_name -> routegraph.TimetableStop
+	51,  // 21: routegraph.TimetableResponse.trips:type_name -> routegraph.TimetableTrip
+	54,  // 22: routegraph.DepartureBoardResponse.departures:type_name -> routegraph.Departure
+	57,  // 23: routegraph.Disruption.detours:type_name -> routegraph.Detour
+	56,  // 24: routegraph.ListDisruptionsResponse.disruptions:type_name -> routegraph.Disruption
+	2,   // 25: routegraph.ScenarioChange.stop:type_name -> routegraph.Stop
+	6,   // 26: routegraph.ScenarioChange.edge:type_name -> routegraph.NextEdge
+	32,  // 27: routegraph.ScenarioChange.route:type_name -> routegraph.SetLineRouteRequest
+	41,  // 28: routegraph.ScenarioChange.service:type_name -> routegraph.ServicePeriodsResponse
+	60,  // 29: routegraph.Scenario.changes:type_name -> routegraph.ScenarioChange
+	61,  // 30: routegraph.ScenariosResponse.scenarios:type_name -> routegraph.Scenario
+	16,  // 31: routegraph.NetworkMetrics.path:type_name -> routegraph.PathResponse
+	18,  // 32: routegraph.NetworkMetrics.top_pairs:type_name -> routegraph.Pair
+	64,  // 33: routegraph.NetworkMetrics.top_stops:type_name -> routegraph.ConnectedStop
+	65,  // 34: routegraph.NetworkMetrics.fleet:type_name -> routegraph.LineFleetSize
+	6,   // 35: routegraph.ScenarioDiff.edges_added:type_name -> routegraph.NextEdge
+	6,   // 36: routegraph.ScenarioDiff.edges_removed:type_name -> routegraph.NextEdge
+	67,  // 37: routegraph.ScenarioDiff.fleet:type_name -> routegraph.FleetDelta
+	66,  // 38: routegraph.ScenarioEvaluation.baseline:type_name -> routegraph.NetworkMetrics
+	66,  // 39: routegraph.ScenarioEvaluation.scenario:type_name -> routegraph.NetworkMetrics
+	68,  // 40: routegraph.ScenarioEvaluation.diff:type_name -> routegraph.ScenarioDiff
+	6,   // 41: routegraph.CriticalElementsResponse.bridges:type_name -> routegraph.NextEdge
+	71,  // 42: routegraph.CriticalElementsResponse.ranked:type_name -> routegraph.CriticalElement
+	74,  // 43: routegraph.StopCentralityResponse.stops:type_name -> routegraph.StopCentralityItem
+	79,  // 44: routegraph.TransferHubsResponse.hubs:type_name -> routegraph.TransferHub
+	82,  // 45: routegraph.ConnectivityResponse.strong:type_name -> routegraph.StopComponent
+	82,  // 46: routegraph.ConnectivityResponse.weak:type_name -> routegraph.StopComponent
+	83,  // 47: routegraph.ConnectivityResponse.candidates:type_name -> routegraph.LinkCandidate
+	88,  // 48: routegraph.AssignDemandResponse.edges:type_name -> routegraph.EdgeLoad
+	89,  // 49: routegraph.AssignDemandResponse.lines:type_name -> routegraph.LineLoad
+	92,  // 50: routegraph.RidershipResponse.busiest_stops:type_name -> routegraph.StopRidership
+	93,  // 51: routegraph.RidershipResponse.busiest_lines:type_name -> routegraph.LineRidership
+	94,  // 52: routegraph.RidershipResponse.zones:type_name -> routegraph.ZoneRidership
+	96,  // 53: routegraph.EdgeProfile.entries:type_name -> routegraph.ProfileEntry
+	97,  // 54: routegraph.BuildEdgeProfilesResponse.profiles:type_name -> routegraph.EdgeProfile
+	100, // 55: routegraph.TripLog.arrivals:type_name -> routegraph.TripStopArrival
+	101, // 56: routegraph.IngestTripLogRequest.trip:type_name -> routegraph.TripLog
+	103, // 57: routegraph.IngestTripLogResponse.drifted:type_name -> routegraph.EdgeDrift
+	112, // 58: routegraph.ReportDataRequest.request:type_name -> routegraph.GenerateReportRequest
+	4,   // 59: routegraph.DepotVehicles.vehicles:type_name -> routegraph.Vehicle
+	2,   // 60: routegraph.ZoneStops.stops:type_name -> routegraph.Stop
+	108, // 61: routegraph.ReportDataResponse.vehicles_by_depot:type_name -> routegraph.DepotVehicles
+	109, // 62: routegraph.ReportDataResponse.stops_by_zone:type_name -> routegraph.ZoneStops
+	18,  // 63: routegraph.ReportDataResponse.top_pairs:type_name -> routegraph.Pair
+	21,  // 64: routegraph.ReportDataResponse.depot_stats:type_name -> routegraph.DepotStat
+	75,  // 65: routegraph.ReportDataResponse.top_stops:type_name -> routegraph.StopCentralityResponse
+	120, // 66: routegraph.ReportDataResponse.notices:type_name -> routegraph.ReportDataResponse.NoticesEntry
+	121, // 67: routegraph.GenerateReportRequest.limits:type_name -> routegraph.GenerateReportRequest.LimitsEntry
+	115, // 68: routegraph.ReportJobsResponse.jobs:type_name -> routegraph.ReportJob
+	112, // 69: routegraph.ReportSchedule.request:type_name -> routegraph.GenerateReportRequest
+	117, // 70: routegraph.ReportSchedulesResponse.schedules:type_name -> routegraph.ReportSchedule
+	2,   // 71: routegraph.RouteGraph.CreateStop:input_type -> routegraph.Stop
+	0,   // 72: routegraph.RouteGraph.GetStop:input_type -> routegraph.ID
+	2,   // 73: routegraph.RouteGraph.UpdateStop:input_type -> routegraph.Stop
+	0,   // 74: routegraph.RouteGraph.DeleteStop:input_type -> routegraph.ID
+	3,   // 75: routegraph.RouteGraph.CreateLine:input_type -> routegraph.Line
+	0,   // 76: routegraph.RouteGraph.GetLine:input_type -> routegraph.ID
+	3,   // 77: routegraph.RouteGraph.UpdateLine:input_type -> routegraph.Line
+	0,   // 78: routegraph.RouteGraph.DeleteLine:input_type -> routegraph.ID
+	4,   // 79: routegraph.RouteGraph.CreateVehicle:input_type -> routegraph.Vehicle
+	0,   // 80: routegraph.RouteGraph.GetVehicle:input_type -> routegraph.ID
+	4,   // 81: routegraph.RouteGraph.UpdateVehicle:input_type -> routegraph.Vehicle
+	0,   // 82: routegraph.RouteGraph.DeleteVehicle:input_type -> routegraph.ID
+	5,   // 83: routegraph.RouteGraph.CreateDepot:input_type -> routegraph.Depot
+	0,   // 84: routegraph.RouteGraph.GetDepot:input_type -> routegraph.ID
+	5,   // 85: routegraph.RouteGraph.UpdateDepot:input_type -> routegraph.Depot
+	0,   // 86: routegraph.RouteGraph.DeleteDepot:input_type -> routegraph.ID
+	6,   // 87: routegraph.RouteGraph.GetNextEdge:input_type -> routegraph.NextEdge
+	6,   // 88: routegraph.RouteGraph.CreateNextEdge:input_type -> routegraph.NextEdge
+	6,   // 89: routegraph.RouteGraph.UpdateNextEdge:input_type -> routegraph.NextEdge
+	6,   // 90: routegraph.RouteGraph.DeleteNextEdge:input_type -> routegraph.NextEdge
+	7,   // 91: routegraph.RouteGraph.GetServesEdge:input_type -> routegraph.ServesEdge
+	25,  // 92: routegraph.RouteGraph.ServesList:input_type -> routegraph.ServesListRequest
+	7,   // 93: routegraph.RouteGraph.CreateServesEdge:input_type -> routegraph.ServesEdge
+	7,   // 94: routegraph.RouteGraph.UpdateServesEdge:input_type -> routegraph.ServesEdge
+	7,   // 95: routegraph.RouteGraph.DeleteServesEdge:input_type -> routegraph.ServesEdge
+	8,   // 96: routegraph.RouteGraph.GetAssignedTo:input_type -> routegraph.AssignedTo
+	8,   // 97: routegraph.RouteGraph.CreateAssignedTo:input_type -> routegraph.AssignedTo
+	8,   // 98: routegraph.RouteGraph.UpdateAssignedTo:input_type -> routegraph.AssignedTo
+	8,   // 99: routegraph.RouteGraph.DeleteAssignedTo:input_type -> routegraph.AssignedTo
+	9,   // 100: routegraph.RouteGraph.GetParkedAt:input_type -> routegraph.ParkedAt
+	9,   // 101: routegraph.RouteGraph.CreateParkedAt:input_type -> routegraph.ParkedAt
+	9,   // 102: routegraph.RouteGraph.UpdateParkedAt:input_type -> routegraph.ParkedAt
+	9,   // 103: routegraph.RouteGraph.DeleteParkedAt:input_type -> routegraph.ParkedAt
+	10,  // 104: routegraph.RouteGraph.AssignVehicle:input_type -> routegraph.AssignVehicleRequest
+	12,  // 105: routegraph.RouteGraph.RecalibrateEdge:input_type -> routegraph.RecalibrateRequest
+	15,  // 106: routegraph.RouteGraph.ShortestPath:input_type -> routegraph.PathRequest
+	17,  // 107: routegraph.RouteGraph.TopPairs:input_type -> routegraph.TopPairsRequest
+	20,  // 108: routegraph.RouteGraph.DepotsIdleStats:input_type -> routegraph.DepotsRequest
+	32,  // 109: routegraph.RouteGraph.SetLineRoute:input_type -> routegraph.SetLineRouteRequest
+	33,  // 110: routegraph.RouteGraph.InsertStopIntoLine:input_type -> routegraph.InsertStopRequest
+	34,  // 111: routegraph.RouteGraph.RemoveStopFromLine:input_type -> routegraph.RemoveStopRequest
+	36,  // 112: routegraph.RouteGraph.UpsertPattern:input_type -> routegraph.RoutePattern
+	37,  // 113: routegraph.RouteGraph.ListPatterns:input_type -> routegraph.ListPatternsRequest
+	36,  // 114: routegraph.RouteGraph.DeletePattern:input_type -> routegraph.RoutePattern
+	40,  // 115: routegraph.RouteGraph.SetServicePeriods:input_type -> routegraph.ServicePeriodsRequest
+	0,   // 116: routegraph.RouteGraph.ListServicePeriods:input_type -> routegraph.ID
+	42,  // 117: routegraph.RouteGraph.CreateHoliday:input_type -> routegraph.Holiday
+	42,  // 118: routegraph.RouteGraph.DeleteHoliday:input_type -> routegraph.Holiday
+	1,   // 119: routegraph.RouteGraph.ListHolidays:input_type -> routegraph.Empty
+	44,  // 120: routegraph.RouteGraph.LineFrequency:input_type -> routegraph.LineFrequencyRequest
+	49,  // 121: routegraph.RouteGraph.GenerateTimetable:input_type -> routegraph.TimetableRequest
+	53,  // 122: routegraph.RouteGraph.DepartureBoard:input_type -> routegraph.DepartureBoardRequest
+	53,  // 123: routegraph.RouteGraph.StreamDepartureBoard:input_type -> routegraph.DepartureBoardRequest
+	56,  // 124: routegraph.RouteGraph.CreateDisruption:input_type -> routegraph.Disruption
+	58,  // 125: routegraph.RouteGraph.ListDisruptions:input_type -> routegraph.ListDisruptionsRequest
+	0,   // 126: routegraph.RouteGraph.EndDisruption:input_type -> routegraph.ID
+	61,  // 127: routegraph.RouteGraph.CreateScenario:input_type -> routegraph.Scenario
+	0,   // 128: routegraph.RouteGraph.GetScenario:input_type -> routegraph.ID
+	1,   // 129: routegraph.RouteGraph.ListScenarios:input_type -> routegraph.Empty
+	61,  // 130: routegraph.RouteGraph.UpdateScenario:input_type -> routegraph.Scenario
+	0,   // 131: routegraph.RouteGraph.DeleteScenario:input_type -> routegraph.ID
+	63,  // 132: routegraph.RouteGraph.EvaluateScenario:input_type -> routegraph.EvaluateScenarioRequest
+	0,   // 133: routegraph.RouteGraph.PromoteScenario:input_type -> routegraph.ID
+	76,  // 134: routegraph.RouteGraph.GenerateWalkLinks:input_type -> routegraph.GenerateWalkLinksRequest
+	78,  // 135: routegraph.RouteGraph.TransferHubs:input_type -> routegraph.TransferHubsRequest
+	73,  // 136: routegraph.RouteGraph.StopCentrality:input_type -> routegraph.StopCentralityRequest
+	70,  // 137: routegraph.RouteGraph.CriticalElements:input_type -> routegraph.CriticalElementsRequest
+	81,  // 138: routegraph.RouteGraph.ConnectivityReport:input_type -> routegraph.ConnectivityRequest
+	85,  // 139: routegraph.RouteGraph.LoadDemand:input_type -> routegraph.LoadDemandRequest
+	87,  // 140: routegraph.RouteGraph.AssignDemand:input_type -> routegraph.AssignDemandRequest
+	91,  // 141: routegraph.RouteGraph.RidershipStats:input_type -> routegraph.RidershipRequest
+	6,   // 142: routegraph.RouteGraph.GetEdgeProfile:input_type -> routegraph.NextEdge
+	97,  // 143: routegraph.RouteGraph.SetEdgeProfile:input_type -> routegraph.EdgeProfile
+	98,  // 144: routegraph.RouteGraph.BuildEdgeProfiles:input_type -> routegraph.BuildEdgeProfilesRequest
+	102, // 145: routegraph.RouteGraph.IngestTripLog:input_type -> routegraph.IngestTripLogRequest
+	46,  // 146: routegraph.RouteGraph.ValidateNetwork:input_type -> routegraph.ValidateNetworkRequest
+	105, // 147: routegraph.RouteGraph.RenderNetworkMap:input_type -> routegraph.NetworkMapRequest
+	107, // 148: routegraph.RouteGraph.ReportData:input_type -> routegraph.ReportDataRequest
+	111, // 149: routegraph.RouteGraph.GenerateLineSheet:input_type -> routegraph.LineSheetRequest
+	112, // 150: routegraph.RouteGraph.GenerateReport:input_type -> routegraph.GenerateReportRequest
+	112, // 151: routegraph.RouteGraph.GenerateReportStream:input_type -> routegraph.GenerateReportRequest
+	112, // 152: routegraph.RouteGraph.SubmitReport:input_type -> routegraph.GenerateReportRequest
+	0,   // 153: routegraph.RouteGraph.GetReportJob:input_type -> routegraph.ID
+	1,   // 154: routegraph.RouteGraph.ListReportJobs:input_type -> routegraph.Empty
+	0,   // 155: routegraph.RouteGraph.DownloadReport:input_type -> routegraph.ID
+	117, // 156: routegraph.RouteGraph.CreateReportSchedule:input_type -> routegraph.ReportSchedule
+	1,   // 157: routegraph.RouteGraph.ListReportSchedules:input_type -> routegraph.Empty
+	0,   // 158: routegraph.RouteGraph.DeleteReportSchedule:input_type -> routegraph.ID
+	2,   // 159: routegraph.RouteGraph.CreateStop:output_type -> routegraph.Stop
+	2,   // 160: routegraph.RouteGraph.GetStop:output_type -> routegraph.Stop
+	2,   // 161: routegraph.RouteGraph.UpdateStop:output_type -> routegraph.Stop
+	1,   // 162: routegraph.RouteGraph.DeleteStop:output_type -> routegraph.Empty
+	3,   // 163: routegraph.RouteGraph.CreateLine:output_type -> routegraph.Line
+	3,   // 164: routegraph.RouteGraph.GetLine:output_type -> routegraph.Line
+	3,   // 165: routegraph.RouteGraph.UpdateLine:output_type -> routegraph.Line
+	1,   // 166: routegraph.RouteGraph.DeleteLine:output_type -> routegraph.Empty
+	4,   // 167: routegraph.RouteGraph.CreateVehicle:output_type -> routegraph.Vehicle
+	4,   // 168: routegraph.RouteGraph.GetVehicle:output_type -> routegraph.Vehicle
+	4,   // 169: routegraph.RouteGraph.UpdateVehicle:output_type -> routegraph.Vehicle
+	1,   // 170: routegraph.RouteGraph.DeleteVehicle:output_type -> routegraph.Empty
+	5,   // 171: routegraph.RouteGraph.CreateDepot:output_type -> routegraph.Depot
+	5,   // 172: routegraph.RouteGraph.GetDepot:output_type -> routegraph.Depot
+	5,   // 173: routegraph.RouteGraph.UpdateDepot:output_type -> routegraph.Depot
+	1,   // 174: routegraph.RouteGraph.DeleteDepot:output_type -> routegraph.Empty
+	6,   // 175: routegraph.RouteGraph.GetNextEdge:output_type -> routegraph.NextEdge
+	6,   // 176: routegraph.RouteGraph.CreateNextEdge:output_type -> routegraph.NextEdge
+	6,   // 177: routegraph.RouteGraph.UpdateNextEdge:output_type -> routegraph.NextEdge
+	1,   // 178: routegraph.RouteGraph.DeleteNextEdge:output_type -> routegraph.Empty
+	7,   // 179: routegraph.RouteGraph.GetServesEdge:output_type -> routegraph.ServesEdge
+	26,  // 180: routegraph.RouteGraph.ServesList:output_type -> routegraph.ServesListResponse
+	7,   // 181: routegraph.RouteGraph.CreateServesEdge:output_type -> routegraph.ServesEdge
+	7,   // 182: routegraph.RouteGraph.UpdateServesEdge:output_type -> routegraph.ServesEdge
+	1,   // 183: routegraph.RouteGraph.DeleteServesEdge:output_type -> routegraph.Empty
+	8,   // 184: routegraph.RouteGraph.GetAssignedTo:output_type -> routegraph.AssignedTo
+	8,   // 185: routegraph.RouteGraph.CreateAssignedTo:output_type -> routegraph.AssignedTo
+	8,   // 186: routegraph.RouteGraph.UpdateAssignedTo:output_type -> routegraph.AssignedTo
+	1,   // 187: routegraph.RouteGraph.DeleteAssignedTo:output_type -> routegraph.Empty
+	9,   // 188: routegraph.RouteGraph.GetParkedAt:output_type -> routegraph.ParkedAt
+	9,   // 189: routegraph.RouteGraph.CreateParkedAt:output_type -> routegraph.ParkedAt
+	9,   // 190: routegraph.RouteGraph.UpdateParkedAt:output_type -> routegraph.ParkedAt
+	1,   // 191: routegraph.RouteGraph.DeleteParkedAt:output_type -> routegraph.Empty
+	11,  // 192: routegraph.RouteGraph.AssignVehicle:output_type -> routegraph.AssignVehicleResponse
+	14,  // 193: routegraph.RouteGraph.RecalibrateEdge:output_type -> routegraph.RecalibrateResponse
+	16,  // 194: routegraph.RouteGraph.ShortestPath:output_type -> routegraph.PathResponse
+	19,  // 195: routegraph.RouteGraph.TopPairs:output_type -> routegraph.TopPairsResponse
+	22,  // 196: routegraph.RouteGraph.DepotsIdleStats:output_type -> routegraph.DepotsResponse
+	35,  // 197: routegraph.RouteGraph.SetLineRoute:output_type -> routegraph.LineRouteResponse
+	35,  // 198: routegraph.RouteGraph.InsertStopIntoLine:output_type -> routegraph.LineRouteResponse
+	35,  // 199: routegraph.RouteGraph.RemoveStopFromLine:output_type -> routegraph.LineRouteResponse
+	36,  // 200: routegraph.RouteGraph.UpsertPattern:output_type -> routegraph.RoutePattern
+	38,  // 201: routegraph.RouteGraph.ListPatterns:output_type -> routegraph.ListPatternsResponse
+	1,   // 202: routegraph.RouteGraph.DeletePattern:output_type -> routegraph.Empty
+	41,  // 203: routegraph.RouteGraph.SetServicePeriods:output_type -> routegraph.ServicePeriodsResponse
+	41,  // 204: routegraph.RouteGraph.ListServicePeriods:output_type -> routegraph.ServicePeriodsResponse
+	42,  // 205: routegraph.RouteGraph.CreateHoliday:output_type -> routegraph.Holiday
+	1,   // 206: routegraph.RouteGraph.DeleteHoliday:output_type -> routegraph.Empty
+	43,  // 207: routegraph.RouteGraph.ListHolidays:output_type -> routegraph.HolidaysResponse
+	45,  // 208: routegraph.RouteGraph.LineFrequency:output_type -> routegraph.LineFrequencyResponse
+	52,  // 209: routegraph.RouteGraph.GenerateTimetable:output_type -> routegraph.TimetableResponse
+	55,  // 210: routegraph.RouteGraph.DepartureBoard:output_type -> routegraph.DepartureBoardResponse
+	55,  // 211: routegraph.RouteGraph.StreamDepartureBoard:output_type -> routegraph.DepartureBoardResponse
+	56,  // 212: routegraph.RouteGraph.CreateDisruption:output_type -> routegraph.Disruption
+	59,  // 213: routegraph.RouteGraph.ListDisruptions:output_type -> routegraph.ListDisruptionsResponse
+	56,  // 214: routegraph.RouteGraph.EndDisruption:output_type -> routegraph.Disruption
+	61,  // 215: routegraph.RouteGraph.CreateScenario:output_type -> routegraph.Scenario
+	61,  // 216: routegraph.RouteGraph.GetScenario:output_type -> routegraph.Scenario
+	62,  // 217: routegraph.RouteGraph.ListScenarios:output_type -> routegraph.ScenariosResponse
+	61,  // 218: routegraph.RouteGraph.UpdateScenario:output_type -> routegraph.Scenario
+	1,   // 219: routegraph.RouteGraph.DeleteScenario:output_type -> routegraph.Empty
+	69,  // 220: routegraph.RouteGraph.EvaluateScenario:output_type -> routegraph.ScenarioEvaluation
+	61,  // 221: routegraph.RouteGraph.PromoteScenario:output_type -> routegraph.Scenario
+	77,  // 222: routegraph.RouteGraph.GenerateWalkLinks:output_type -> routegraph.GenerateWalkLinksResponse
+	80,  // 223: routegraph.RouteGraph.TransferHubs:output_type -> routegraph.TransferHubsResponse
+	75,  // 224: routegraph.RouteGraph.StopCentrality:output_type -> routegraph.StopCentralityResponse
+	72,  // 225: routegraph.RouteGraph.CriticalElements:output_type -> routegraph.CriticalElementsResponse
+	84,  // 226: routegraph.RouteGraph.ConnectivityReport:output_type -> routegraph.ConnectivityResponse
+	86,  // 227: routegraph.RouteGraph.LoadDemand:output_type -> routegraph.LoadDemandResponse
+	90,  // 228: routegraph.RouteGraph.AssignDemand:output_type -> routegraph.AssignDemandResponse
+	95,  // 229: routegraph.RouteGraph.RidershipStats:output_type -> routegraph.RidershipResponse
+	97,  // 230: routegraph.RouteGraph.GetEdgeProfile:output_type -> routegraph.EdgeProfile
+	97,  // 231: routegraph.RouteGraph.SetEdgeProfile:output_type -> routegraph.EdgeProfile
+	99,  // 232: routegraph.RouteGraph.BuildEdgeProfiles:output_type -> routegraph.BuildEdgeProfilesResponse
+	104, // 233: routegraph.RouteGraph.IngestTripLog:output_type -> routegraph.IngestTripLogResponse
+	48,  // 234: routegraph.RouteGraph.ValidateNetwork:output_type -> routegraph.ValidateNetworkResponse
+	106, // 235: routegraph.RouteGraph.RenderNetworkMap:output_type -> routegraph.NetworkMapResponse
+	110, // 236: routegraph.RouteGraph.ReportData:output_type -> routegraph.ReportDataResponse
+	113, // 237: routegraph.RouteGraph.GenerateLineSheet:output_type -> routegraph.GenerateReportResponse
+	113, // 238: routegraph.RouteGraph.GenerateReport:output_type -> routegraph.GenerateReportResponse
+	114, // 239: routegraph.RouteGraph.GenerateReportStream:output_type -> routegraph.ReportChunk
+	115, // 240: routegraph.RouteGraph.SubmitReport:output_type -> routegraph.ReportJob
+	115, // 241: routegraph.RouteGraph.GetReportJob:output_type -> routegraph.ReportJob
+	116, // 242: routegraph.RouteGraph.ListReportJobs:output_type -> routegraph.ReportJobsResponse
+	114, // 243: routegraph.RouteGraph.DownloadReport:output_type -> routegraph.ReportChunk
+	117, // 244: routegraph.RouteGraph.CreateReportSchedule:output_type -> routegraph.ReportSchedule
+	118, // 245: routegraph.RouteGraph.ListReportSchedules:output_type -> routegraph.ReportSchedulesResponse
+	1,   // 246: routegraph.RouteGraph.DeleteReportSchedule:output_type -> routegraph.Empty
+	159, // [159:247] is the sub-list for method output_type
+	71,  // [71:159] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_proto_routegraph_proto_init() }
@@ -2025,13 +10083,15 @@ func file_proto_routegraph_proto_init() {
 	if File_proto_routegraph_proto != nil {
 		return
 	}
+	file_proto_routegraph_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_routegraph_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_routegraph_proto_rawDesc), len(file_proto_routegraph_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
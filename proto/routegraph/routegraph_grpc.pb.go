@@ -19,45 +19,94 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RouteGraph_CreateStop_FullMethodName       = "/routegraph.RouteGraph/CreateStop"
-	RouteGraph_GetStop_FullMethodName          = "/routegraph.RouteGraph/GetStop"
-	RouteGraph_UpdateStop_FullMethodName       = "/routegraph.RouteGraph/UpdateStop"
-	RouteGraph_DeleteStop_FullMethodName       = "/routegraph.RouteGraph/DeleteStop"
-	RouteGraph_CreateLine_FullMethodName       = "/routegraph.RouteGraph/CreateLine"
-	RouteGraph_GetLine_FullMethodName          = "/routegraph.RouteGraph/GetLine"
-	RouteGraph_UpdateLine_FullMethodName       = "/routegraph.RouteGraph/UpdateLine"
-	RouteGraph_DeleteLine_FullMethodName       = "/routegraph.RouteGraph/DeleteLine"
-	RouteGraph_CreateVehicle_FullMethodName    = "/routegraph.RouteGraph/CreateVehicle"
-	RouteGraph_GetVehicle_FullMethodName       = "/routegraph.RouteGraph/GetVehicle"
-	RouteGraph_UpdateVehicle_FullMethodName    = "/routegraph.RouteGraph/UpdateVehicle"
-	RouteGraph_DeleteVehicle_FullMethodName    = "/routegraph.RouteGraph/DeleteVehicle"
-	RouteGraph_CreateDepot_FullMethodName      = "/routegraph.RouteGraph/CreateDepot"
-	RouteGraph_GetDepot_FullMethodName         = "/routegraph.RouteGraph/GetDepot"
-	RouteGraph_UpdateDepot_FullMethodName      = "/routegraph.RouteGraph/UpdateDepot"
-	RouteGraph_DeleteDepot_FullMethodName      = "/routegraph.RouteGraph/DeleteDepot"
-	RouteGraph_GetNextEdge_FullMethodName      = "/routegraph.RouteGraph/GetNextEdge"
-	RouteGraph_CreateNextEdge_FullMethodName   = "/routegraph.RouteGraph/CreateNextEdge"
-	RouteGraph_UpdateNextEdge_FullMethodName   = "/routegraph.RouteGraph/UpdateNextEdge"
-	RouteGraph_DeleteNextEdge_FullMethodName   = "/routegraph.RouteGraph/DeleteNextEdge"
-	RouteGraph_GetServesEdge_FullMethodName    = "/routegraph.RouteGraph/GetServesEdge"
-	RouteGraph_ServesList_FullMethodName       = "/routegraph.RouteGraph/ServesList"
-	RouteGraph_CreateServesEdge_FullMethodName = "/routegraph.RouteGraph/CreateServesEdge"
-	RouteGraph_UpdateServesEdge_FullMethodName = "/routegraph.RouteGraph/UpdateServesEdge"
-	RouteGraph_DeleteServesEdge_FullMethodName = "/routegraph.RouteGraph/DeleteServesEdge"
-	RouteGraph_GetAssignedTo_FullMethodName    = "/routegraph.RouteGraph/GetAssignedTo"
-	RouteGraph_CreateAssignedTo_FullMethodName = "/routegraph.RouteGraph/CreateAssignedTo"
-	RouteGraph_UpdateAssignedTo_FullMethodName = "/routegraph.RouteGraph/UpdateAssignedTo"
-	RouteGraph_DeleteAssignedTo_FullMethodName = "/routegraph.RouteGraph/DeleteAssignedTo"
-	RouteGraph_GetParkedAt_FullMethodName      = "/routegraph.RouteGraph/GetParkedAt"
-	RouteGraph_CreateParkedAt_FullMethodName   = "/routegraph.RouteGraph/CreateParkedAt"
-	RouteGraph_UpdateParkedAt_FullMethodName   = "/routegraph.RouteGraph/UpdateParkedAt"
-	RouteGraph_DeleteParkedAt_FullMethodName   = "/routegraph.RouteGraph/DeleteParkedAt"
-	RouteGraph_AssignVehicle_FullMethodName    = "/routegraph.RouteGraph/AssignVehicle"
-	RouteGraph_RecalibrateEdge_FullMethodName  = "/routegraph.RouteGraph/RecalibrateEdge"
-	RouteGraph_ShortestPath_FullMethodName     = "/routegraph.RouteGraph/ShortestPath"
-	RouteGraph_TopPairs_FullMethodName         = "/routegraph.RouteGraph/TopPairs"
-	RouteGraph_DepotsIdleStats_FullMethodName  = "/routegraph.RouteGraph/DepotsIdleStats"
-	RouteGraph_GenerateReport_FullMethodName   = "/routegraph.RouteGraph/GenerateReport"
+	RouteGraph_CreateStop_FullMethodName           = "/routegraph.RouteGraph/CreateStop"
+	RouteGraph_GetStop_FullMethodName              = "/routegraph.RouteGraph/GetStop"
+	RouteGraph_UpdateStop_FullMethodName           = "/routegraph.RouteGraph/UpdateStop"
+	RouteGraph_DeleteStop_FullMethodName           = "/routegraph.RouteGraph/DeleteStop"
+	RouteGraph_CreateLine_FullMethodName           = "/routegraph.RouteGraph/CreateLine"
+	RouteGraph_GetLine_FullMethodName              = "/routegraph.RouteGraph/GetLine"
+	RouteGraph_UpdateLine_FullMethodName           = "/routegraph.RouteGraph/UpdateLine"
+	RouteGraph_DeleteLine_FullMethodName           = "/routegraph.RouteGraph/DeleteLine"
+	RouteGraph_CreateVehicle_FullMethodName        = "/routegraph.RouteGraph/CreateVehicle"
+	RouteGraph_GetVehicle_FullMethodName           = "/routegraph.RouteGraph/GetVehicle"
+	RouteGraph_UpdateVehicle_FullMethodName        = "/routegraph.RouteGraph/UpdateVehicle"
+	RouteGraph_DeleteVehicle_FullMethodName        = "/routegraph.RouteGraph/DeleteVehicle"
+	RouteGraph_CreateDepot_FullMethodName          = "/routegraph.RouteGraph/CreateDepot"
+	RouteGraph_GetDepot_FullMethodName             = "/routegraph.RouteGraph/GetDepot"
+	RouteGraph_UpdateDepot_FullMethodName          = "/routegraph.RouteGraph/UpdateDepot"
+	RouteGraph_DeleteDepot_FullMethodName          = "/routegraph.RouteGraph/DeleteDepot"
+	RouteGraph_GetNextEdge_FullMethodName          = "/routegraph.RouteGraph/GetNextEdge"
+	RouteGraph_CreateNextEdge_FullMethodName       = "/routegraph.RouteGraph/CreateNextEdge"
+	RouteGraph_UpdateNextEdge_FullMethodName       = "/routegraph.RouteGraph/UpdateNextEdge"
+	RouteGraph_DeleteNextEdge_FullMethodName       = "/routegraph.RouteGraph/DeleteNextEdge"
+	RouteGraph_GetServesEdge_FullMethodName        = "/routegraph.RouteGraph/GetServesEdge"
+	RouteGraph_ServesList_FullMethodName           = "/routegraph.RouteGraph/ServesList"
+	RouteGraph_CreateServesEdge_FullMethodName     = "/routegraph.RouteGraph/CreateServesEdge"
+	RouteGraph_UpdateServesEdge_FullMethodName     = "/routegraph.RouteGraph/UpdateServesEdge"
+	RouteGraph_DeleteServesEdge_FullMethodName     = "/routegraph.RouteGraph/DeleteServesEdge"
+	RouteGraph_GetAssignedTo_FullMethodName        = "/routegraph.RouteGraph/GetAssignedTo"
+	RouteGraph_CreateAssignedTo_FullMethodName     = "/routegraph.RouteGraph/CreateAssignedTo"
+	RouteGraph_UpdateAssignedTo_FullMethodName     = "/routegraph.RouteGraph/UpdateAssignedTo"
+	RouteGraph_DeleteAssignedTo_FullMethodName     = "/routegraph.RouteGraph/DeleteAssignedTo"
+	RouteGraph_GetParkedAt_FullMethodName          = "/routegraph.RouteGraph/GetParkedAt"
+	RouteGraph_CreateParkedAt_FullMethodName       = "/routegraph.RouteGraph/CreateParkedAt"
+	RouteGraph_UpdateParkedAt_FullMethodName       = "/routegraph.RouteGraph/UpdateParkedAt"
+	RouteGraph_DeleteParkedAt_FullMethodName       = "/routegraph.RouteGraph/DeleteParkedAt"
+	RouteGraph_AssignVehicle_FullMethodName        = "/routegraph.RouteGraph/AssignVehicle"
+	RouteGraph_RecalibrateEdge_FullMethodName      = "/routegraph.RouteGraph/RecalibrateEdge"
+	RouteGraph_ShortestPath_FullMethodName         = "/routegraph.RouteGraph/ShortestPath"
+	RouteGraph_TopPairs_FullMethodName             = "/routegraph.RouteGraph/TopPairs"
+	RouteGraph_DepotsIdleStats_FullMethodName      = "/routegraph.RouteGraph/DepotsIdleStats"
+	RouteGraph_SetLineRoute_FullMethodName         = "/routegraph.RouteGraph/SetLineRoute"
+	RouteGraph_InsertStopIntoLine_FullMethodName   = "/routegraph.RouteGraph/InsertStopIntoLine"
+	RouteGraph_RemoveStopFromLine_FullMethodName   = "/routegraph.RouteGraph/RemoveStopFromLine"
+	RouteGraph_UpsertPattern_FullMethodName        = "/routegraph.RouteGraph/UpsertPattern"
+	RouteGraph_ListPatterns_FullMethodName         = "/routegraph.RouteGraph/ListPatterns"
+	RouteGraph_DeletePattern_FullMethodName        = "/routegraph.RouteGraph/DeletePattern"
+	RouteGraph_SetServicePeriods_FullMethodName    = "/routegraph.RouteGraph/SetServicePeriods"
+	RouteGraph_ListServicePeriods_FullMethodName   = "/routegraph.RouteGraph/ListServicePeriods"
+	RouteGraph_CreateHoliday_FullMethodName        = "/routegraph.RouteGraph/CreateHoliday"
+	RouteGraph_DeleteHoliday_FullMethodName        = "/routegraph.RouteGraph/DeleteHoliday"
+	RouteGraph_ListHolidays_FullMethodName         = "/routegraph.RouteGraph/ListHolidays"
+	RouteGraph_LineFrequency_FullMethodName        = "/routegraph.RouteGraph/LineFrequency"
+	RouteGraph_GenerateTimetable_FullMethodName    = "/routegraph.RouteGraph/GenerateTimetable"
+	RouteGraph_DepartureBoard_FullMethodName       = "/routegraph.RouteGraph/DepartureBoard"
+	RouteGraph_StreamDepartureBoard_FullMethodName = "/routegraph.RouteGraph/StreamDepartureBoard"
+	RouteGraph_CreateDisruption_FullMethodName     = "/routegraph.RouteGraph/CreateDisruption"
+	RouteGraph_ListDisruptions_FullMethodName      = "/routegraph.RouteGraph/ListDisruptions"
+	RouteGraph_EndDisruption_FullMethodName        = "/routegraph.RouteGraph/EndDisruption"
+	RouteGraph_CreateScenario_FullMethodName       = "/routegraph.RouteGraph/CreateScenario"
+	RouteGraph_GetScenario_FullMethodName          = "/routegraph.RouteGraph/GetScenario"
+	RouteGraph_ListScenarios_FullMethodName        = "/routegraph.RouteGraph/ListScenarios"
+	RouteGraph_UpdateScenario_FullMethodName       = "/routegraph.RouteGraph/UpdateScenario"
+	RouteGraph_DeleteScenario_FullMethodName       = "/routegraph.RouteGraph/DeleteScenario"
+	RouteGraph_EvaluateScenario_FullMethodName     = "/routegraph.RouteGraph/EvaluateScenario"
+	RouteGraph_PromoteScenario_FullMethodName      = "/routegraph.RouteGraph/PromoteScenario"
+	RouteGraph_GenerateWalkLinks_FullMethodName    = "/routegraph.RouteGraph/GenerateWalkLinks"
+	RouteGraph_TransferHubs_FullMethodName         = "/routegraph.RouteGraph/TransferHubs"
+	RouteGraph_StopCentrality_FullMethodName       = "/routegraph.RouteGraph/StopCentrality"
+	RouteGraph_CriticalElements_FullMethodName     = "/routegraph.RouteGraph/CriticalElements"
+	RouteGraph_ConnectivityReport_FullMethodName   = "/routegraph.RouteGraph/ConnectivityReport"
+	RouteGraph_LoadDemand_FullMethodName           = "/routegraph.RouteGraph/LoadDemand"
+	RouteGraph_AssignDemand_FullMethodName         = "/routegraph.RouteGraph/AssignDemand"
+	RouteGraph_RidershipStats_FullMethodName       = "/routegraph.RouteGraph/RidershipStats"
+	RouteGraph_GetEdgeProfile_FullMethodName       = "/routegraph.RouteGraph/GetEdgeProfile"
+	RouteGraph_SetEdgeProfile_FullMethodName       = "/routegraph.RouteGraph/SetEdgeProfile"
+	RouteGraph_BuildEdgeProfiles_FullMethodName    = "/routegraph.RouteGraph/BuildEdgeProfiles"
+	RouteGraph_IngestTripLog_FullMethodName        = "/routegraph.RouteGraph/IngestTripLog"
+	RouteGraph_ValidateNetwork_FullMethodName      = "/routegraph.RouteGraph/ValidateNetwork"
+	RouteGraph_RenderNetworkMap_FullMethodName     = "/routegraph.RouteGraph/RenderNetworkMap"
+	RouteGraph_ReportData_FullMethodName           = "/routegraph.RouteGraph/ReportData"
+	RouteGraph_GenerateLineSheet_FullMethodName    = "/routegraph.RouteGraph/GenerateLineSheet"
+	RouteGraph_GenerateReport_FullMethodName       = "/routegraph.RouteGraph/GenerateReport"
+	RouteGraph_GenerateReportStream_FullMethodName = "/routegraph.RouteGraph/GenerateReportStream"
+	RouteGraph_SubmitReport_FullMethodName         = "/routegraph.RouteGraph/SubmitReport"
+	RouteGraph_GetReportJob_FullMethodName         = "/routegraph.RouteGraph/GetReportJob"
+	RouteGraph_ListReportJobs_FullMethodName       = "/routegraph.RouteGraph/ListReportJobs"
+	RouteGraph_DownloadReport_FullMethodName       = "/routegraph.RouteGraph/DownloadReport"
+	RouteGraph_CreateReportSchedule_FullMethodName = "/routegraph.RouteGraph/CreateReportSchedule"
+	RouteGraph_ListReportSchedules_FullMethodName  = "/routegraph.RouteGraph/ListReportSchedules"
+	RouteGraph_DeleteReportSchedule_FullMethodName = "/routegraph.RouteGraph/DeleteReportSchedule"
 )
 
 // RouteGraphClient is the client API for RouteGraph service.
@@ -102,14 +151,82 @@ type RouteGraphClient interface {
 	CreateParkedAt(ctx context.Context, in *ParkedAt, opts ...grpc.CallOption) (*ParkedAt, error)
 	UpdateParkedAt(ctx context.Context, in *ParkedAt, opts ...grpc.CallOption) (*ParkedAt, error)
 	DeleteParkedAt(ctx context.Context, in *ParkedAt, opts ...grpc.CallOption) (*Empty, error)
-	// Complex queries (the 5 required)
+	// Complex queries
 	AssignVehicle(ctx context.Context, in *AssignVehicleRequest, opts ...grpc.CallOption) (*AssignVehicleResponse, error)
-	RecalibrateEdge(ctx context.Context, in *RecalibrateRequest, opts ...grpc.CallOption) (*NextEdge, error)
+	RecalibrateEdge(ctx context.Context, in *RecalibrateRequest, opts ...grpc.CallOption) (*RecalibrateResponse, error)
 	ShortestPath(ctx context.Context, in *PathRequest, opts ...grpc.CallOption) (*PathResponse, error)
 	TopPairs(ctx context.Context, in *TopPairsRequest, opts ...grpc.CallOption) (*TopPairsResponse, error)
 	DepotsIdleStats(ctx context.Context, in *DepotsRequest, opts ...grpc.CallOption) (*DepotsResponse, error)
+	// Route editing
+	SetLineRoute(ctx context.Context, in *SetLineRouteRequest, opts ...grpc.CallOption) (*LineRouteResponse, error)
+	InsertStopIntoLine(ctx context.Context, in *InsertStopRequest, opts ...grpc.CallOption) (*LineRouteResponse, error)
+	RemoveStopFromLine(ctx context.Context, in *RemoveStopRequest, opts ...grpc.CallOption) (*LineRouteResponse, error)
+	// Route patterns
+	UpsertPattern(ctx context.Context, in *RoutePattern, opts ...grpc.CallOption) (*RoutePattern, error)
+	ListPatterns(ctx context.Context, in *ListPatternsRequest, opts ...grpc.CallOption) (*ListPatternsResponse, error)
+	DeletePattern(ctx context.Context, in *RoutePattern, opts ...grpc.CallOption) (*Empty, error)
+	// Service calendar
+	SetServicePeriods(ctx context.Context, in *ServicePeriodsRequest, opts ...grpc.CallOption) (*ServicePeriodsResponse, error)
+	ListServicePeriods(ctx context.Context, in *ID, opts ...grpc.CallOption) (*ServicePeriodsResponse, error)
+	CreateHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Holiday, error)
+	DeleteHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Empty, error)
+	ListHolidays(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HolidaysResponse, error)
+	LineFrequency(ctx context.Context, in *LineFrequencyRequest, opts ...grpc.CallOption) (*LineFrequencyResponse, error)
+	// Timetable
+	GenerateTimetable(ctx context.Context, in *TimetableRequest, opts ...grpc.CallOption) (*TimetableResponse, error)
+	// Departure board
+	DepartureBoard(ctx context.Context, in *DepartureBoardRequest, opts ...grpc.CallOption) (*DepartureBoardResponse, error)
+	StreamDepartureBoard(ctx context.Context, in *DepartureBoardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DepartureBoardResponse], error)
+	// Disruptions
+	CreateDisruption(ctx context.Context, in *Disruption, opts ...grpc.CallOption) (*Disruption, error)
+	ListDisruptions(ctx context.Context, in *ListDisruptionsRequest, opts ...grpc.CallOption) (*ListDisruptionsResponse, error)
+	EndDisruption(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Disruption, error)
+	// Scenarios
+	CreateScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error)
+	GetScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Scenario, error)
+	ListScenarios(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScenariosResponse, error)
+	UpdateScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error)
+	DeleteScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	EvaluateScenario(ctx context.Context, in *EvaluateScenarioRequest, opts ...grpc.CallOption) (*ScenarioEvaluation, error)
+	PromoteScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Scenario, error)
+	// Walking transfers
+	GenerateWalkLinks(ctx context.Context, in *GenerateWalkLinksRequest, opts ...grpc.CallOption) (*GenerateWalkLinksResponse, error)
+	TransferHubs(ctx context.Context, in *TransferHubsRequest, opts ...grpc.CallOption) (*TransferHubsResponse, error)
+	// Centrality
+	StopCentrality(ctx context.Context, in *StopCentralityRequest, opts ...grpc.CallOption) (*StopCentralityResponse, error)
+	// Resilience
+	CriticalElements(ctx context.Context, in *CriticalElementsRequest, opts ...grpc.CallOption) (*CriticalElementsResponse, error)
+	// Connectivity
+	ConnectivityReport(ctx context.Context, in *ConnectivityRequest, opts ...grpc.CallOption) (*ConnectivityResponse, error)
+	// Demand
+	LoadDemand(ctx context.Context, in *LoadDemandRequest, opts ...grpc.CallOption) (*LoadDemandResponse, error)
+	AssignDemand(ctx context.Context, in *AssignDemandRequest, opts ...grpc.CallOption) (*AssignDemandResponse, error)
+	// Ridership
+	RidershipStats(ctx context.Context, in *RidershipRequest, opts ...grpc.CallOption) (*RidershipResponse, error)
+	// Travel time profiles
+	GetEdgeProfile(ctx context.Context, in *NextEdge, opts ...grpc.CallOption) (*EdgeProfile, error)
+	SetEdgeProfile(ctx context.Context, in *EdgeProfile, opts ...grpc.CallOption) (*EdgeProfile, error)
+	BuildEdgeProfiles(ctx context.Context, in *BuildEdgeProfilesRequest, opts ...grpc.CallOption) (*BuildEdgeProfilesResponse, error)
+	// Trip logs
+	IngestTripLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestTripLogRequest, IngestTripLogResponse], error)
+	// Validation
+	ValidateNetwork(ctx context.Context, in *ValidateNetworkRequest, opts ...grpc.CallOption) (*ValidateNetworkResponse, error)
+	// Network map
+	RenderNetworkMap(ctx context.Context, in *NetworkMapRequest, opts ...grpc.CallOption) (*NetworkMapResponse, error)
+	// Report data
+	ReportData(ctx context.Context, in *ReportDataRequest, opts ...grpc.CallOption) (*ReportDataResponse, error)
+	// Line sheet
+	GenerateLineSheet(ctx context.Context, in *LineSheetRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error)
 	// Report
 	GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error)
+	GenerateReportStream(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error)
+	SubmitReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*ReportJob, error)
+	GetReportJob(ctx context.Context, in *ID, opts ...grpc.CallOption) (*ReportJob, error)
+	ListReportJobs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReportJobsResponse, error)
+	DownloadReport(ctx context.Context, in *ID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error)
+	CreateReportSchedule(ctx context.Context, in *ReportSchedule, opts ...grpc.CallOption) (*ReportSchedule, error)
+	ListReportSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReportSchedulesResponse, error)
+	DeleteReportSchedule(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type routeGraphClient struct {
@@ -460,9 +577,9 @@ func (c *routeGraphClient) AssignVehicle(ctx context.Context, in *AssignVehicleR
 	return out, nil
 }
 
-func (c *routeGraphClient) RecalibrateEdge(ctx context.Context, in *RecalibrateRequest, opts ...grpc.CallOption) (*NextEdge, error) {
+func (c *routeGraphClient) RecalibrateEdge(ctx context.Context, in *RecalibrateRequest, opts ...grpc.CallOption) (*RecalibrateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(NextEdge)
+	out := new(RecalibrateResponse)
 	err := c.cc.Invoke(ctx, RouteGraph_RecalibrateEdge_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -500,912 +617,2497 @@ func (c *routeGraphClient) DepotsIdleStats(ctx context.Context, in *DepotsReques
 	return out, nil
 }
 
-func (c *routeGraphClient) GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error) {
+func (c *routeGraphClient) SetLineRoute(ctx context.Context, in *SetLineRouteRequest, opts ...grpc.CallOption) (*LineRouteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GenerateReportResponse)
-	err := c.cc.Invoke(ctx, RouteGraph_GenerateReport_FullMethodName, in, out, cOpts...)
+	out := new(LineRouteResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_SetLineRoute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RouteGraphServer is the server API for RouteGraph service.
-// All implementations must embed UnimplementedRouteGraphServer
-// for forward compatibility.
-type RouteGraphServer interface {
-	// Stops
-	CreateStop(context.Context, *Stop) (*Stop, error)
-	GetStop(context.Context, *ID) (*Stop, error)
-	UpdateStop(context.Context, *Stop) (*Stop, error)
-	DeleteStop(context.Context, *ID) (*Empty, error)
-	// Lines
-	CreateLine(context.Context, *Line) (*Line, error)
-	GetLine(context.Context, *ID) (*Line, error)
-	UpdateLine(context.Context, *Line) (*Line, error)
-	DeleteLine(context.Context, *ID) (*Empty, error)
-	// Vehicles
-	CreateVehicle(context.Context, *Vehicle) (*Vehicle, error)
-	GetVehicle(context.Context, *ID) (*Vehicle, error)
-	UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error)
-	DeleteVehicle(context.Context, *ID) (*Empty, error)
-	// Depots
-	CreateDepot(context.Context, *Depot) (*Depot, error)
-	GetDepot(context.Context, *ID) (*Depot, error)
-	UpdateDepot(context.Context, *Depot) (*Depot, error)
-	DeleteDepot(context.Context, *ID) (*Empty, error)
-	// Edges CRUD
-	GetNextEdge(context.Context, *NextEdge) (*NextEdge, error)
-	CreateNextEdge(context.Context, *NextEdge) (*NextEdge, error)
-	UpdateNextEdge(context.Context, *NextEdge) (*NextEdge, error)
-	DeleteNextEdge(context.Context, *NextEdge) (*Empty, error)
-	GetServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
-	ServesList(context.Context, *ServesListRequest) (*ServesListResponse, error)
-	CreateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
-	UpdateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
-	DeleteServesEdge(context.Context, *ServesEdge) (*Empty, error)
-	GetAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
-	CreateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
-	UpdateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
-	DeleteAssignedTo(context.Context, *AssignedTo) (*Empty, error)
-	GetParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
-	CreateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
-	UpdateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
-	DeleteParkedAt(context.Context, *ParkedAt) (*Empty, error)
-	// Complex queries (the 5 required)
-	AssignVehicle(context.Context, *AssignVehicleRequest) (*AssignVehicleResponse, error)
-	RecalibrateEdge(context.Context, *RecalibrateRequest) (*NextEdge, error)
-	ShortestPath(context.Context, *PathRequest) (*PathResponse, error)
-	TopPairs(context.Context, *TopPairsRequest) (*TopPairsResponse, error)
-	DepotsIdleStats(context.Context, *DepotsRequest) (*DepotsResponse, error)
-	// Report
-	GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error)
-	mustEmbedUnimplementedRouteGraphServer()
+func (c *routeGraphClient) InsertStopIntoLine(ctx context.Context, in *InsertStopRequest, opts ...grpc.CallOption) (*LineRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineRouteResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_InsertStopIntoLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedRouteGraphServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRouteGraphServer struct{}
-
-func (UnimplementedRouteGraphServer) CreateStop(context.Context, *Stop) (*Stop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStop not implemented")
+func (c *routeGraphClient) RemoveStopFromLine(ctx context.Context, in *RemoveStopRequest, opts ...grpc.CallOption) (*LineRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineRouteResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_RemoveStopFromLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) GetStop(context.Context, *ID) (*Stop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStop not implemented")
+
+func (c *routeGraphClient) UpsertPattern(ctx context.Context, in *RoutePattern, opts ...grpc.CallOption) (*RoutePattern, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutePattern)
+	err := c.cc.Invoke(ctx, RouteGraph_UpsertPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateStop(context.Context, *Stop) (*Stop, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStop not implemented")
+
+func (c *routeGraphClient) ListPatterns(ctx context.Context, in *ListPatternsRequest, opts ...grpc.CallOption) (*ListPatternsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPatternsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListPatterns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) DeleteStop(context.Context, *ID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStop not implemented")
+
+func (c *routeGraphClient) DeletePattern(ctx context.Context, in *RoutePattern, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, RouteGraph_DeletePattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) CreateLine(context.Context, *Line) (*Line, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateLine not implemented")
+
+func (c *routeGraphClient) SetServicePeriods(ctx context.Context, in *ServicePeriodsRequest, opts ...grpc.CallOption) (*ServicePeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServicePeriodsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_SetServicePeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) GetLine(context.Context, *ID) (*Line, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLine not implemented")
+
+func (c *routeGraphClient) ListServicePeriods(ctx context.Context, in *ID, opts ...grpc.CallOption) (*ServicePeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServicePeriodsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListServicePeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateLine(context.Context, *Line) (*Line, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLine not implemented")
+
+func (c *routeGraphClient) CreateHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, RouteGraph_CreateHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) DeleteLine(context.Context, *ID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteLine not implemented")
+
+func (c *routeGraphClient) DeleteHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, RouteGraph_DeleteHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) CreateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVehicle not implemented")
+
+func (c *routeGraphClient) ListHolidays(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HolidaysResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) GetVehicle(context.Context, *ID) (*Vehicle, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+
+func (c *routeGraphClient) LineFrequency(ctx context.Context, in *LineFrequencyRequest, opts ...grpc.CallOption) (*LineFrequencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineFrequencyResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_LineFrequency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+
+func (c *routeGraphClient) GenerateTimetable(ctx context.Context, in *TimetableRequest, opts ...grpc.CallOption) (*TimetableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimetableResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_GenerateTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) DeleteVehicle(context.Context, *ID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
+
+func (c *routeGraphClient) DepartureBoard(ctx context.Context, in *DepartureBoardRequest, opts ...grpc.CallOption) (*DepartureBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartureBoardResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_DepartureBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) CreateDepot(context.Context, *Depot) (*Depot, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDepot not implemented")
+
+func (c *routeGraphClient) StreamDepartureBoard(ctx context.Context, in *DepartureBoardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DepartureBoardResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGraph_ServiceDesc.Streams[0], RouteGraph_StreamDepartureBoard_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DepartureBoardRequest, DepartureBoardResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedRouteGraphServer) GetDepot(context.Context, *ID) (*Depot, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepot not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_StreamDepartureBoardClient = grpc.ServerStreamingClient[DepartureBoardResponse]
+
+func (c *routeGraphClient) CreateDisruption(ctx context.Context, in *Disruption, opts ...grpc.CallOption) (*Disruption, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Disruption)
+	err := c.cc.Invoke(ctx, RouteGraph_CreateDisruption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateDepot(context.Context, *Depot) (*Depot, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepot not implemented")
+
+func (c *routeGraphClient) ListDisruptions(ctx context.Context, in *ListDisruptionsRequest, opts ...grpc.CallOption) (*ListDisruptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDisruptionsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListDisruptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) DeleteDepot(context.Context, *ID) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepot not implemented")
+
+func (c *routeGraphClient) EndDisruption(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Disruption, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Disruption)
+	err := c.cc.Invoke(ctx, RouteGraph_EndDisruption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) GetNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextEdge not implemented")
+
+func (c *routeGraphClient) CreateScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, RouteGraph_CreateScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) CreateNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNextEdge not implemented")
+
+func (c *routeGraphClient) GetScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, RouteGraph_GetScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNextEdge not implemented")
+
+func (c *routeGraphClient) ListScenarios(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScenariosResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) DeleteNextEdge(context.Context, *NextEdge) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNextEdge not implemented")
+
+func (c *routeGraphClient) UpdateScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, RouteGraph_UpdateScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) GetServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServesEdge not implemented")
+
+func (c *routeGraphClient) DeleteScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, RouteGraph_DeleteScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) ServesList(context.Context, *ServesListRequest) (*ServesListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServesList not implemented")
+
+func (c *routeGraphClient) EvaluateScenario(ctx context.Context, in *EvaluateScenarioRequest, opts ...grpc.CallOption) (*ScenarioEvaluation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScenarioEvaluation)
+	err := c.cc.Invoke(ctx, RouteGraph_EvaluateScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) CreateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateServesEdge not implemented")
+
+func (c *routeGraphClient) PromoteScenario(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, RouteGraph_PromoteScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGraphServer) UpdateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
+
+func (c *routeGraphClient) GenerateWalkLinks(ctx context.Context, in *GenerateWalkLinksRequest, opts ...grpc.CallOption) (*GenerateWalkLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateWalkLinksResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_GenerateWalkLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) TransferHubs(ctx context.Context, in *TransferHubsRequest, opts ...grpc.CallOption) (*TransferHubsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferHubsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_TransferHubs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) StopCentrality(ctx context.Context, in *StopCentralityRequest, opts ...grpc.CallOption) (*StopCentralityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopCentralityResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_StopCentrality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) CriticalElements(ctx context.Context, in *CriticalElementsRequest, opts ...grpc.CallOption) (*CriticalElementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CriticalElementsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_CriticalElements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) ConnectivityReport(ctx context.Context, in *ConnectivityRequest, opts ...grpc.CallOption) (*ConnectivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectivityResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ConnectivityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) LoadDemand(ctx context.Context, in *LoadDemandRequest, opts ...grpc.CallOption) (*LoadDemandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoadDemandResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_LoadDemand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) AssignDemand(ctx context.Context, in *AssignDemandRequest, opts ...grpc.CallOption) (*AssignDemandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignDemandResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_AssignDemand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) RidershipStats(ctx context.Context, in *RidershipRequest, opts ...grpc.CallOption) (*RidershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RidershipResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_RidershipStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) GetEdgeProfile(ctx context.Context, in *NextEdge, opts ...grpc.CallOption) (*EdgeProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EdgeProfile)
+	err := c.cc.Invoke(ctx, RouteGraph_GetEdgeProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) SetEdgeProfile(ctx context.Context, in *EdgeProfile, opts ...grpc.CallOption) (*EdgeProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EdgeProfile)
+	err := c.cc.Invoke(ctx, RouteGraph_SetEdgeProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) BuildEdgeProfiles(ctx context.Context, in *BuildEdgeProfilesRequest, opts ...grpc.CallOption) (*BuildEdgeProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildEdgeProfilesResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_BuildEdgeProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) IngestTripLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestTripLogRequest, IngestTripLogResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGraph_ServiceDesc.Streams[1], RouteGraph_IngestTripLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IngestTripLogRequest, IngestTripLogResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_IngestTripLogClient = grpc.ClientStreamingClient[IngestTripLogRequest, IngestTripLogResponse]
+
+func (c *routeGraphClient) ValidateNetwork(ctx context.Context, in *ValidateNetworkRequest, opts ...grpc.CallOption) (*ValidateNetworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateNetworkResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ValidateNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) RenderNetworkMap(ctx context.Context, in *NetworkMapRequest, opts ...grpc.CallOption) (*NetworkMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkMapResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_RenderNetworkMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) ReportData(ctx context.Context, in *ReportDataRequest, opts ...grpc.CallOption) (*ReportDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportDataResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ReportData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) GenerateLineSheet(ctx context.Context, in *LineSheetRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateReportResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_GenerateLineSheet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateReportResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_GenerateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) GenerateReportStream(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGraph_ServiceDesc.Streams[2], RouteGraph_GenerateReportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateReportRequest, ReportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_GenerateReportStreamClient = grpc.ServerStreamingClient[ReportChunk]
+
+func (c *routeGraphClient) SubmitReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*ReportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportJob)
+	err := c.cc.Invoke(ctx, RouteGraph_SubmitReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) GetReportJob(ctx context.Context, in *ID, opts ...grpc.CallOption) (*ReportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportJob)
+	err := c.cc.Invoke(ctx, RouteGraph_GetReportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) ListReportJobs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReportJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportJobsResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListReportJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) DownloadReport(ctx context.Context, in *ID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGraph_ServiceDesc.Streams[3], RouteGraph_DownloadReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ID, ReportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_DownloadReportClient = grpc.ServerStreamingClient[ReportChunk]
+
+func (c *routeGraphClient) CreateReportSchedule(ctx context.Context, in *ReportSchedule, opts ...grpc.CallOption) (*ReportSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSchedule)
+	err := c.cc.Invoke(ctx, RouteGraph_CreateReportSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) ListReportSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ReportSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSchedulesResponse)
+	err := c.cc.Invoke(ctx, RouteGraph_ListReportSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGraphClient) DeleteReportSchedule(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, RouteGraph_DeleteReportSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouteGraphServer is the server API for RouteGraph service.
+// All implementations must embed UnimplementedRouteGraphServer
+// for forward compatibility.
+type RouteGraphServer interface {
+	// Stops
+	CreateStop(context.Context, *Stop) (*Stop, error)
+	GetStop(context.Context, *ID) (*Stop, error)
+	UpdateStop(context.Context, *Stop) (*Stop, error)
+	DeleteStop(context.Context, *ID) (*Empty, error)
+	// Lines
+	CreateLine(context.Context, *Line) (*Line, error)
+	GetLine(context.Context, *ID) (*Line, error)
+	UpdateLine(context.Context, *Line) (*Line, error)
+	DeleteLine(context.Context, *ID) (*Empty, error)
+	// Vehicles
+	CreateVehicle(context.Context, *Vehicle) (*Vehicle, error)
+	GetVehicle(context.Context, *ID) (*Vehicle, error)
+	UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error)
+	DeleteVehicle(context.Context, *ID) (*Empty, error)
+	// Depots
+	CreateDepot(context.Context, *Depot) (*Depot, error)
+	GetDepot(context.Context, *ID) (*Depot, error)
+	UpdateDepot(context.Context, *Depot) (*Depot, error)
+	DeleteDepot(context.Context, *ID) (*Empty, error)
+	// Edges CRUD
+	GetNextEdge(context.Context, *NextEdge) (*NextEdge, error)
+	CreateNextEdge(context.Context, *NextEdge) (*NextEdge, error)
+	UpdateNextEdge(context.Context, *NextEdge) (*NextEdge, error)
+	DeleteNextEdge(context.Context, *NextEdge) (*Empty, error)
+	GetServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
+	ServesList(context.Context, *ServesListRequest) (*ServesListResponse, error)
+	CreateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
+	UpdateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error)
+	DeleteServesEdge(context.Context, *ServesEdge) (*Empty, error)
+	GetAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
+	CreateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
+	UpdateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error)
+	DeleteAssignedTo(context.Context, *AssignedTo) (*Empty, error)
+	GetParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
+	CreateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
+	UpdateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error)
+	DeleteParkedAt(context.Context, *ParkedAt) (*Empty, error)
+	// Complex queries
+	AssignVehicle(context.Context, *AssignVehicleRequest) (*AssignVehicleResponse, error)
+	RecalibrateEdge(context.Context, *RecalibrateRequest) (*RecalibrateResponse, error)
+	ShortestPath(context.Context, *PathRequest) (*PathResponse, error)
+	TopPairs(context.Context, *TopPairsRequest) (*TopPairsResponse, error)
+	DepotsIdleStats(context.Context, *DepotsRequest) (*DepotsResponse, error)
+	// Route editing
+	SetLineRoute(context.Context, *SetLineRouteRequest) (*LineRouteResponse, error)
+	InsertStopIntoLine(context.Context, *InsertStopRequest) (*LineRouteResponse, error)
+	RemoveStopFromLine(context.Context, *RemoveStopRequest) (*LineRouteResponse, error)
+	// Route patterns
+	UpsertPattern(context.Context, *RoutePattern) (*RoutePattern, error)
+	ListPatterns(context.Context, *ListPatternsRequest) (*ListPatternsResponse, error)
+	DeletePattern(context.Context, *RoutePattern) (*Empty, error)
+	// Service calendar
+	SetServicePeriods(context.Context, *ServicePeriodsRequest) (*ServicePeriodsResponse, error)
+	ListServicePeriods(context.Context, *ID) (*ServicePeriodsResponse, error)
+	CreateHoliday(context.Context, *Holiday) (*Holiday, error)
+	DeleteHoliday(context.Context, *Holiday) (*Empty, error)
+	ListHolidays(context.Context, *Empty) (*HolidaysResponse, error)
+	LineFrequency(context.Context, *LineFrequencyRequest) (*LineFrequencyResponse, error)
+	// Timetable
+	GenerateTimetable(context.Context, *TimetableRequest) (*TimetableResponse, error)
+	// Departure board
+	DepartureBoard(context.Context, *DepartureBoardRequest) (*DepartureBoardResponse, error)
+	StreamDepartureBoard(*DepartureBoardRequest, grpc.ServerStreamingServer[DepartureBoardResponse]) error
+	// Disruptions
+	CreateDisruption(context.Context, *Disruption) (*Disruption, error)
+	ListDisruptions(context.Context, *ListDisruptionsRequest) (*ListDisruptionsResponse, error)
+	EndDisruption(context.Context, *ID) (*Disruption, error)
+	// Scenarios
+	CreateScenario(context.Context, *Scenario) (*Scenario, error)
+	GetScenario(context.Context, *ID) (*Scenario, error)
+	ListScenarios(context.Context, *Empty) (*ScenariosResponse, error)
+	UpdateScenario(context.Context, *Scenario) (*Scenario, error)
+	DeleteScenario(context.Context, *ID) (*Empty, error)
+	EvaluateScenario(context.Context, *EvaluateScenarioRequest) (*ScenarioEvaluation, error)
+	PromoteScenario(context.Context, *ID) (*Scenario, error)
+	// Walking transfers
+	GenerateWalkLinks(context.Context, *GenerateWalkLinksRequest) (*GenerateWalkLinksResponse, error)
+	TransferHubs(context.Context, *TransferHubsRequest) (*TransferHubsResponse, error)
+	// Centrality
+	StopCentrality(context.Context, *StopCentralityRequest) (*StopCentralityResponse, error)
+	// Resilience
+	CriticalElements(context.Context, *CriticalElementsRequest) (*CriticalElementsResponse, error)
+	// Connectivity
+	ConnectivityReport(context.Context, *ConnectivityRequest) (*ConnectivityResponse, error)
+	// Demand
+	LoadDemand(context.Context, *LoadDemandRequest) (*LoadDemandResponse, error)
+	AssignDemand(context.Context, *AssignDemandRequest) (*AssignDemandResponse, error)
+	// Ridership
+	RidershipStats(context.Context, *RidershipRequest) (*RidershipResponse, error)
+	// Travel time profiles
+	GetEdgeProfile(context.Context, *NextEdge) (*EdgeProfile, error)
+	SetEdgeProfile(context.Context, *EdgeProfile) (*EdgeProfile, error)
+	BuildEdgeProfiles(context.Context, *BuildEdgeProfilesRequest) (*BuildEdgeProfilesResponse, error)
+	// Trip logs
+	IngestTripLog(grpc.ClientStreamingServer[IngestTripLogRequest, IngestTripLogResponse]) error
+	// Validation
+	ValidateNetwork(context.Context, *ValidateNetworkRequest) (*ValidateNetworkResponse, error)
+	// Network map
+	RenderNetworkMap(context.Context, *NetworkMapRequest) (*NetworkMapResponse, error)
+	// Report data
+	ReportData(context.Context, *ReportDataRequest) (*ReportDataResponse, error)
+	// Line sheet
+	GenerateLineSheet(context.Context, *LineSheetRequest) (*GenerateReportResponse, error)
+	// Report
+	GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error)
+	GenerateReportStream(*GenerateReportRequest, grpc.ServerStreamingServer[ReportChunk]) error
+	SubmitReport(context.Context, *GenerateReportRequest) (*ReportJob, error)
+	GetReportJob(context.Context, *ID) (*ReportJob, error)
+	ListReportJobs(context.Context, *Empty) (*ReportJobsResponse, error)
+	DownloadReport(*ID, grpc.ServerStreamingServer[ReportChunk]) error
+	CreateReportSchedule(context.Context, *ReportSchedule) (*ReportSchedule, error)
+	ListReportSchedules(context.Context, *Empty) (*ReportSchedulesResponse, error)
+	DeleteReportSchedule(context.Context, *ID) (*Empty, error)
+	mustEmbedUnimplementedRouteGraphServer()
+}
+
+// UnimplementedRouteGraphServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRouteGraphServer struct{}
+
+func (UnimplementedRouteGraphServer) CreateStop(context.Context, *Stop) (*Stop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStop not implemented")
+}
+func (UnimplementedRouteGraphServer) GetStop(context.Context, *ID) (*Stop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStop not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateStop(context.Context, *Stop) (*Stop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStop not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteStop(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStop not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateLine(context.Context, *Line) (*Line, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLine not implemented")
+}
+func (UnimplementedRouteGraphServer) GetLine(context.Context, *ID) (*Line, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLine not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateLine(context.Context, *Line) (*Line, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLine not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteLine(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLine not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVehicle not implemented")
+}
+func (UnimplementedRouteGraphServer) GetVehicle(context.Context, *ID) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteVehicle(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateDepot(context.Context, *Depot) (*Depot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDepot not implemented")
+}
+func (UnimplementedRouteGraphServer) GetDepot(context.Context, *ID) (*Depot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepot not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateDepot(context.Context, *Depot) (*Depot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepot not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteDepot(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepot not implemented")
+}
+func (UnimplementedRouteGraphServer) GetNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNextEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateNextEdge(context.Context, *NextEdge) (*NextEdge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNextEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteNextEdge(context.Context, *NextEdge) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNextEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) GetServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServesEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) ServesList(context.Context, *ServesListRequest) (*ServesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServesList not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServesEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateServesEdge(context.Context, *ServesEdge) (*ServesEdge, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateServesEdge not implemented")
 }
-func (UnimplementedRouteGraphServer) DeleteServesEdge(context.Context, *ServesEdge) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteServesEdge not implemented")
+func (UnimplementedRouteGraphServer) DeleteServesEdge(context.Context, *ServesEdge) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServesEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) GetAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignedTo not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignedTo not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignedTo not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteAssignedTo(context.Context, *AssignedTo) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignedTo not implemented")
+}
+func (UnimplementedRouteGraphServer) GetParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParkedAt not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateParkedAt not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParkedAt not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteParkedAt(context.Context, *ParkedAt) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteParkedAt not implemented")
+}
+func (UnimplementedRouteGraphServer) AssignVehicle(context.Context, *AssignVehicleRequest) (*AssignVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignVehicle not implemented")
+}
+func (UnimplementedRouteGraphServer) RecalibrateEdge(context.Context, *RecalibrateRequest) (*RecalibrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalibrateEdge not implemented")
+}
+func (UnimplementedRouteGraphServer) ShortestPath(context.Context, *PathRequest) (*PathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortestPath not implemented")
+}
+func (UnimplementedRouteGraphServer) TopPairs(context.Context, *TopPairsRequest) (*TopPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopPairs not implemented")
+}
+func (UnimplementedRouteGraphServer) DepotsIdleStats(context.Context, *DepotsRequest) (*DepotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepotsIdleStats not implemented")
+}
+func (UnimplementedRouteGraphServer) SetLineRoute(context.Context, *SetLineRouteRequest) (*LineRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLineRoute not implemented")
+}
+func (UnimplementedRouteGraphServer) InsertStopIntoLine(context.Context, *InsertStopRequest) (*LineRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertStopIntoLine not implemented")
+}
+func (UnimplementedRouteGraphServer) RemoveStopFromLine(context.Context, *RemoveStopRequest) (*LineRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStopFromLine not implemented")
+}
+func (UnimplementedRouteGraphServer) UpsertPattern(context.Context, *RoutePattern) (*RoutePattern, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPattern not implemented")
+}
+func (UnimplementedRouteGraphServer) ListPatterns(context.Context, *ListPatternsRequest) (*ListPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPatterns not implemented")
+}
+func (UnimplementedRouteGraphServer) DeletePattern(context.Context, *RoutePattern) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePattern not implemented")
+}
+func (UnimplementedRouteGraphServer) SetServicePeriods(context.Context, *ServicePeriodsRequest) (*ServicePeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServicePeriods not implemented")
+}
+func (UnimplementedRouteGraphServer) ListServicePeriods(context.Context, *ID) (*ServicePeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServicePeriods not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateHoliday(context.Context, *Holiday) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHoliday not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteHoliday(context.Context, *Holiday) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHoliday not implemented")
+}
+func (UnimplementedRouteGraphServer) ListHolidays(context.Context, *Empty) (*HolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHolidays not implemented")
+}
+func (UnimplementedRouteGraphServer) LineFrequency(context.Context, *LineFrequencyRequest) (*LineFrequencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LineFrequency not implemented")
+}
+func (UnimplementedRouteGraphServer) GenerateTimetable(context.Context, *TimetableRequest) (*TimetableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTimetable not implemented")
+}
+func (UnimplementedRouteGraphServer) DepartureBoard(context.Context, *DepartureBoardRequest) (*DepartureBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepartureBoard not implemented")
+}
+func (UnimplementedRouteGraphServer) StreamDepartureBoard(*DepartureBoardRequest, grpc.ServerStreamingServer[DepartureBoardResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDepartureBoard not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateDisruption(context.Context, *Disruption) (*Disruption, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDisruption not implemented")
+}
+func (UnimplementedRouteGraphServer) ListDisruptions(context.Context, *ListDisruptionsRequest) (*ListDisruptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisruptions not implemented")
+}
+func (UnimplementedRouteGraphServer) EndDisruption(context.Context, *ID) (*Disruption, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndDisruption not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateScenario(context.Context, *Scenario) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) GetScenario(context.Context, *ID) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) ListScenarios(context.Context, *Empty) (*ScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScenarios not implemented")
+}
+func (UnimplementedRouteGraphServer) UpdateScenario(context.Context, *Scenario) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteScenario(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) EvaluateScenario(context.Context, *EvaluateScenarioRequest) (*ScenarioEvaluation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) PromoteScenario(context.Context, *ID) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteScenario not implemented")
+}
+func (UnimplementedRouteGraphServer) GenerateWalkLinks(context.Context, *GenerateWalkLinksRequest) (*GenerateWalkLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWalkLinks not implemented")
+}
+func (UnimplementedRouteGraphServer) TransferHubs(context.Context, *TransferHubsRequest) (*TransferHubsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHubs not implemented")
+}
+func (UnimplementedRouteGraphServer) StopCentrality(context.Context, *StopCentralityRequest) (*StopCentralityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCentrality not implemented")
+}
+func (UnimplementedRouteGraphServer) CriticalElements(context.Context, *CriticalElementsRequest) (*CriticalElementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CriticalElements not implemented")
+}
+func (UnimplementedRouteGraphServer) ConnectivityReport(context.Context, *ConnectivityRequest) (*ConnectivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectivityReport not implemented")
+}
+func (UnimplementedRouteGraphServer) LoadDemand(context.Context, *LoadDemandRequest) (*LoadDemandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadDemand not implemented")
+}
+func (UnimplementedRouteGraphServer) AssignDemand(context.Context, *AssignDemandRequest) (*AssignDemandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDemand not implemented")
+}
+func (UnimplementedRouteGraphServer) RidershipStats(context.Context, *RidershipRequest) (*RidershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RidershipStats not implemented")
+}
+func (UnimplementedRouteGraphServer) GetEdgeProfile(context.Context, *NextEdge) (*EdgeProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEdgeProfile not implemented")
+}
+func (UnimplementedRouteGraphServer) SetEdgeProfile(context.Context, *EdgeProfile) (*EdgeProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEdgeProfile not implemented")
+}
+func (UnimplementedRouteGraphServer) BuildEdgeProfiles(context.Context, *BuildEdgeProfilesRequest) (*BuildEdgeProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildEdgeProfiles not implemented")
+}
+func (UnimplementedRouteGraphServer) IngestTripLog(grpc.ClientStreamingServer[IngestTripLogRequest, IngestTripLogResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method IngestTripLog not implemented")
+}
+func (UnimplementedRouteGraphServer) ValidateNetwork(context.Context, *ValidateNetworkRequest) (*ValidateNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateNetwork not implemented")
+}
+func (UnimplementedRouteGraphServer) RenderNetworkMap(context.Context, *NetworkMapRequest) (*NetworkMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderNetworkMap not implemented")
+}
+func (UnimplementedRouteGraphServer) ReportData(context.Context, *ReportDataRequest) (*ReportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportData not implemented")
+}
+func (UnimplementedRouteGraphServer) GenerateLineSheet(context.Context, *LineSheetRequest) (*GenerateReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateLineSheet not implemented")
+}
+func (UnimplementedRouteGraphServer) GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
+}
+func (UnimplementedRouteGraphServer) GenerateReportStream(*GenerateReportRequest, grpc.ServerStreamingServer[ReportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateReportStream not implemented")
+}
+func (UnimplementedRouteGraphServer) SubmitReport(context.Context, *GenerateReportRequest) (*ReportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReport not implemented")
+}
+func (UnimplementedRouteGraphServer) GetReportJob(context.Context, *ID) (*ReportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportJob not implemented")
+}
+func (UnimplementedRouteGraphServer) ListReportJobs(context.Context, *Empty) (*ReportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportJobs not implemented")
+}
+func (UnimplementedRouteGraphServer) DownloadReport(*ID, grpc.ServerStreamingServer[ReportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadReport not implemented")
+}
+func (UnimplementedRouteGraphServer) CreateReportSchedule(context.Context, *ReportSchedule) (*ReportSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReportSchedule not implemented")
+}
+func (UnimplementedRouteGraphServer) ListReportSchedules(context.Context, *Empty) (*ReportSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportSchedules not implemented")
+}
+func (UnimplementedRouteGraphServer) DeleteReportSchedule(context.Context, *ID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReportSchedule not implemented")
+}
+func (UnimplementedRouteGraphServer) mustEmbedUnimplementedRouteGraphServer() {}
+func (UnimplementedRouteGraphServer) testEmbeddedByValue()                    {}
+
+// UnsafeRouteGraphServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RouteGraphServer will
+// result in compilation errors.
+type UnsafeRouteGraphServer interface {
+	mustEmbedUnimplementedRouteGraphServer()
+}
+
+func RegisterRouteGraphServer(s grpc.ServiceRegistrar, srv RouteGraphServer) {
+	// If the following call pancis, it indicates UnimplementedRouteGraphServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RouteGraph_ServiceDesc, srv)
+}
+
+func _RouteGraph_CreateStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Stop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateStop(ctx, req.(*Stop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetStop(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Stop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateStop(ctx, req.(*Stop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteStop(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_CreateLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Line)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateLine(ctx, req.(*Line))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetLine(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Line)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateLine(ctx, req.(*Line))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteLine(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_CreateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateVehicle(ctx, req.(*Vehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetVehicle(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateVehicle(ctx, req.(*Vehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteVehicle(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_CreateDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Depot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateDepot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateDepot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateDepot(ctx, req.(*Depot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetDepot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetDepot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetDepot(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Depot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateDepot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateDepot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateDepot(ctx, req.(*Depot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteDepot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteDepot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteDepot(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetNextEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetNextEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetNextEdge(ctx, req.(*NextEdge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_CreateNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateNextEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateNextEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateNextEdge(ctx, req.(*NextEdge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateNextEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateNextEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateNextEdge(ctx, req.(*NextEdge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteNextEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteNextEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteNextEdge(ctx, req.(*NextEdge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServesEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetServesEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetServesEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetServesEdge(ctx, req.(*ServesEdge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) GetAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssignedTo not implemented")
+
+func _RouteGraph_ServesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).ServesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_ServesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).ServesList(ctx, req.(*ServesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) CreateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignedTo not implemented")
+
+func _RouteGraph_CreateServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServesEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateServesEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateServesEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateServesEdge(ctx, req.(*ServesEdge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) UpdateAssignedTo(context.Context, *AssignedTo) (*AssignedTo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignedTo not implemented")
+
+func _RouteGraph_UpdateServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServesEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateServesEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateServesEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateServesEdge(ctx, req.(*ServesEdge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) DeleteAssignedTo(context.Context, *AssignedTo) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignedTo not implemented")
+
+func _RouteGraph_DeleteServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServesEdge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteServesEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteServesEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteServesEdge(ctx, req.(*ServesEdge))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) GetParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetParkedAt not implemented")
+
+func _RouteGraph_GetAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignedTo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetAssignedTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetAssignedTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetAssignedTo(ctx, req.(*AssignedTo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) CreateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateParkedAt not implemented")
+
+func _RouteGraph_CreateAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignedTo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateAssignedTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateAssignedTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateAssignedTo(ctx, req.(*AssignedTo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignedTo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateAssignedTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateAssignedTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateAssignedTo(ctx, req.(*AssignedTo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignedTo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteAssignedTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteAssignedTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteAssignedTo(ctx, req.(*AssignedTo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_GetParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParkedAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).GetParkedAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_GetParkedAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).GetParkedAt(ctx, req.(*ParkedAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_CreateParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParkedAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).CreateParkedAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_CreateParkedAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).CreateParkedAt(ctx, req.(*ParkedAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_UpdateParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParkedAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpdateParkedAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpdateParkedAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpdateParkedAt(ctx, req.(*ParkedAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_DeleteParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParkedAt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeleteParkedAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeleteParkedAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeleteParkedAt(ctx, req.(*ParkedAt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_AssignVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).AssignVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_AssignVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).AssignVehicle(ctx, req.(*AssignVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) UpdateParkedAt(context.Context, *ParkedAt) (*ParkedAt, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParkedAt not implemented")
+
+func _RouteGraph_RecalibrateEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalibrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).RecalibrateEdge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_RecalibrateEdge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).RecalibrateEdge(ctx, req.(*RecalibrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) DeleteParkedAt(context.Context, *ParkedAt) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteParkedAt not implemented")
+
+func _RouteGraph_ShortestPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).ShortestPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_ShortestPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).ShortestPath(ctx, req.(*PathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) AssignVehicle(context.Context, *AssignVehicleRequest) (*AssignVehicleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignVehicle not implemented")
+
+func _RouteGraph_TopPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).TopPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_TopPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).TopPairs(ctx, req.(*TopPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) RecalibrateEdge(context.Context, *RecalibrateRequest) (*NextEdge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecalibrateEdge not implemented")
+
+func _RouteGraph_DepotsIdleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DepotsIdleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DepotsIdleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DepotsIdleStats(ctx, req.(*DepotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) ShortestPath(context.Context, *PathRequest) (*PathResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShortestPath not implemented")
+
+func _RouteGraph_SetLineRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLineRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).SetLineRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_SetLineRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).SetLineRoute(ctx, req.(*SetLineRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) TopPairs(context.Context, *TopPairsRequest) (*TopPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TopPairs not implemented")
+
+func _RouteGraph_InsertStopIntoLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).InsertStopIntoLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_InsertStopIntoLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).InsertStopIntoLine(ctx, req.(*InsertStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) DepotsIdleStats(context.Context, *DepotsRequest) (*DepotsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepotsIdleStats not implemented")
+
+func _RouteGraph_RemoveStopFromLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).RemoveStopFromLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_RemoveStopFromLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).RemoveStopFromLine(ctx, req.(*RemoveStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
+
+func _RouteGraph_UpsertPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutePattern)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).UpsertPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_UpsertPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).UpsertPattern(ctx, req.(*RoutePattern))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRouteGraphServer) mustEmbedUnimplementedRouteGraphServer() {}
-func (UnimplementedRouteGraphServer) testEmbeddedByValue()                    {}
 
-// UnsafeRouteGraphServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RouteGraphServer will
-// result in compilation errors.
-type UnsafeRouteGraphServer interface {
-	mustEmbedUnimplementedRouteGraphServer()
+func _RouteGraph_ListPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).ListPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_ListPatterns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).ListPatterns(ctx, req.(*ListPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRouteGraphServer(s grpc.ServiceRegistrar, srv RouteGraphServer) {
-	// If the following call pancis, it indicates UnimplementedRouteGraphServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _RouteGraph_DeletePattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoutePattern)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&RouteGraph_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(RouteGraphServer).DeletePattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_DeletePattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).DeletePattern(ctx, req.(*RoutePattern))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Stop)
+func _RouteGraph_SetServicePeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServicePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateStop(ctx, in)
+		return srv.(RouteGraphServer).SetServicePeriods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateStop_FullMethodName,
+		FullMethod: RouteGraph_SetServicePeriods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateStop(ctx, req.(*Stop))
+		return srv.(RouteGraphServer).SetServicePeriods(ctx, req.(*ServicePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RouteGraph_ListServicePeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetStop(ctx, in)
+		return srv.(RouteGraphServer).ListServicePeriods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetStop_FullMethodName,
+		FullMethod: RouteGraph_ListServicePeriods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetStop(ctx, req.(*ID))
+		return srv.(RouteGraphServer).ListServicePeriods(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Stop)
+func _RouteGraph_CreateHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Holiday)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateStop(ctx, in)
+		return srv.(RouteGraphServer).CreateHoliday(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateStop_FullMethodName,
+		FullMethod: RouteGraph_CreateHoliday_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateStop(ctx, req.(*Stop))
+		return srv.(RouteGraphServer).CreateHoliday(ctx, req.(*Holiday))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _RouteGraph_DeleteHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Holiday)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteStop(ctx, in)
+		return srv.(RouteGraphServer).DeleteHoliday(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteStop_FullMethodName,
+		FullMethod: RouteGraph_DeleteHoliday_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteStop(ctx, req.(*ID))
+		return srv.(RouteGraphServer).DeleteHoliday(ctx, req.(*Holiday))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Line)
+func _RouteGraph_ListHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateLine(ctx, in)
+		return srv.(RouteGraphServer).ListHolidays(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateLine_FullMethodName,
+		FullMethod: RouteGraph_ListHolidays_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateLine(ctx, req.(*Line))
+		return srv.(RouteGraphServer).ListHolidays(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _RouteGraph_LineFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LineFrequencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetLine(ctx, in)
+		return srv.(RouteGraphServer).LineFrequency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetLine_FullMethodName,
+		FullMethod: RouteGraph_LineFrequency_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetLine(ctx, req.(*ID))
+		return srv.(RouteGraphServer).LineFrequency(ctx, req.(*LineFrequencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Line)
+func _RouteGraph_GenerateTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimetableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateLine(ctx, in)
+		return srv.(RouteGraphServer).GenerateTimetable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateLine_FullMethodName,
+		FullMethod: RouteGraph_GenerateTimetable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateLine(ctx, req.(*Line))
+		return srv.(RouteGraphServer).GenerateTimetable(ctx, req.(*TimetableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _RouteGraph_DepartureBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartureBoardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteLine(ctx, in)
+		return srv.(RouteGraphServer).DepartureBoard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteLine_FullMethodName,
+		FullMethod: RouteGraph_DepartureBoard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteLine(ctx, req.(*ID))
+		return srv.(RouteGraphServer).DepartureBoard(ctx, req.(*DepartureBoardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Vehicle)
+func _RouteGraph_StreamDepartureBoard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DepartureBoardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGraphServer).StreamDepartureBoard(m, &grpc.GenericServerStream[DepartureBoardRequest, DepartureBoardResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_StreamDepartureBoardServer = grpc.ServerStreamingServer[DepartureBoardResponse]
+
+func _RouteGraph_CreateDisruption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Disruption)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateVehicle(ctx, in)
+		return srv.(RouteGraphServer).CreateDisruption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateVehicle_FullMethodName,
+		FullMethod: RouteGraph_CreateDisruption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateVehicle(ctx, req.(*Vehicle))
+		return srv.(RouteGraphServer).CreateDisruption(ctx, req.(*Disruption))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RouteGraph_ListDisruptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisruptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGraphServer).ListDisruptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGraph_ListDisruptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGraphServer).ListDisruptions(ctx, req.(*ListDisruptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGraph_EndDisruption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetVehicle(ctx, in)
+		return srv.(RouteGraphServer).EndDisruption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetVehicle_FullMethodName,
+		FullMethod: RouteGraph_EndDisruption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetVehicle(ctx, req.(*ID))
+		return srv.(RouteGraphServer).EndDisruption(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Vehicle)
+func _RouteGraph_CreateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Scenario)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateVehicle(ctx, in)
+		return srv.(RouteGraphServer).CreateScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateVehicle_FullMethodName,
+		FullMethod: RouteGraph_CreateScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateVehicle(ctx, req.(*Vehicle))
+		return srv.(RouteGraphServer).CreateScenario(ctx, req.(*Scenario))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RouteGraph_GetScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteVehicle(ctx, in)
+		return srv.(RouteGraphServer).GetScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteVehicle_FullMethodName,
+		FullMethod: RouteGraph_GetScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteVehicle(ctx, req.(*ID))
+		return srv.(RouteGraphServer).GetScenario(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Depot)
+func _RouteGraph_ListScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateDepot(ctx, in)
+		return srv.(RouteGraphServer).ListScenarios(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateDepot_FullMethodName,
+		FullMethod: RouteGraph_ListScenarios_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateDepot(ctx, req.(*Depot))
+		return srv.(RouteGraphServer).ListScenarios(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _RouteGraph_UpdateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Scenario)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetDepot(ctx, in)
+		return srv.(RouteGraphServer).UpdateScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetDepot_FullMethodName,
+		FullMethod: RouteGraph_UpdateScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetDepot(ctx, req.(*ID))
+		return srv.(RouteGraphServer).UpdateScenario(ctx, req.(*Scenario))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Depot)
+func _RouteGraph_DeleteScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateDepot(ctx, in)
+		return srv.(RouteGraphServer).DeleteScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateDepot_FullMethodName,
+		FullMethod: RouteGraph_DeleteScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateDepot(ctx, req.(*Depot))
+		return srv.(RouteGraphServer).DeleteScenario(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _RouteGraph_EvaluateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateScenarioRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteDepot(ctx, in)
+		return srv.(RouteGraphServer).EvaluateScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteDepot_FullMethodName,
+		FullMethod: RouteGraph_EvaluateScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteDepot(ctx, req.(*ID))
+		return srv.(RouteGraphServer).EvaluateScenario(ctx, req.(*EvaluateScenarioRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextEdge)
+func _RouteGraph_PromoteScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetNextEdge(ctx, in)
+		return srv.(RouteGraphServer).PromoteScenario(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetNextEdge_FullMethodName,
+		FullMethod: RouteGraph_PromoteScenario_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetNextEdge(ctx, req.(*NextEdge))
+		return srv.(RouteGraphServer).PromoteScenario(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextEdge)
+func _RouteGraph_GenerateWalkLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWalkLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateNextEdge(ctx, in)
+		return srv.(RouteGraphServer).GenerateWalkLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateNextEdge_FullMethodName,
+		FullMethod: RouteGraph_GenerateWalkLinks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateNextEdge(ctx, req.(*NextEdge))
+		return srv.(RouteGraphServer).GenerateWalkLinks(ctx, req.(*GenerateWalkLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextEdge)
+func _RouteGraph_TransferHubs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferHubsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateNextEdge(ctx, in)
+		return srv.(RouteGraphServer).TransferHubs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateNextEdge_FullMethodName,
+		FullMethod: RouteGraph_TransferHubs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateNextEdge(ctx, req.(*NextEdge))
+		return srv.(RouteGraphServer).TransferHubs(ctx, req.(*TransferHubsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteNextEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextEdge)
+func _RouteGraph_StopCentrality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopCentralityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteNextEdge(ctx, in)
+		return srv.(RouteGraphServer).StopCentrality(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteNextEdge_FullMethodName,
+		FullMethod: RouteGraph_StopCentrality_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteNextEdge(ctx, req.(*NextEdge))
+		return srv.(RouteGraphServer).StopCentrality(ctx, req.(*StopCentralityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServesEdge)
+func _RouteGraph_CriticalElements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CriticalElementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetServesEdge(ctx, in)
+		return srv.(RouteGraphServer).CriticalElements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetServesEdge_FullMethodName,
+		FullMethod: RouteGraph_CriticalElements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetServesEdge(ctx, req.(*ServesEdge))
+		return srv.(RouteGraphServer).CriticalElements(ctx, req.(*CriticalElementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_ServesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServesListRequest)
+func _RouteGraph_ConnectivityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectivityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).ServesList(ctx, in)
+		return srv.(RouteGraphServer).ConnectivityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_ServesList_FullMethodName,
+		FullMethod: RouteGraph_ConnectivityReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).ServesList(ctx, req.(*ServesListRequest))
+		return srv.(RouteGraphServer).ConnectivityReport(ctx, req.(*ConnectivityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServesEdge)
+func _RouteGraph_LoadDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadDemandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateServesEdge(ctx, in)
+		return srv.(RouteGraphServer).LoadDemand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateServesEdge_FullMethodName,
+		FullMethod: RouteGraph_LoadDemand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateServesEdge(ctx, req.(*ServesEdge))
+		return srv.(RouteGraphServer).LoadDemand(ctx, req.(*LoadDemandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServesEdge)
+func _RouteGraph_AssignDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDemandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateServesEdge(ctx, in)
+		return srv.(RouteGraphServer).AssignDemand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateServesEdge_FullMethodName,
+		FullMethod: RouteGraph_AssignDemand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateServesEdge(ctx, req.(*ServesEdge))
+		return srv.(RouteGraphServer).AssignDemand(ctx, req.(*AssignDemandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteServesEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServesEdge)
+func _RouteGraph_RidershipStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RidershipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteServesEdge(ctx, in)
+		return srv.(RouteGraphServer).RidershipStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteServesEdge_FullMethodName,
+		FullMethod: RouteGraph_RidershipStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteServesEdge(ctx, req.(*ServesEdge))
+		return srv.(RouteGraphServer).RidershipStats(ctx, req.(*RidershipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignedTo)
+func _RouteGraph_GetEdgeProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextEdge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetAssignedTo(ctx, in)
+		return srv.(RouteGraphServer).GetEdgeProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetAssignedTo_FullMethodName,
+		FullMethod: RouteGraph_GetEdgeProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetAssignedTo(ctx, req.(*AssignedTo))
+		return srv.(RouteGraphServer).GetEdgeProfile(ctx, req.(*NextEdge))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignedTo)
+func _RouteGraph_SetEdgeProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EdgeProfile)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateAssignedTo(ctx, in)
+		return srv.(RouteGraphServer).SetEdgeProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateAssignedTo_FullMethodName,
+		FullMethod: RouteGraph_SetEdgeProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateAssignedTo(ctx, req.(*AssignedTo))
+		return srv.(RouteGraphServer).SetEdgeProfile(ctx, req.(*EdgeProfile))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignedTo)
+func _RouteGraph_BuildEdgeProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildEdgeProfilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateAssignedTo(ctx, in)
+		return srv.(RouteGraphServer).BuildEdgeProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateAssignedTo_FullMethodName,
+		FullMethod: RouteGraph_BuildEdgeProfiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateAssignedTo(ctx, req.(*AssignedTo))
+		return srv.(RouteGraphServer).BuildEdgeProfiles(ctx, req.(*BuildEdgeProfilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteAssignedTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignedTo)
+func _RouteGraph_IngestTripLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouteGraphServer).IngestTripLog(&grpc.GenericServerStream[IngestTripLogRequest, IngestTripLogResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_IngestTripLogServer = grpc.ClientStreamingServer[IngestTripLogRequest, IngestTripLogResponse]
+
+func _RouteGraph_ValidateNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateNetworkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteAssignedTo(ctx, in)
+		return srv.(RouteGraphServer).ValidateNetwork(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteAssignedTo_FullMethodName,
+		FullMethod: RouteGraph_ValidateNetwork_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteAssignedTo(ctx, req.(*AssignedTo))
+		return srv.(RouteGraphServer).ValidateNetwork(ctx, req.(*ValidateNetworkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GetParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParkedAt)
+func _RouteGraph_RenderNetworkMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkMapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GetParkedAt(ctx, in)
+		return srv.(RouteGraphServer).RenderNetworkMap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GetParkedAt_FullMethodName,
+		FullMethod: RouteGraph_RenderNetworkMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GetParkedAt(ctx, req.(*ParkedAt))
+		return srv.(RouteGraphServer).RenderNetworkMap(ctx, req.(*NetworkMapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_CreateParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParkedAt)
+func _RouteGraph_ReportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).CreateParkedAt(ctx, in)
+		return srv.(RouteGraphServer).ReportData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_CreateParkedAt_FullMethodName,
+		FullMethod: RouteGraph_ReportData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).CreateParkedAt(ctx, req.(*ParkedAt))
+		return srv.(RouteGraphServer).ReportData(ctx, req.(*ReportDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_UpdateParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParkedAt)
+func _RouteGraph_GenerateLineSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LineSheetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).UpdateParkedAt(ctx, in)
+		return srv.(RouteGraphServer).GenerateLineSheet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_UpdateParkedAt_FullMethodName,
+		FullMethod: RouteGraph_GenerateLineSheet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).UpdateParkedAt(ctx, req.(*ParkedAt))
+		return srv.(RouteGraphServer).GenerateLineSheet(ctx, req.(*LineSheetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DeleteParkedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParkedAt)
+func _RouteGraph_GenerateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DeleteParkedAt(ctx, in)
+		return srv.(RouteGraphServer).GenerateReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DeleteParkedAt_FullMethodName,
+		FullMethod: RouteGraph_GenerateReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DeleteParkedAt(ctx, req.(*ParkedAt))
+		return srv.(RouteGraphServer).GenerateReport(ctx, req.(*GenerateReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_AssignVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignVehicleRequest)
+func _RouteGraph_GenerateReportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGraphServer).GenerateReportStream(m, &grpc.GenericServerStream[GenerateReportRequest, ReportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_GenerateReportStreamServer = grpc.ServerStreamingServer[ReportChunk]
+
+func _RouteGraph_SubmitReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).AssignVehicle(ctx, in)
+		return srv.(RouteGraphServer).SubmitReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_AssignVehicle_FullMethodName,
+		FullMethod: RouteGraph_SubmitReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).AssignVehicle(ctx, req.(*AssignVehicleRequest))
+		return srv.(RouteGraphServer).SubmitReport(ctx, req.(*GenerateReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_RecalibrateEdge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecalibrateRequest)
+func _RouteGraph_GetReportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).RecalibrateEdge(ctx, in)
+		return srv.(RouteGraphServer).GetReportJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_RecalibrateEdge_FullMethodName,
+		FullMethod: RouteGraph_GetReportJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).RecalibrateEdge(ctx, req.(*RecalibrateRequest))
+		return srv.(RouteGraphServer).GetReportJob(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_ShortestPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PathRequest)
+func _RouteGraph_ListReportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).ShortestPath(ctx, in)
+		return srv.(RouteGraphServer).ListReportJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_ShortestPath_FullMethodName,
+		FullMethod: RouteGraph_ListReportJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).ShortestPath(ctx, req.(*PathRequest))
+		return srv.(RouteGraphServer).ListReportJobs(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_TopPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopPairsRequest)
+func _RouteGraph_DownloadReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGraphServer).DownloadReport(m, &grpc.GenericServerStream[ID, ReportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGraph_DownloadReportServer = grpc.ServerStreamingServer[ReportChunk]
+
+func _RouteGraph_CreateReportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSchedule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).TopPairs(ctx, in)
+		return srv.(RouteGraphServer).CreateReportSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_TopPairs_FullMethodName,
+		FullMethod: RouteGraph_CreateReportSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).TopPairs(ctx, req.(*TopPairsRequest))
+		return srv.(RouteGraphServer).CreateReportSchedule(ctx, req.(*ReportSchedule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_DepotsIdleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepotsRequest)
+func _RouteGraph_ListReportSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).DepotsIdleStats(ctx, in)
+		return srv.(RouteGraphServer).ListReportSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_DepotsIdleStats_FullMethodName,
+		FullMethod: RouteGraph_ListReportSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).DepotsIdleStats(ctx, req.(*DepotsRequest))
+		return srv.(RouteGraphServer).ListReportSchedules(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RouteGraph_GenerateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateReportRequest)
+func _RouteGraph_DeleteReportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RouteGraphServer).GenerateReport(ctx, in)
+		return srv.(RouteGraphServer).DeleteReportSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RouteGraph_GenerateReport_FullMethodName,
+		FullMethod: RouteGraph_DeleteReportSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGraphServer).GenerateReport(ctx, req.(*GenerateReportRequest))
+		return srv.(RouteGraphServer).DeleteReportSchedule(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1569,11 +3271,212 @@ var RouteGraph_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepotsIdleStats",
 			Handler:    _RouteGraph_DepotsIdleStats_Handler,
 		},
+		{
+			MethodName: "SetLineRoute",
+			Handler:    _RouteGraph_SetLineRoute_Handler,
+		},
+		{
+			MethodName: "InsertStopIntoLine",
+			Handler:    _RouteGraph_InsertStopIntoLine_Handler,
+		},
+		{
+			MethodName: "RemoveStopFromLine",
+			Handler:    _RouteGraph_RemoveStopFromLine_Handler,
+		},
+		{
+			MethodName: "UpsertPattern",
+			Handler:    _RouteGraph_UpsertPattern_Handler,
+		},
+		{
+			MethodName: "ListPatterns",
+			Handler:    _RouteGraph_ListPatterns_Handler,
+		},
+		{
+			MethodName: "DeletePattern",
+			Handler:    _RouteGraph_DeletePattern_Handler,
+		},
+		{
+			MethodName: "SetServicePeriods",
+			Handler:    _RouteGraph_SetServicePeriods_Handler,
+		},
+		{
+			MethodName: "ListServicePeriods",
+			Handler:    _RouteGraph_ListServicePeriods_Handler,
+		},
+		{
+			MethodName: "CreateHoliday",
+			Handler:    _RouteGraph_CreateHoliday_Handler,
+		},
+		{
+			MethodName: "DeleteHoliday",
+			Handler:    _RouteGraph_DeleteHoliday_Handler,
+		},
+		{
+			MethodName: "ListHolidays",
+			Handler:    _RouteGraph_ListHolidays_Handler,
+		},
+		{
+			MethodName: "LineFrequency",
+			Handler:    _RouteGraph_LineFrequency_Handler,
+		},
+		{
+			MethodName: "GenerateTimetable",
+			Handler:    _RouteGraph_GenerateTimetable_Handler,
+		},
+		{
+			MethodName: "DepartureBoard",
+			Handler:    _RouteGraph_DepartureBoard_Handler,
+		},
+		{
+			MethodName: "CreateDisruption",
+			Handler:    _RouteGraph_CreateDisruption_Handler,
+		},
+		{
+			MethodName: "ListDisruptions",
+			Handler:    _RouteGraph_ListDisruptions_Handler,
+		},
+		{
+			MethodName: "EndDisruption",
+			Handler:    _RouteGraph_EndDisruption_Handler,
+		},
+		{
+			MethodName: "CreateScenario",
+			Handler:    _RouteGraph_CreateScenario_Handler,
+		},
+		{
+			MethodName: "GetScenario",
+			Handler:    _RouteGraph_GetScenario_Handler,
+		},
+		{
+			MethodName: "ListScenarios",
+			Handler:    _RouteGraph_ListScenarios_Handler,
+		},
+		{
+			MethodName: "UpdateScenario",
+			Handler:    _RouteGraph_UpdateScenario_Handler,
+		},
+		{
+			MethodName: "DeleteScenario",
+			Handler:    _RouteGraph_DeleteScenario_Handler,
+		},
+		{
+			MethodName: "EvaluateScenario",
+			Handler:    _RouteGraph_EvaluateScenario_Handler,
+		},
+		{
+			MethodName: "PromoteScenario",
+			Handler:    _RouteGraph_PromoteScenario_Handler,
+		},
+		{
+			MethodName: "GenerateWalkLinks",
+			Handler:    _RouteGraph_GenerateWalkLinks_Handler,
+		},
+		{
+			MethodName: "TransferHubs",
+			Handler:    _RouteGraph_TransferHubs_Handler,
+		},
+		{
+			MethodName: "StopCentrality",
+			Handler:    _RouteGraph_StopCentrality_Handler,
+		},
+		{
+			MethodName: "CriticalElements",
+			Handler:    _RouteGraph_CriticalElements_Handler,
+		},
+		{
+			MethodName: "ConnectivityReport",
+			Handler:    _RouteGraph_ConnectivityReport_Handler,
+		},
+		{
+			MethodName: "LoadDemand",
+			Handler:    _RouteGraph_LoadDemand_Handler,
+		},
+		{
+			MethodName: "AssignDemand",
+			Handler:    _RouteGraph_AssignDemand_Handler,
+		},
+		{
+			MethodName: "RidershipStats",
+			Handler:    _RouteGraph_RidershipStats_Handler,
+		},
+		{
+			MethodName: "GetEdgeProfile",
+			Handler:    _RouteGraph_GetEdgeProfile_Handler,
+		},
+		{
+			MethodName: "SetEdgeProfile",
+			Handler:    _RouteGraph_SetEdgeProfile_Handler,
+		},
+		{
+			MethodName: "BuildEdgeProfiles",
+			Handler:    _RouteGraph_BuildEdgeProfiles_Handler,
+		},
+		{
+			MethodName: "ValidateNetwork",
+			Handler:    _RouteGraph_ValidateNetwork_Handler,
+		},
+		{
+			MethodName: "RenderNetworkMap",
+			Handler:    _RouteGraph_RenderNetworkMap_Handler,
+		},
+		{
+			MethodName: "ReportData",
+			Handler:    _RouteGraph_ReportData_Handler,
+		},
+		{
+			MethodName: "GenerateLineSheet",
+			Handler:    _RouteGraph_GenerateLineSheet_Handler,
+		},
 		{
 			MethodName: "GenerateReport",
 			Handler:    _RouteGraph_GenerateReport_Handler,
 		},
+		{
+			MethodName: "SubmitReport",
+			Handler:    _RouteGraph_SubmitReport_Handler,
+		},
+		{
+			MethodName: "GetReportJob",
+			Handler:    _RouteGraph_GetReportJob_Handler,
+		},
+		{
+			MethodName: "ListReportJobs",
+			Handler:    _RouteGraph_ListReportJobs_Handler,
+		},
+		{
+			MethodName: "CreateReportSchedule",
+			Handler:    _RouteGraph_CreateReportSchedule_Handler,
+		},
+		{
+			MethodName: "ListReportSchedules",
+			Handler:    _RouteGraph_ListReportSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteReportSchedule",
+			Handler:    _RouteGraph_DeleteReportSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDepartureBoard",
+			Handler:       _RouteGraph_StreamDepartureBoard_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "IngestTripLog",
+			Handler:       _RouteGraph_IngestTripLog_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GenerateReportStream",
+			Handler:       _RouteGraph_GenerateReportStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadReport",
+			Handler:       _RouteGraph_DownloadReport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/routegraph.proto",
 }
@@ -5,6 +5,9 @@ CREATE CONSTRAINT stop_id IF NOT EXISTS FOR (s:Stop) REQUIRE s.id IS UNIQUE;
 CREATE CONSTRAINT line_id IF NOT EXISTS FOR (l:Line) REQUIRE l.id IS UNIQUE;
 CREATE CONSTRAINT vehicle_uuid IF NOT EXISTS FOR (v:Vehicle) REQUIRE v.vehicle_uuid IS UNIQUE;
 CREATE CONSTRAINT depot_id IF NOT EXISTS FOR (d:Depot) REQUIRE d.id IS UNIQUE;
+CREATE CONSTRAINT disruption_id IF NOT EXISTS FOR (d:Disruption) REQUIRE d.id IS UNIQUE;
+CREATE CONSTRAINT report_schedule_id IF NOT EXISTS FOR (s:ReportSchedule) REQUIRE s.id IS UNIQUE;
+CREATE INDEX travel_observation_edge IF NOT EXISTS FOR (o:TravelTimeObservation) ON (o.from_id, o.to_id);
 
 // depots
 UNWIND [
@@ -48,19 +51,37 @@ CALL {
   WITH l, allStops[0..toInteger(10 + rand()*5)] AS route
   UNWIND range(0, size(route)-1) AS idx
   WITH l, route[idx] AS s, idx
-  CREATE (l)-[:SERVES {order: idx+1, average_boardings: toInteger(rand()*50)}]->(s)
+  CREATE (l)-[:SERVES {order: idx+1, direction: 'OUTBOUND', average_boardings: toInteger(rand()*50)}]->(s)
 }
 IN TRANSACTIONS;
 
-// create NEXT between consecutive SERVES per line
+// inbound direction runs the outbound route in reverse under the same line
+MATCH (l:Line)-[r:SERVES {direction:'OUTBOUND'}]->(s:Stop)
+WITH l, collect({stop: s, order: r.order}) AS route
+UNWIND route AS x
+WITH l, size(route) AS n, x.stop AS s, x.order AS o
+CREATE (l)-[:SERVES {order: n - o + 1, direction: 'INBOUND', average_boardings: toInteger(rand()*50)}]->(s);
+
+// L3 short-turn pattern: first half of the outbound route, morning peak only
+MATCH (l:Line {id:'L3'})-[r:SERVES {direction:'OUTBOUND'}]->(s:Stop)
+WITH l, collect({stop: s, order: r.order}) AS route
+UNWIND route AS x
+WITH l, x WHERE x.order <= size(route) / 2
+CREATE (l)-[:SERVES {order: x.order, direction: 'OUTBOUND', pattern: 'SHORT', average_boardings: toInteger(rand()*50)}]->(x.stop);
+
+MATCH (l:Line {id:'L3'})
+CREATE (l)-[:HAS_PATTERN]->(:Pattern {line_id:'L3', name:'SHORT', direction:'OUTBOUND', start_time:'06:00', end_time:'09:00', description:'short turn'});
+
+// create NEXT between consecutive SERVES per line direction and pattern
 // we must preserve r and s across ORDER BY, so include them in WITH before ORDER BY
 MATCH (l:Line)-[r:SERVES]->(s:Stop)
 WITH l, r, s
-ORDER BY l.id, r.order
-WITH l, collect(s) AS stops
+ORDER BY l.id, r.direction, r.pattern, r.order
+WITH l, r.direction AS dir, r.pattern AS pat, collect(s) AS stops
 UNWIND range(0, size(stops)-2) AS i
 WITH stops[i] AS a, stops[i+1] AS b
-CREATE (a)-[:NEXT {travel_time: 60 + toInteger(rand()*180), distance: 100 + toInteger(rand()*900)}]->(b);
+MERGE (a)-[n:NEXT]->(b)
+ON CREATE SET n.travel_time = 60 + toInteger(rand()*180), n.distance = 100 + toInteger(rand()*900);
 
 // vehicles (40) - vehicle_uuid is deterministic 'V'+i
 UNWIND range(1,40) AS i
@@ -91,3 +112,32 @@ WITH idle, collect(d) AS deps
 UNWIND idle AS v
 WITH v, deps[toInteger(rand()*size(deps))] AS d
 CREATE (v)-[:PARKED_AT {since: timestamp()-toInteger(rand()*86400000)}]->(d);
+
+// service calendar: peak/off-peak headways per day type, holidays run the SUNDAY service
+MATCH (l:Line)
+UNWIND [
+  {day_type:'WEEKDAY',  start_time:'05:00', end_time:'07:00', frequency_mins:15},
+  {day_type:'WEEKDAY',  start_time:'07:00', end_time:'09:00', frequency_mins:8},
+  {day_type:'WEEKDAY',  start_time:'09:00', end_time:'15:00', frequency_mins:12},
+  {day_type:'WEEKDAY',  start_time:'15:00', end_time:'18:00', frequency_mins:8},
+  {day_type:'WEEKDAY',  start_time:'18:00', end_time:'23:00', frequency_mins:15},
+  {day_type:'SATURDAY', start_time:'06:00', end_time:'23:00', frequency_mins:20},
+  {day_type:'SUNDAY',   start_time:'07:00', end_time:'22:00', frequency_mins:30}
+] AS p
+CREATE (l)-[:HAS_PERIOD]->(:ServicePeriod {line_id:l.id, day_type:p.day_type, start_time:p.start_time, end_time:p.end_time, frequency_mins:p.frequency_mins});
+
+UNWIND [
+  {date:'2026-01-01', name:'Nova godina'},
+  {date:'2026-01-07', name:'Bozic'},
+  {date:'2026-02-15', name:'Dan drzavnosti'},
+  {date:'2026-05-01', name:'Praznik rada'}
+] AS h
+MERGE (:Holiday {date:h.date, name:h.name});
+
+// origin-destination demand: random stop pairs with trips per hour
+UNWIND range(1,150) AS i
+WITH 'S'+toString(1+toInteger(rand()*100)) AS a, 'S'+toString(1+toInteger(rand()*100)) AS b
+WHERE a <> b
+MATCH (o:Stop {id:a}), (d:Stop {id:b})
+MERGE (o)-[r:DEMAND]->(d)
+SET r.trips_per_hour = 5 + toInteger(rand()*120);
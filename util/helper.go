@@ -2,6 +2,7 @@ package helper
 
 import (
 	"fmt"
+	"math"
 )
 
 func AnyToInt32(v any) int32 {
@@ -45,3 +46,43 @@ func AnyToString(v any) string {
 		return fmt.Sprintf("%v", t)
 	}
 }
+
+const earthRadiusM = 6371000.0
+
+// HaversineMeters returns the great-circle distance between two lat/lon points in metres.
+func HaversineMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusM * math.Asin(math.Sqrt(a))
+}
+
+// ClockMinutes parses a "HH:MM" time of day into minutes after midnight ("24:00" is allowed).
+func ClockMinutes(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	if h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+// InClockWindow reports whether minute-of-day at falls in [start, end); windows may wrap past midnight.
+func InClockWindow(start, end, at int) bool {
+	if start == end {
+		return true
+	}
+	if start < end {
+		return at >= start && at < end
+	}
+	return at >= start || at < end
+}
+
+// ClockString formats minutes after midnight as "HH:MM"; service past midnight keeps counting (24:10).
+func ClockString(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}